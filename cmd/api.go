@@ -10,8 +10,10 @@ import (
 
 	"github.com/FreakyGranny/launchpad-api/internal/app"
 	"github.com/FreakyGranny/launchpad-api/internal/auth"
+	"github.com/FreakyGranny/launchpad-api/internal/chat"
 	"github.com/FreakyGranny/launchpad-api/internal/config"
 	"github.com/FreakyGranny/launchpad-api/internal/db"
+	"github.com/FreakyGranny/launchpad-api/internal/mail"
 	"github.com/FreakyGranny/launchpad-api/internal/models"
 	"github.com/FreakyGranny/launchpad-api/internal/server"
 	"github.com/spf13/cobra"
@@ -52,14 +54,71 @@ func API(cmd *cobra.Command, args []string) {
 	uModel := models.NewUserModel(d)
 	cModel := models.NewCategoryModel(d)
 	dModel := models.NewDonationModel(d)
+	aModel := models.NewAdjustmentModel(d)
+	tModel := models.NewTierModel(d)
+	cmModel := models.NewCommentModel(d)
+	puModel := models.NewProjectUpdateModel(d)
+	nModel := models.NewNotificationModel(d)
+	esModel := models.NewEmailSettingsModel(d)
+	wModel := models.NewWebhookModel(d)
+	wdModel := models.NewWebhookDeliveryModel(d)
+	caModel := models.NewChatAccountModel(d)
+	tgModel := models.NewTagModel(d)
+	fModel := models.NewFollowModel(d)
+	acModel := models.NewActivityModel(d)
+	rkModel := models.NewRankingModel(d)
+	tplModel := models.NewTemplateModel(d)
+	clModel := models.NewCollaboratorModel(d)
+	auModel := models.NewAuditModel(d)
+	rvModel := models.NewRevisionModel(d)
 
 	ctx, cancel := context.WithCancel(context.Background())
-	b := app.NewBackground(sModel, pModel, uModel)
-	b.Start(ctx)
-	e := server.New(
-		app.New(cModel, uModel, pModel, ptModel, dModel, auth.NewVk(cfg.Vk), clockwork.NewRealClock(), cfg.JWTSecret, b.GetRecalcPipe()),
-		[]byte(cfg.JWTSecret),
+	clock := clockwork.NewRealClock()
+	live := app.NewLiveHub(models.NewChannelModel(d))
+	go live.Run(ctx)
+	webhookNotifier := app.NewWebhookNotifier(wModel, wdModel, clock)
+	notifier := app.NewMultiNotifier(
+		app.NewLogNotifier(),
+		app.NewStoreNotifier(nModel, clock),
+		webhookNotifier,
+		live,
 	)
+	var digest app.DigestSender
+	if cfg.SMTP.Host != "" {
+		emailNotifier := app.NewEmailNotifier(uModel, esModel, models.NewPendingEmailModel(d), mail.NewSMTPMailer(cfg.SMTP), clock)
+		notifier = append(notifier, emailNotifier)
+		digest = emailNotifier
+	}
+	var transport chat.Transport
+	if cfg.Chat.Token != "" {
+		transport = chat.NewTelegram(cfg.Chat)
+		notifier = append(notifier, chat.NewAnnouncer(transport, caModel, cfg.Chat.GroupID))
+	}
+	followNotifier := app.NewFollowNotifier(notifier, pModel, fModel, acModel, clock)
+	reminders := app.ReminderPolicy{
+		Delays:        cfg.Reminders.Delays,
+		CheckInterval: cfg.Reminders.CheckInterval,
+		Grace:         cfg.Reminders.Grace,
+		GraceAction:   cfg.Reminders.GraceAction,
+	}
+	if err := reminders.Validate(); err != nil {
+		log.Fatal(err)
+	}
+	rankings := app.RankingPolicy{
+		Window:   cfg.Rankings.Window,
+		Interval: cfg.Rankings.Interval,
+		Limit:    cfg.Rankings.Limit,
+	}
+	if err := rankings.Validate(); err != nil {
+		log.Fatal(err)
+	}
+	b := app.NewBackground(sModel, pModel, uModel, dModel, nModel, rkModel, followNotifier, digest, webhookNotifier, cfg.NotificationRetention, reminders, rankings, clock)
+	b.Start(ctx)
+	application := app.New(cModel, uModel, pModel, ptModel, dModel, aModel, tModel, cmModel, puModel, nModel, esModel, wModel, wdModel, caModel, tgModel, fModel, acModel, rkModel, tplModel, clModel, auModel, rvModel, live, auth.NewVk(cfg.Vk), followNotifier, clock, cfg.JWTSecret, b.GetRecalcPipe())
+	if transport != nil {
+		go chat.NewBot(transport, application).Run(ctx)
+	}
+	e := server.New(application, []byte(cfg.JWTSecret))
 	go func() {
 		if err := e.Start(":1323"); err != nil {
 			e.Logger.Info("shutting down the server")
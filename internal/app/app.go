@@ -2,45 +2,147 @@ package app
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/FreakyGranny/launchpad-api/internal/auth"
 	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/FreakyGranny/launchpad-api/internal/money"
 	"github.com/jonboulle/clockwork"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mock/app_mock.go -package=app_mock Application
 
+const (
+	messageRateLimit  = 5
+	messageRateWindow = time.Minute
+	maxMessageLength  = 500
+	maxPageSize       = 100
+)
+
 // Application business logic.
 type Application interface {
 	GetCategories() ([]models.Category, error)
-	GetUser(id int) (*ExtendedUser, error)
+	GetUser(id, viewerID int) (*ExtendedUser, error)
 	Authentificate(code string) (string, error)
 	GetProjectTypes() ([]models.ProjectType, error)
 	GetProject(id int) (*ExtendedProject, error)
-	GetProjectsWithPagination(category, projectType, page, pageSize int, onlyOpen bool) ([]*ExtendedProject, int, bool, error)
-	GetUserProjects(user int, onlyContributed, onlyOwned bool) ([]*ExtendedProject, error)
-	CreateProject(user, goalPeople, goalAmount, category, projectType int, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time) (int, error)
-	UpdateProject(id, user, goalPeople, goalAmount, category, projectType int, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, published, dropEventDate bool) (*ExtendedProject, error)
+	GetProjectsWithPagination(filter *models.ProjectFilter, page, pageSize int) ([]*ExtendedProject, int, bool, error)
+	GetProjectsWithCursor(filter *models.ProjectFilter, cursor *models.Cursor, limit int) ([]*ExtendedProject, *models.Cursor, error)
+	GetUserProjects(user, viewerID int, onlyContributed, onlyOwned bool, cursor, limit int) ([]*ExtendedProject, int, bool, error)
+	GetTrendingProjects(limit int) ([]*ExtendedProject, error)
+	GetRecommendedProjects(userID, limit int) ([]*ExtendedProject, error)
+	CreateProject(user, template, goalPeople int, goalAmount int64, category, projectType int, currency, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, rules models.PledgeRules) (int, error)
+	UpdateProject(id, user, goalPeople int, goalAmount int64, category, projectType int, currency, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, rules *models.PledgeRules, published, dropEventDate bool) (*ExtendedProject, error)
 	DeleteProject(iserID, projectID int) error
-	GetUserDonations(id int) ([]models.Donation, error)
-	GetProjectDonations(id int) ([]ShortDonation, error)
-	CreateDonation(userID, projectID, payment int) (*models.Donation, error)
+	GetCollaborators(viewerID, projectID int) ([]models.Collaborator, error)
+	InviteCollaborator(ownerID, projectID, userID int, role string) (*models.Collaborator, error)
+	GetInvitations(userID int) ([]models.Collaborator, error)
+	AcceptInvitation(userID, projectID int) (*models.Collaborator, error)
+	RemoveCollaborator(actorID, projectID, userID int) error
+	TransferOwnership(ownerID, projectID, userID int) (*ExtendedProject, error)
+	CancelProject(userID, projectID int, reason string) (*ExtendedProject, error)
+	ExtendProject(userID, projectID int, releaseDate time.Time) (*ExtendedProject, error)
+	EditProject(userID, projectID int, title, subtitle, descr, imageLink, instructions *string) (*ExtendedProject, error)
+	ReopenProject(userID, projectID int, releaseDate time.Time) (*ExtendedProject, error)
+	SchedulePublication(userID, projectID int, publishAt time.Time) (*ExtendedProject, error)
+	GetReviewQueue(userID int) ([]*ExtendedProject, error)
+	ApproveProject(userID, projectID int, comment string) (*ExtendedProject, error)
+	RejectProject(userID, projectID int, comment string) (*ExtendedProject, error)
+	GetProjectAudit(viewerID, projectID int) ([]models.ProjectAudit, error)
+	GetProjectRevisions(viewerID, projectID int) ([]models.ProjectRevision, error)
+	GetRevisionDiff(viewerID, projectID, from, to int) (*RevisionDiff, error)
+	CloneProject(userID, projectID int, releaseDate time.Time) (int, error)
+	GetTemplates(userID int) ([]models.ProjectTemplate, error)
+	CreateTemplate(userID int, t *models.ProjectTemplate) (*models.ProjectTemplate, error)
+	DeleteTemplate(userID, templateID int) error
+	SetProjectPrivacy(userID, projectID int, privateAmounts bool) (*ExtendedProject, error)
+	GetUserDonations(id, cursor, limit int) ([]models.Donation, int, bool, error)
+	GetProjectDonations(id, viewerID, cursor, limit int) ([]ShortDonation, int, bool, error)
+	CreateDonation(userID, projectID, tierID int, payment int64, anonymous bool, message string) (*models.Donation, error)
 	DeleteDonation(donationID, userID int) error
-	UpdateDonation(donationID, userID, payment int, paid bool) (*models.Donation, error)
+	UpdateDonation(donationID, userID int, payment int64, paid bool) (*models.Donation, error)
+	SetDonationAnonymity(donationID, userID int, anonymous bool) (*models.Donation, error)
+	SetDonationMessage(donationID, userID int, message string) (*models.Donation, error)
+	HideDonationMessage(donationID, userID int, hidden bool) (*models.Donation, error)
+	DeleteDonationMessage(donationID, userID int) error
+	GetGuestbook(projectID, viewerID, page, pageSize int) ([]GuestbookEntry, int, bool, error)
+	GetProjectComments(projectID, viewerID, cursor, limit int) ([]models.Comment, int, bool, error)
+	GetCommentReplies(commentID, viewerID, cursor, limit int) ([]models.Comment, int, bool, error)
+	CreateComment(userID, projectID, parentID int, text string) (*models.Comment, error)
+	UpdateComment(commentID, userID int, text string) (*models.Comment, error)
+	DeleteComment(commentID, userID int) error
+	GetProjectUpdates(projectID, viewerID int) ([]models.ProjectUpdate, error)
+	CreateProjectUpdate(userID, projectID int, title, text string, participantsOnly bool) (*models.ProjectUpdate, error)
+	DeleteProjectUpdate(userID, updateID int) error
+	GetNotifications(userID, cursor, limit int, onlyUnread bool) ([]models.Notification, int, bool, error)
+	CountUnreadNotifications(userID int) (int, error)
+	MarkNotificationsRead(userID int, ids []int) error
+	MarkAllNotificationsRead(userID int) error
+	GetEmailSettings(userID int) (*models.EmailSettings, error)
+	UpdateEmailSettings(userID int, locale string, digest bool, events []string) (*models.EmailSettings, error)
+	GetWebhooks(userID int) ([]models.Webhook, error)
+	CreateWebhook(userID int, url string, events []string) (*CreatedWebhook, error)
+	UpdateWebhook(userID, webhookID int, url string, events []string, active bool) (*models.Webhook, error)
+	DeleteWebhook(userID, webhookID int) error
+	GetWebhookDeliveries(userID, webhookID, cursor, limit int) ([]models.WebhookDelivery, int, bool, error)
+	SendTestWebhook(userID, webhookID int) (*models.WebhookDelivery, error)
+	SubscribeProject(userID, projectID int) (*LiveMessage, <-chan LiveMessage, func(), error)
+	CreateChatLinkCode(userID int) (string, time.Time, error)
+	LinkChatAccount(code, chatUserID, chatUsername string) (int, error)
+	UnlinkChatAccount(userID int) error
+	GetUserByChatAccount(chatUserID string) (int, error)
+	SettleCredit(donationID, userID int) (*models.Donation, error)
+	GetUserAdjustments(userID int) ([]models.Adjustment, error)
+	SearchTags(prefix string, limit int) ([]models.Tag, error)
+	GetTagStats() ([]TagStats, error)
+	SetProjectTags(userID, projectID int, names []string) ([]models.Tag, error)
+	CreateTag(userID int, name string) (*models.Tag, error)
+	MergeTags(userID, sourceID, targetID int) (*models.Tag, error)
+	GetFollows(userID int) ([]models.Follow, error)
+	Follow(userID int, targetType string, targetID int) (*models.Follow, error)
+	Unfollow(userID int, targetType string, targetID int) error
+	GetFeed(userID, cursor, limit int) ([]models.Activity, int, bool, error)
+	GetProjectTiers(projectID int) ([]models.Tier, error)
+	CreateTier(userID, projectID int, amount int64, quantity int, title, descr string) (*models.Tier, error)
+	DeleteTier(userID, tierID int) error
 }
 
 // App launchpad instance.
 type App struct {
-	categoryModel    models.CategoryImpl
-	userModel        models.UserImpl
-	projectModel     models.ProjectImpl
-	projectTypeModel models.ProjectTypeImpl
-	donationModel    models.DonationImpl
-	jwtSecret        string
-	provider         auth.Provider
-	clock            clockwork.Clock
-	reCalcCh         chan<- int
+	categoryModel        models.CategoryImpl
+	userModel            models.UserImpl
+	projectModel         models.ProjectImpl
+	projectTypeModel     models.ProjectTypeImpl
+	donationModel        models.DonationImpl
+	adjustmentModel      models.AdjustmentImpl
+	tierModel            models.TierImpl
+	commentModel         models.CommentImpl
+	projectUpdateModel   models.ProjectUpdateImpl
+	notificationModel    models.NotificationImpl
+	emailSettingsModel   models.EmailSettingsImpl
+	webhookModel         models.WebhookImpl
+	webhookDeliveryModel models.WebhookDeliveryImpl
+	webhookClient        HTTPClient
+	chatAccountModel     models.ChatAccountImpl
+	tagModel             models.TagImpl
+	followModel          models.FollowImpl
+	activityModel        models.ActivityImpl
+	rankingModel         models.RankingImpl
+	templateModel        models.TemplateImpl
+	collaboratorModel    models.CollaboratorImpl
+	auditModel           models.AuditImpl
+	revisionModel        models.RevisionImpl
+	live                 *LiveHub
+	jwtSecret            string
+	provider             auth.Provider
+	notifier             Notifier
+	clock                clockwork.Clock
+	reCalcCh             chan<- int
+	messageLimiter       *rateLimiter
 }
 
 // New returns new app.
@@ -50,21 +152,61 @@ func New(
 	project models.ProjectImpl,
 	projectType models.ProjectTypeImpl,
 	donation models.DonationImpl,
+	adjustment models.AdjustmentImpl,
+	tier models.TierImpl,
+	comment models.CommentImpl,
+	projectUpdate models.ProjectUpdateImpl,
+	notification models.NotificationImpl,
+	emailSettings models.EmailSettingsImpl,
+	webhook models.WebhookImpl,
+	webhookDelivery models.WebhookDeliveryImpl,
+	chatAccount models.ChatAccountImpl,
+	tag models.TagImpl,
+	follow models.FollowImpl,
+	activity models.ActivityImpl,
+	ranking models.RankingImpl,
+	template models.TemplateImpl,
+	collaborator models.CollaboratorImpl,
+	audit models.AuditImpl,
+	revision models.RevisionImpl,
+	live *LiveHub,
 	provider auth.Provider,
+	notifier Notifier,
 	clock clockwork.Clock,
 	jwtSecret string,
 	ch chan<- int,
 ) *App {
 	return &App{
-		categoryModel:    category,
-		userModel:        user,
-		projectModel:     project,
-		projectTypeModel: projectType,
-		donationModel:    donation,
-		jwtSecret:        jwtSecret,
-		clock:            clock,
-		provider:         provider,
-		reCalcCh:         ch,
+		categoryModel:        category,
+		userModel:            user,
+		projectModel:         project,
+		projectTypeModel:     projectType,
+		donationModel:        donation,
+		adjustmentModel:      adjustment,
+		tierModel:            tier,
+		commentModel:         comment,
+		projectUpdateModel:   projectUpdate,
+		notificationModel:    notification,
+		emailSettingsModel:   emailSettings,
+		webhookModel:         webhook,
+		webhookDeliveryModel: webhookDelivery,
+		webhookClient:        &http.Client{Timeout: webhookTimeout},
+		chatAccountModel:     chatAccount,
+		tagModel:             tag,
+		followModel:          follow,
+		activityModel:        activity,
+		rankingModel:         ranking,
+		templateModel:        template,
+		collaboratorModel:    collaborator,
+		auditModel:           audit,
+		revisionModel:        revision,
+		live:                 live,
+		notifier:             notifier,
+		jwtSecret:            jwtSecret,
+		clock:                clock,
+		provider:             provider,
+		reCalcCh:             ch,
+		messageLimiter:       newRateLimiter(clock, messageRateLimit, messageRateWindow),
 	}
 }
 
@@ -73,13 +215,13 @@ func (a *App) GetCategories() ([]models.Category, error) {
 	return a.categoryModel.GetAll()
 }
 
-// GetUser returns all existing category.
-func (a *App) GetUser(id int) (*ExtendedUser, error) {
+// GetUser returns user with participation visible to viewer.
+func (a *App) GetUser(id, viewerID int) (*ExtendedUser, error) {
 	user, ok := a.userModel.Get(id)
 	if !ok {
 		return nil, ErrUserNotFound
 	}
-	pts, err := a.userModel.GetParticipation(id)
+	pts, err := a.userModel.GetParticipation(id, id == viewerID)
 	if err != nil {
 		return nil, ErrGetUserParticipation
 	}
@@ -128,12 +270,65 @@ func (a *App) GetProjectTypes() ([]models.ProjectType, error) {
 	return a.projectTypeModel.GetAll()
 }
 
-// GetProjectsWithPagination returns list of projects.
-func (a *App) GetProjectsWithPagination(category, projectType, page, pageSize int, onlyOpen bool) ([]*ExtendedProject, int, bool, error) {
+// normalizeProjectFilter trims search query and converts tag names to aliases.
+func normalizeProjectFilter(f *models.ProjectFilter) {
+	f.Search = strings.TrimSpace(f.Search)
+	for i, tag := range f.Tags {
+		f.Tags[i] = tagAlias(tag)
+	}
+}
+
+// validateProjectFilter checks filter and ordering of project list.
+func validateProjectFilter(f *models.ProjectFilter, pageSize int) error {
+	verr := &ValidationError{}
+	if pageSize > maxPageSize {
+		verr.add("page_size", CodeMax, fmt.Sprintf("page size can't be greater than %d", maxPageSize))
+	}
+	for _, status := range f.Statuses {
+		if !isListedStatus(status) {
+			verr.add("status", CodeNotAllowed, fmt.Sprintf("status %q is not supported", status))
+		}
+	}
+	for _, s := range f.Sort {
+		if !models.IsSortField(s.Field) {
+			verr.add("sort", CodeNotAllowed, fmt.Sprintf("sorting by %q is not supported", s.Field))
+		}
+		if s.Field == models.SortRelevance && f.Search == "" {
+			verr.add("sort", CodeNotAllowed, "sorting by relevance requires search query")
+		}
+	}
+	if !f.ReleaseFrom.IsZero() && !f.ReleaseTo.IsZero() && !f.ReleaseFrom.Before(f.ReleaseTo) {
+		verr.add("release_to", CodeMin, "release date range is empty")
+	}
+	if !f.EventFrom.IsZero() && !f.EventTo.IsZero() && !f.EventFrom.Before(f.EventTo) {
+		verr.add("event_to", CodeMin, "event date range is empty")
+	}
+
+	return verr.errOrNil()
+}
+
+func isListedStatus(status string) bool {
+	for _, s := range models.ListedStatuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetProjectsWithPagination returns list of projects matching filter.
+// Projects matching search have highlighted snippet.
+func (a *App) GetProjectsWithPagination(filter *models.ProjectFilter, page, pageSize int) ([]*ExtendedProject, int, bool, error) {
 	var next int
 	var hasNext bool
 
-	paginator, err := a.projectModel.GetProjectsWithPagination(category, projectType, page, pageSize, onlyOpen)
+	normalizeProjectFilter(filter)
+	err := validateProjectFilter(filter, pageSize)
+	if err != nil {
+		return nil, next, hasNext, err
+	}
+	paginator, err := a.projectModel.GetProjectsWithPagination(filter, page, pageSize)
 	if err != nil {
 		return nil, next, hasNext, ErrProjectRetrieve
 	}
@@ -147,18 +342,76 @@ func (a *App) GetProjectsWithPagination(category, projectType, page, pageSize in
 	if err != nil {
 		return nil, next, hasNext, err
 	}
+	if filter.Search != "" {
+		err = a.addSnippets(projectList, filter.Search)
+		if err != nil {
+			return nil, next, hasNext, err
+		}
+	}
 
 	return projectList, next, hasNext, nil
 }
 
-// GetUserProjects returns list of projects for user.
-func (a *App) GetUserProjects(user int, onlyContributed, onlyOwned bool) ([]*ExtendedProject, error) {
-	projects, err := a.projectModel.GetUserProjects(user, onlyContributed, onlyOwned)
+// addSnippets sets highlighted fragments matching search to projects.
+func (a *App) addSnippets(projects []*ExtendedProject, search string) error {
+	ids := make([]int, 0, len(projects))
+	for _, p := range projects {
+		ids = append(ids, p.ID)
+	}
+	snippets, err := a.projectModel.GetSnippets(ids, search)
 	if err != nil {
-		return nil, ErrProjectRetrieve
+		return ErrProjectRetrieve
+	}
+	for _, p := range projects {
+		p.Snippet = snippets[p.ID]
 	}
 
-	return a.extendProjectList(projects)
+	return nil
+}
+
+// GetProjectsWithCursor returns page of projects matching filter which follow cursor
+// and cursor of the next page.
+func (a *App) GetProjectsWithCursor(filter *models.ProjectFilter, cursor *models.Cursor, limit int) ([]*ExtendedProject, *models.Cursor, error) {
+	normalizeProjectFilter(filter)
+	err := validateProjectFilter(filter, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	projects, next, err := a.projectModel.GetProjectsAfter(filter, cursor, limit)
+	if err == models.ErrInvalidCursor {
+		return nil, nil, invalidCursorError()
+	}
+	if err != nil {
+		return nil, nil, ErrProjectRetrieve
+	}
+	projectList, err := a.extendProjectList(projects)
+	if err != nil {
+		return nil, nil, err
+	}
+	if filter.Search != "" {
+		err = a.addSnippets(projectList, filter.Search)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return projectList, next, nil
+}
+
+// GetUserProjects returns page of projects for user visible to viewer, newest first.
+func (a *App) GetUserProjects(user, viewerID int, onlyContributed, onlyOwned bool, cursor, limit int) ([]*ExtendedProject, int, bool, error) {
+	projects, err := a.projectModel.GetUserProjects(user, viewerID, onlyContributed, onlyOwned, cursor, pageFetchLimit(limit))
+	if err != nil {
+		return nil, 0, false, ErrProjectRetrieve
+	}
+	n, next, hasNext := cutPage(len(*projects), limit, func(i int) int { return (*projects)[i].ID })
+	*projects = (*projects)[:n]
+	projectList, err := a.extendProjectList(projects)
+	if err != nil {
+		return nil, 0, false, err
+	}
+
+	return projectList, next, hasNext, nil
 }
 
 // GetProject returns project by given id.
@@ -167,8 +420,20 @@ func (a *App) GetProject(id int) (*ExtendedProject, error) {
 	if !ok {
 		return nil, ErrProjectNotFound
 	}
+	extended, err := a.extendProject(project)
+	if err != nil {
+		return nil, err
+	}
+	extended.Tiers, err = a.tierModel.GetAllByProject(id)
+	if err != nil {
+		return nil, err
+	}
+	extended.Tags, err = a.tagModel.GetByProject(id)
+	if err != nil {
+		return nil, err
+	}
 
-	return a.extendProject(project)
+	return extended, nil
 }
 
 func (a *App) extendProjectList(projects *[]models.Project) ([]*ExtendedProject, error) {
@@ -190,33 +455,51 @@ func (a *App) extendProject(project *models.Project) (*ExtendedProject, error) {
 		return nil, err
 	}
 	extended := &ExtendedProject{
-		ID:           project.ID,
-		Title:        project.Title,
-		SubTitle:     project.SubTitle,
-		Status:       project.Status(),
-		ReleaseDate:  project.ReleaseDate.Format(DateLayout),
-		ImageLink:    project.ImageLink,
-		Total:        project.Total,
-		Percent:      strategy.Percent(project),
-		Category:     project.Category,
-		ProjectType:  project.ProjectType,
-		GoalPeople:   project.GoalPeople,
-		GoalAmount:   project.GoalAmount,
-		Description:  project.Description,
-		Instructions: project.Instructions,
-		Owner:        project.Owner,
+		ID:             project.ID,
+		Title:          project.Title,
+		SubTitle:       project.SubTitle,
+		Status:         project.Status(),
+		ReleaseDate:    project.ReleaseDate.Format(DateLayout),
+		ImageLink:      project.ImageLink,
+		Total:          project.Total,
+		Currency:       project.Currency,
+		Percent:        strategy.Percent(project),
+		Category:       project.Category,
+		ProjectType:    project.ProjectType,
+		GoalPeople:     project.GoalPeople,
+		GoalAmount:     project.GoalAmount,
+		Description:    project.Description,
+		Instructions:   project.Instructions,
+		Owner:          project.Owner,
+		Pledge:         project.PledgeRules,
+		PrivateAmounts: project.PrivateAmounts,
+		CancelReason:   project.CancelReason,
+		ReviewStatus:   project.ReviewStatus,
+		ReviewComment:  project.ReviewComment,
 	}
 
 	if !project.EventDate.IsZero() {
 		ed := project.EventDate.Format(DateTimeLayout)
 		extended.EventDate = &ed
 	}
+	if !project.Published && !project.PublishAt.IsZero() {
+		pa := project.PublishAt.Format(DateTimeLayout)
+		extended.PublishAt = &pa
+	}
 
 	return extended, nil
 }
 
-// CreateProject creates new prject.
-func (a *App) CreateProject(user, goalPeople, goalAmount, category, projectType int, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time) (int, error) {
+// CreateProject creates new prject, empty fields are filled from template if it is set.
+func (a *App) CreateProject(user, template, goalPeople int, goalAmount int64, category, projectType int, currency, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, rules models.PledgeRules) (int, error) {
+	currency, err := money.NormalizeCurrency(currency)
+	if err != nil {
+		return 0, ErrProjectWrongCurrency
+	}
+	err = validatePledgeRules(&rules)
+	if err != nil {
+		return 0, err
+	}
 	newProject := models.Project{
 		OwnerID:       user,
 		Title:         title,
@@ -225,6 +508,7 @@ func (a *App) CreateProject(user, goalPeople, goalAmount, category, projectType
 		EventDate:     eventTime,
 		GoalPeople:    goalPeople,
 		GoalAmount:    goalAmount,
+		Currency:      currency,
 		Description:   descr,
 		ImageLink:     imageLink,
 		Instructions:  instructions,
@@ -234,18 +518,35 @@ func (a *App) CreateProject(user, goalPeople, goalAmount, category, projectType
 		Locked:        false,
 		Published:     false,
 		Total:         0,
+		PledgeRules:   rules,
+		// amounts of participants are visible only to those who manage payments until owner opens them
+		PrivateAmounts: true,
+	}
+	if template != 0 {
+		t, err := a.getTemplate(user, template)
+		if err != nil {
+			return 0, err
+		}
+		applyTemplate(&newProject, t)
+	}
+
+	err = a.projectModel.Create(&newProject)
+	if err != nil {
+		return 0, err
 	}
 
-	return newProject.ID, a.projectModel.Create(&newProject)
+	return newProject.ID, a.saveRevision(&newProject, user)
 }
 
-// UpdateProject updates prject.
-func (a *App) UpdateProject(id, user, goalPeople, goalAmount, category, projectType int, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, published, dropEventDate bool) (*ExtendedProject, error) {
+// UpdateProject updates prject, saved state is stored as revision.
+// Drafts of moderated categories are submitted to review instead of publication,
+// editing draft after review withdraws approval.
+func (a *App) UpdateProject(id, user, goalPeople int, goalAmount int64, category, projectType int, currency, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, rules *models.PledgeRules, published, dropEventDate bool) (*ExtendedProject, error) {
 	project, ok := a.projectModel.Get(id)
 	if !ok {
 		return nil, ErrProjectNotFound
 	}
-	if project.Published || project.OwnerID != user {
+	if project.Published || !a.can(project, user, permEdit) {
 		return nil, ErrProjectModifyNotAllowed
 	}
 
@@ -254,26 +555,101 @@ func (a *App) UpdateProject(id, user, goalPeople, goalAmount, category, projectT
 		if err != nil {
 			return nil, err
 		}
+		project.EventDate = time.Time{}
+		err = a.saveRevision(project, user)
+		if err != nil {
+			return nil, err
+		}
 		return a.extendProject(project)
 	}
+	if currency != "" {
+		normalized, err := money.NormalizeCurrency(currency)
+		if err != nil {
+			return nil, ErrProjectWrongCurrency
+		}
+		project.Currency = normalized
+	}
+	if rules != nil {
+		err := validatePledgeRules(rules)
+		if err != nil {
+			return nil, err
+		}
+		project.PledgeRules = *rules
+		err = a.projectModel.UpdatePledgeRules(project)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	project.Title = title
-	project.SubTitle = subtitle
-	project.Instructions = instructions
-	project.Description = descr
-	project.ImageLink = imageLink
-	project.CategoryID = category
-	project.ProjectTypeID = projectType
-	project.GoalAmount = goalAmount
-	project.GoalPeople = goalPeople
-	project.ReleaseDate = releaseDate
-	project.EventDate = eventTime
+	moderated := a.isModeratedCategory(project, category)
+	// only set fields are saved, unset ones keep stored values
+	if title != "" {
+		project.Title = title
+	}
+	if subtitle != "" {
+		project.SubTitle = subtitle
+	}
+	if instructions != "" {
+		project.Instructions = instructions
+	}
+	if descr != "" {
+		project.Description = descr
+	}
+	if imageLink != "" {
+		project.ImageLink = imageLink
+	}
+	if category != 0 {
+		project.CategoryID = category
+	}
+	if projectType != 0 {
+		project.ProjectTypeID = projectType
+	}
+	if goalAmount != 0 {
+		project.GoalAmount = goalAmount
+	}
+	if goalPeople != 0 {
+		project.GoalPeople = goalPeople
+	}
+	if !releaseDate.IsZero() {
+		project.ReleaseDate = releaseDate
+	}
+	if !eventTime.IsZero() {
+		project.EventDate = eventTime
+	}
+	// changes after review need another approval
+	review := project.ReviewStatus
+	if review == models.ReviewPending || review == models.ReviewApproved {
+		project.ReviewStatus = ""
+	}
+	submit := published && requiresReview(project, moderated)
+	if submit {
+		published = false
+	}
 	project.Published = published
 
 	err := a.projectModel.Update(project)
 	if err != nil {
 		return nil, err
 	}
+	err = a.saveRevision(project, user)
+	if err != nil {
+		return nil, err
+	}
+	if submit {
+		err = submitForReview(a.projectModel, a.userModel, a.notifier, project)
+	} else if project.ReviewStatus != review {
+		err = a.projectModel.UpdateReview(project)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if published {
+		a.notifier.Notify(Event{
+			Type:      EventProjectPublished,
+			ProjectID: project.ID,
+			Data:      map[string]interface{}{"title": project.Title},
+		})
+	}
 
 	return a.extendProject(project)
 }
@@ -291,42 +667,135 @@ func (a *App) DeleteProject(userID, projectID int) error {
 	return a.projectModel.Delete(project)
 }
 
-// GetUserDonations returns donations for user.
-func (a *App) GetUserDonations(id int) ([]models.Donation, error) {
-	return a.donationModel.GetAllByUser(id)
+// SetProjectPrivacy hides or shows amounts of project participants.
+func (a *App) SetProjectPrivacy(userID, projectID int, privateAmounts bool) (*ExtendedProject, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if !a.can(project, userID, permEdit) {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	project.PrivateAmounts = privateAmounts
+	err := a.projectModel.UpdatePrivacy(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.extendProject(project)
 }
 
-// GetProjectDonations returns donations for project.
-func (a *App) GetProjectDonations(id int) ([]ShortDonation, error) {
-	donations, err := a.donationModel.GetAllByProject(id)
+// GetUserDonations returns page of donations for user, zero limit means all donations.
+func (a *App) GetUserDonations(id, cursor, limit int) ([]models.Donation, int, bool, error) {
+	donations, err := a.donationModel.GetAllByUser(id, cursor, pageFetchLimit(limit))
 	if err != nil {
-		return nil, err
+		return nil, 0, false, err
+	}
+	n, next, hasNext := cutPage(len(donations), limit, func(i int) int { return donations[i].ID })
+
+	return donations[:n], next, hasNext, nil
+}
+
+// GetProjectDonations returns page of donations for project, zero limit means all donations.
+// Anonymous donors and private amounts are hidden from everyone except donor and those who manage payments.
+func (a *App) GetProjectDonations(id, viewerID, cursor, limit int) ([]ShortDonation, int, bool, error) {
+	project, ok := a.projectModel.Get(id)
+	if !ok {
+		return nil, 0, false, ErrProjectNotFound
+	}
+	donations, err := a.donationModel.GetPageByProject(id, cursor, pageFetchLimit(limit))
+	if err != nil {
+		return nil, 0, false, err
 	}
+	n, next, hasNext := cutPage(len(donations), limit, func(i int) int { return donations[i].ID })
+	donations = donations[:n]
 	projectDonations := make([]ShortDonation, 0, len(donations))
+	managesPayments := a.can(project, viewerID, permPayments)
 
 	for _, donation := range donations {
-		projectDonations = append(projectDonations, ShortDonation{
-			ID:     donation.ID,
-			User:   donation.User,
-			Locked: donation.Locked,
-			Paid:   donation.Paid,
-		})
+		trusted := managesPayments || viewerID == donation.UserID
+		sd := ShortDonation{
+			ID:        donation.ID,
+			User:      donation.User,
+			Anonymous: donation.Anonymous,
+			Locked:    donation.Locked,
+			Paid:      donation.Paid,
+		}
+		if donation.Anonymous && !trusted {
+			sd.User = models.User{}
+		}
+		if !project.PrivateAmounts || trusted {
+			payment := donation.Payment
+			sd.Payment = &payment
+		}
+		projectDonations = append(projectDonations, sd)
 	}
 
-	return projectDonations, nil
+	return projectDonations, next, hasNext, nil
 }
 
 // CreateDonation creates new donation.
-func (a *App) CreateDonation(userID, projectID, payment int) (*models.Donation, error) {
+func (a *App) CreateDonation(userID, projectID, tierID int, payment int64, anonymous bool, message string) (*models.Donation, error) {
+	err := validateMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	strategy, err := GetStrategy(&project.ProjectType, a.projectModel)
+	if err != nil {
+		return nil, err
+	}
+	if project.Locked && !strategy.JoinAfterLock() {
+		return nil, models.ErrDonationForbidden
+	}
+	if tierID != 0 {
+		tier, ok := a.tierModel.Get(tierID)
+		if !ok || tier.ProjectID != projectID {
+			return nil, ErrTierNotFound
+		}
+		if payment == 0 {
+			payment = tier.Amount
+		}
+		err = validatePledge(project, strategy, tier, payment)
+	} else {
+		err = validatePledge(project, strategy, nil, payment)
+	}
+	if err != nil {
+		return nil, err
+	}
 	donation := &models.Donation{
 		UserID:    userID,
 		ProjectID: projectID,
+		TierID:    tierID,
 		Payment:   payment,
+		Locked:    project.Locked,
+		Anonymous: anonymous,
+		CreatedAt: a.clock.Now(),
 	}
-	err := a.donationModel.Create(donation)
+	if message != "" {
+		if !a.messageLimiter.Allow(userID) {
+			return nil, ErrMessageRateLimit
+		}
+		donation.Message = message
+		donation.MessageAt = a.clock.Now()
+	}
+	err = a.donationModel.Create(donation, strategy.JoinAfterLock())
 	if err != nil {
-		return nil, err
+		return nil, pledgeError(err)
 	}
+	a.notifier.Notify(Event{
+		Type:      EventParticipantJoined,
+		ProjectID: projectID,
+		UserIDs:   []int{project.OwnerID},
+		Data: map[string]interface{}{
+			"donation":  donation.ID,
+			"user":      userID,
+			"anonymous": anonymous,
+		},
+	})
 	a.reCalcCh <- donation.ProjectID
 
 	return donation, nil
@@ -338,21 +807,60 @@ func (a *App) DeleteDonation(donationID, userID int) error {
 	if !ok {
 		return ErrDonationNotFound
 	}
-	if donation.Locked || donation.UserID != userID {
+	if donation.UserID != userID {
 		return ErrDonationModifyNotAllowed
 	}
+	if donation.Locked {
+		allowed, err := a.canLeaveLocked(donation)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return ErrDonationModifyNotAllowed
+		}
+	}
 
 	err := a.donationModel.Delete(donation)
 	if err != nil {
 		return err
 	}
+	a.notifier.Notify(Event{
+		Type:      EventParticipantLeft,
+		ProjectID: donation.ProjectID,
+		UserIDs:   []int{donation.Project.OwnerID},
+		Data: map[string]interface{}{
+			"donation":  donation.ID,
+			"user":      userID,
+			"anonymous": donation.Anonymous,
+		},
+	})
 	a.reCalcCh <- donation.ProjectID
 
 	return nil
 }
 
+// canLeaveLocked checks participant can leave locked project before payment.
+func (a *App) canLeaveLocked(donation *models.Donation) (bool, error) {
+	if donation.Paid || donation.PaidAmount > 0 {
+		return false, nil
+	}
+	project, ok := a.projectModel.Get(donation.ProjectID)
+	if !ok {
+		return false, ErrProjectNotFound
+	}
+	if project.Closed {
+		return false, nil
+	}
+	strategy, err := GetStrategy(&project.ProjectType, a.projectModel)
+	if err != nil {
+		return false, err
+	}
+
+	return strategy.JoinAfterLock(), nil
+}
+
 // UpdateDonation updates donation by id.
-func (a *App) UpdateDonation(donationID, userID, payment int, paid bool) (*models.Donation, error) {
+func (a *App) UpdateDonation(donationID, userID int, payment int64, paid bool) (*models.Donation, error) {
 	donation, ok := a.donationModel.Get(donationID)
 	if !ok {
 		return nil, ErrDonationNotFound
@@ -361,10 +869,23 @@ func (a *App) UpdateDonation(donationID, userID, payment int, paid bool) (*model
 		if payment != 0 {
 			return nil, ErrDonationModifyWrong
 		}
-		if donation.Project.OwnerID != userID {
+		if !a.can(&donation.Project, userID, permPayments) {
 			return nil, ErrDonationModifyNotAllowed
 		}
+		// credit is paid above share, it is kept until settled
+		if !paid && donation.Credit > 0 {
+			return nil, ErrDonationModifyWrong
+		}
 		donation.Paid = paid
+		if !paid {
+			donation.PaidAmount = 0
+		} else if donation.PaidAmount < donation.Payment {
+			donation.PaidAmount = donation.Payment
+		}
+		err := a.donationModel.UpdatePaid(donation)
+		if err != nil {
+			return nil, err
+		}
 	} else {
 		if payment == 0 {
 			return nil, ErrDonationModifyWrong
@@ -372,14 +893,338 @@ func (a *App) UpdateDonation(donationID, userID, payment int, paid bool) (*model
 		if donation.UserID != userID {
 			return nil, ErrDonationModifyNotAllowed
 		}
+		err := a.validateDonationPayment(donation, payment)
+		if err != nil {
+			return nil, err
+		}
 		donation.Payment = payment
+		err = a.donationModel.UpdatePayment(donation)
+		if err != nil {
+			return nil, pledgeError(err)
+		}
+	}
+	if donation.Locked && paid {
+		a.notifier.Notify(Event{
+			Type:      EventPaymentConfirmed,
+			ProjectID: donation.ProjectID,
+			UserIDs:   []int{donation.UserID},
+			Data: map[string]interface{}{
+				"title":    donation.Project.Title,
+				"amount":   donation.PaidAmount,
+				"currency": donation.Currency,
+			},
+		})
+	}
+	a.reCalcCh <- donation.ProjectID
+
+	return donation, nil
+}
+
+// SetDonationAnonymity hides or shows donor in project participant list.
+func (a *App) SetDonationAnonymity(donationID, userID int, anonymous bool) (*models.Donation, error) {
+	donation, ok := a.donationModel.Get(donationID)
+	if !ok {
+		return nil, ErrDonationNotFound
+	}
+	if donation.UserID != userID {
+		return nil, ErrDonationModifyNotAllowed
+	}
+	donation.Anonymous = anonymous
+	err := a.donationModel.UpdateAnonymity(donation)
+	if err != nil {
+		return nil, err
 	}
 
-	err := a.donationModel.Update(donation)
+	return donation, nil
+}
+
+// SetDonationMessage sets or clears message of donation until project lock.
+func (a *App) SetDonationMessage(donationID, userID int, message string) (*models.Donation, error) {
+	err := validateMessage(message)
 	if err != nil {
 		return nil, err
 	}
-	a.reCalcCh <- donation.ProjectID
+	donation, ok := a.donationModel.Get(donationID)
+	if !ok {
+		return nil, ErrDonationNotFound
+	}
+	if donation.UserID != userID || donation.Locked {
+		return nil, ErrDonationModifyNotAllowed
+	}
+	if message != "" && !a.messageLimiter.Allow(userID) {
+		return nil, ErrMessageRateLimit
+	}
+	donation.Message = message
+	donation.MessageHidden = false
+	donation.MessageAt = time.Time{}
+	if message != "" {
+		donation.MessageAt = a.clock.Now()
+	}
+	err = a.donationModel.UpdateMessage(donation)
+	if err != nil {
+		return nil, err
+	}
+
+	return donation, nil
+}
+
+// HideDonationMessage hides or shows donation message in guestbook by project owner or moderator.
+func (a *App) HideDonationMessage(donationID, userID int, hidden bool) (*models.Donation, error) {
+	donation, ok := a.donationModel.Get(donationID)
+	if !ok {
+		return nil, ErrDonationNotFound
+	}
+	if !a.can(&donation.Project, userID, permModerate) {
+		return nil, ErrDonationModifyNotAllowed
+	}
+	if donation.Message == "" {
+		return nil, ErrDonationModifyWrong
+	}
+	donation.MessageHidden = hidden
+	err := a.donationModel.UpdateMessage(donation)
+	if err != nil {
+		return nil, err
+	}
+
+	return donation, nil
+}
+
+// DeleteDonationMessage deletes donation message by project owner or moderator.
+func (a *App) DeleteDonationMessage(donationID, userID int) error {
+	donation, ok := a.donationModel.Get(donationID)
+	if !ok {
+		return ErrDonationNotFound
+	}
+	if !a.can(&donation.Project, userID, permModerate) {
+		return ErrDonationModifyNotAllowed
+	}
+	donation.Message = ""
+	donation.MessageHidden = false
+	donation.MessageAt = time.Time{}
+
+	return a.donationModel.UpdateMessage(donation)
+}
+
+// GetGuestbook returns page of project guestbook.
+// Hidden messages are visible only to project owner and moderators.
+func (a *App) GetGuestbook(projectID, viewerID, page, pageSize int) ([]GuestbookEntry, int, bool, error) {
+	var next int
+	var hasNext bool
+
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, next, hasNext, ErrProjectNotFound
+	}
+	isModerator := a.can(project, viewerID, permModerate)
+	donations, count, err := a.donationModel.GetMessagesByProject(projectID, page, pageSize, isModerator)
+	if err != nil {
+		return nil, next, hasNext, err
+	}
+	if page*pageSize < count {
+		next, hasNext = page+1, true
+	}
+	entries := make([]GuestbookEntry, 0, len(donations))
+	for _, donation := range donations {
+		entry := GuestbookEntry{
+			DonationID: donation.ID,
+			User:       donation.User,
+			Anonymous:  donation.Anonymous,
+			Message:    donation.Message,
+			Hidden:     donation.MessageHidden,
+			CreatedAt:  donation.MessageAt,
+		}
+		if donation.Anonymous && !isModerator && donation.UserID != viewerID {
+			entry.User = models.User{}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, next, hasNext, nil
+}
+
+// validateDonationPayment checks new payment of existing donation against project pledge rules.
+func (a *App) validateDonationPayment(donation *models.Donation, payment int64) error {
+	project, ok := a.projectModel.Get(donation.ProjectID)
+	if !ok {
+		return ErrProjectNotFound
+	}
+	strategy, err := GetStrategy(&project.ProjectType, a.projectModel)
+	if err != nil {
+		return err
+	}
+	var tier *models.Tier
+	if donation.TierID != 0 {
+		tier, ok = a.tierModel.Get(donation.TierID)
+		if !ok {
+			tier = nil
+		}
+	}
+
+	return validatePledge(project, strategy, tier, payment)
+}
+
+// validatePledgeRules checks pledge rules of project.
+func validatePledgeRules(r *models.PledgeRules) error {
+	verr := &ValidationError{}
+	if r.MinPledge < 0 {
+		verr.add("pledge.min", CodeMin, "minimum pledge can't be negative")
+	}
+	if r.MaxPledge < 0 {
+		verr.add("pledge.max", CodeMin, "maximum pledge can't be negative")
+	}
+	if r.MaxPledge > 0 && r.MaxPledge < r.MinPledge {
+		verr.add("pledge.max", CodeMin, "maximum pledge is less than minimum")
+	}
+	if r.PledgeStep < 0 {
+		verr.add("pledge.step", CodeMin, "pledge step can't be negative")
+	}
+
+	return verr.errOrNil()
+}
+
+// validatePledge checks payment against project pledge rules.
+// Overfunding depends on other pledges, so it is checked by donation model.
+func validatePledge(p *models.Project, strategy Strategy, tier *models.Tier, payment int64) error {
+	verr := &ValidationError{}
+	if !strategy.PaymentByUser() {
+		if payment != 0 {
+			verr.add("payment", CodeNotAllowed, "payment is not allowed for this project type")
+		}
+		return verr.errOrNil()
+	}
+	if payment <= 0 {
+		verr.add("payment", CodeRequired, "payment must be positive")
+		return verr
+	}
+	amount := func(v int64) string {
+		return money.New(v, p.Currency).String()
+	}
+	if tier != nil && payment < tier.Amount {
+		verr.add("payment", CodeMin, fmt.Sprintf("payment for tier must be at least %s", amount(tier.Amount)))
+	}
+	if p.MinPledge > 0 && payment < p.MinPledge {
+		verr.add("payment", CodeMin, fmt.Sprintf("payment must be at least %s", amount(p.MinPledge)))
+	}
+	if p.MaxPledge > 0 && payment > p.MaxPledge {
+		verr.add("payment", CodeMax, fmt.Sprintf("payment must be at most %s", amount(p.MaxPledge)))
+	}
+	if p.PledgeStep > 0 && payment%p.PledgeStep != 0 {
+		verr.add("payment", CodeStep, fmt.Sprintf("payment must be a multiple of %s", amount(p.PledgeStep)))
+	}
+
+	return verr.errOrNil()
+}
+
+// pledgeError converts overfunding found by donation model to validation error.
+func pledgeError(err error) error {
+	oerr, ok := err.(*models.OverfundingError)
+	if !ok {
+		return err
+	}
+	verr := &ValidationError{}
+	remaining := money.New(oerr.Remaining, oerr.Currency).String()
+	verr.add("payment", CodeRemaining, fmt.Sprintf("payment exceeds remaining amount %s", remaining))
+
+	return verr
+}
+
+// validateMessage checks donation message.
+func validateMessage(message string) error {
+	verr := &ValidationError{}
+	if utf8.RuneCountInString(message) > maxMessageLength {
+		verr.add("message", CodeMax, fmt.Sprintf("message must be at most %d characters", maxMessageLength))
+	}
+
+	return verr.errOrNil()
+}
+
+// SettleCredit marks credit of donation as returned to participant.
+func (a *App) SettleCredit(donationID, userID int) (*models.Donation, error) {
+	donation, ok := a.donationModel.Get(donationID)
+	if !ok {
+		return nil, ErrDonationNotFound
+	}
+	if !a.can(&donation.Project, userID, permPayments) {
+		return nil, ErrDonationModifyNotAllowed
+	}
+	if donation.Credit == 0 {
+		return nil, ErrDonationModifyWrong
+	}
+	credit := donation.Credit
+	donation.PaidAmount -= donation.Credit
+	donation.Credit = 0
+
+	err := a.donationModel.UpdatePaid(donation)
+	if err != nil {
+		return nil, err
+	}
+	a.notifier.Notify(Event{
+		Type:      EventCreditSettled,
+		ProjectID: donation.ProjectID,
+		UserIDs:   []int{donation.UserID},
+		Data: map[string]interface{}{
+			"amount":   credit,
+			"currency": donation.Currency,
+		},
+	})
 
 	return donation, nil
 }
+
+// GetUserAdjustments returns share adjustments of user.
+func (a *App) GetUserAdjustments(userID int) ([]models.Adjustment, error) {
+	return a.adjustmentModel.GetAllByUser(userID)
+}
+
+// GetProjectTiers returns reward tiers of project.
+func (a *App) GetProjectTiers(projectID int) ([]models.Tier, error) {
+	return a.tierModel.GetAllByProject(projectID)
+}
+
+// CreateTier creates reward tier for not published project.
+func (a *App) CreateTier(userID, projectID int, amount int64, quantity int, title, descr string) (*models.Tier, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if project.Published || !a.can(project, userID, permEdit) {
+		return nil, ErrTierModifyNotAllowed
+	}
+	strategy, err := GetStrategy(&project.ProjectType, a.projectModel)
+	if err != nil {
+		return nil, err
+	}
+	if !strategy.PaymentByUser() || amount <= 0 || quantity < 0 || title == "" {
+		return nil, ErrTierWrong
+	}
+	tier := &models.Tier{
+		ProjectID:   projectID,
+		Amount:      amount,
+		Quantity:    quantity,
+		Title:       title,
+		Description: descr,
+	}
+	err = a.tierModel.Create(tier)
+	if err != nil {
+		return nil, err
+	}
+
+	return tier, nil
+}
+
+// DeleteTier deletes reward tier of not published project.
+func (a *App) DeleteTier(userID, tierID int) error {
+	tier, ok := a.tierModel.Get(tierID)
+	if !ok {
+		return ErrTierNotFound
+	}
+	project, ok := a.projectModel.Get(tier.ProjectID)
+	if !ok {
+		return ErrProjectNotFound
+	}
+	if project.Published || !a.can(project, userID, permEdit) {
+		return ErrTierModifyNotAllowed
+	}
+
+	return a.tierModel.Delete(tier)
+}
@@ -28,7 +28,7 @@ func (s *AuthSuite) SetupTest() {
 	s.mockProviderCtl = gomock.NewController(s.T())
 	s.mockProvider = mocks.NewMockProvider(s.mockProviderCtl)
 
-	s.app = New(nil, s.mockUser, nil, nil, nil, s.mockProvider, clockwork.NewFakeClock(), "secret", nil)
+	s.app = New(nil, s.mockUser, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockProvider, nil, clockwork.NewFakeClock(), "secret", nil)
 }
 
 func (s *AuthSuite) TearDownTest() {
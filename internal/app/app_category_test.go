@@ -20,7 +20,7 @@ type CategorySuite struct {
 func (s *CategorySuite) SetupTest() {
 	s.mockCategoryCtl = gomock.NewController(s.T())
 	s.mockCategory = mocks.NewMockCategoryImpl(s.mockCategoryCtl)
-	s.app = New(s.mockCategory, nil, nil, nil, nil, nil, nil, "", nil)
+	s.app = New(s.mockCategory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
 }
 
 func (s *CategorySuite) TearDownTest() {
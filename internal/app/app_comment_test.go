@@ -0,0 +1,192 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type CommentSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockUserCtl         *gomock.Controller
+	mockUser            *mocks.MockUserImpl
+	mockCommentCtl      *gomock.Controller
+	mockComment         *mocks.MockCommentImpl
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *CommentSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockCommentCtl = gomock.NewController(s.T())
+	s.mockComment = mocks.NewMockCommentImpl(s.mockCommentCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.app = New(nil, s.mockUser, s.mockProject, nil, nil, nil, nil, s.mockComment, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, nil, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *CommentSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockCommentCtl.Finish()
+}
+
+func (s *CommentSuite) project(published bool) *models.Project {
+	return &models.Project{
+		ID:        10,
+		OwnerID:   42,
+		Published: published,
+	}
+}
+
+func (s *CommentSuite) TestParseMentions() {
+	s.Require().Equal([]string{"john", "jane_doe"}, parseMentions("@john ask @jane_doe, not mail@example.com or @john"))
+	s.Require().Equal([]string{}, parseMentions("no mentions"))
+}
+
+func (s *CommentSuite) TestGetProjectComments() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(true), true)
+	comments := []models.Comment{
+		{ID: 1, ProjectID: 10, Text: "@john what about delivery?", MentionIDs: []int{7}},
+		{ID: 3, ProjectID: 10, Text: "spam", Deleted: true},
+		{ID: 4, ProjectID: 10, Text: "next page"},
+	}
+	s.mockComment.EXPECT().GetThreads(10, 0, 3).Return(comments, nil)
+	john := models.User{ID: 7, Username: "john"}
+	s.mockUser.EXPECT().GetByIDs([]int{7}).Return([]models.User{john}, nil)
+
+	result, next, hasNext, err := s.app.GetProjectComments(10, 5, 0, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(3, next)
+	s.Require().True(hasNext)
+	s.Require().Equal([]models.Comment{
+		{ID: 1, ProjectID: 10, Text: "@john what about delivery?", MentionIDs: []int{7}, Mentions: []models.User{john}},
+		{ID: 3, ProjectID: 10, Deleted: true, Mentions: []models.User{}},
+	}, result)
+}
+
+func (s *CommentSuite) TestGetProjectCommentsDraft() {
+	s.mockCollaborator.EXPECT().Get(10, 5).Return(nil, false)
+	s.mockProject.EXPECT().Get(10).Return(s.project(false), true)
+
+	result, _, _, err := s.app.GetProjectComments(10, 5, 0, 2)
+	s.Require().Equal(ErrProjectNotFound, err)
+	s.Require().Nil(result)
+}
+
+func (s *CommentSuite) TestGetCommentReplies() {
+	s.mockComment.EXPECT().Get(1).Return(&models.Comment{ID: 1, ProjectID: 10}, true)
+	s.mockProject.EXPECT().Get(10).Return(s.project(false), true)
+	s.mockComment.EXPECT().GetReplies(1, 2, 21).Return([]models.Comment{{ID: 5, ParentID: 1}}, nil)
+	s.mockUser.EXPECT().GetByIDs([]int{}).Return([]models.User{}, nil)
+
+	result, next, hasNext, err := s.app.GetCommentReplies(1, 42, 2, 20)
+	s.Require().NoError(err)
+	s.Require().Equal(0, next)
+	s.Require().False(hasNext)
+	s.Require().Len(result, 1)
+}
+
+func (s *CommentSuite) TestCreateComment() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(true), true)
+	jane := models.User{ID: 8, Username: "jane"}
+	s.mockUser.EXPECT().GetByUsernames([]string{"jane", "nobody"}).Return([]models.User{jane}, nil)
+	expect := &models.Comment{
+		ProjectID:  10,
+		UserID:     5,
+		Text:       "@jane @nobody thanks",
+		MentionIDs: []int{8},
+		Mentions:   []models.User{jane},
+		CreatedAt:  s.clock.Now(),
+	}
+	s.mockComment.EXPECT().Create(expect).Return(nil)
+
+	comment, err := s.app.CreateComment(5, 10, 0, "@jane @nobody thanks")
+	s.Require().NoError(err)
+	s.Require().Equal(expect, comment)
+}
+
+func (s *CommentSuite) TestCreateReplyToReply() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(true), true)
+	s.mockComment.EXPECT().Get(6).Return(&models.Comment{ID: 6, ProjectID: 10, ParentID: 2}, true)
+	s.mockUser.EXPECT().GetByUsernames([]string{}).Return([]models.User{}, nil)
+	s.mockComment.EXPECT().Create(gomock.Any()).Return(nil)
+
+	comment, err := s.app.CreateComment(5, 10, 6, "agree")
+	s.Require().NoError(err)
+	s.Require().Equal(2, comment.ParentID)
+}
+
+func (s *CommentSuite) TestCreateCommentWrongParent() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(true), true)
+	s.mockComment.EXPECT().Get(6).Return(&models.Comment{ID: 6, ProjectID: 11}, true)
+
+	comment, err := s.app.CreateComment(5, 10, 6, "agree")
+	s.Require().Equal(ErrCommentNotFound, err)
+	s.Require().Nil(comment)
+}
+
+func (s *CommentSuite) TestCreateCommentEmpty() {
+	comment, err := s.app.CreateComment(5, 10, 0, "")
+	s.Require().IsType(&ValidationError{}, err)
+	s.Require().Nil(comment)
+}
+
+func (s *CommentSuite) TestUpdateComment() {
+	s.mockComment.EXPECT().Get(1).Return(&models.Comment{ID: 1, UserID: 5, Text: "old"}, true)
+	s.mockUser.EXPECT().GetByUsernames([]string{}).Return([]models.User{}, nil)
+	s.mockComment.EXPECT().Update(gomock.Any()).Return(nil)
+
+	comment, err := s.app.UpdateComment(1, 5, "new")
+	s.Require().NoError(err)
+	s.Require().Equal("new", comment.Text)
+	s.Require().Equal(s.clock.Now(), *comment.EditedAt)
+}
+
+func (s *CommentSuite) TestUpdateCommentNotAuthor() {
+	s.mockComment.EXPECT().Get(1).Return(&models.Comment{ID: 1, UserID: 5, Text: "old"}, true)
+
+	comment, err := s.app.UpdateComment(1, 6, "new")
+	s.Require().Equal(ErrCommentModifyNotAllowed, err)
+	s.Require().Nil(comment)
+}
+
+func (s *CommentSuite) TestDeleteCommentByOwner() {
+	s.mockComment.EXPECT().Get(1).Return(&models.Comment{ID: 1, UserID: 5, ProjectID: 10}, true)
+	s.mockProject.EXPECT().Get(10).Return(s.project(true), true)
+	s.mockComment.EXPECT().Update(&models.Comment{ID: 1, UserID: 5, ProjectID: 10, Deleted: true}).Return(nil)
+
+	s.Require().NoError(s.app.DeleteComment(1, 42))
+}
+
+func (s *CommentSuite) TestDeleteCommentNotAllowed() {
+	s.mockCollaborator.EXPECT().Get(10, 6).Return(nil, false)
+	s.mockComment.EXPECT().Get(1).Return(&models.Comment{ID: 1, UserID: 5, ProjectID: 10}, true)
+	s.mockProject.EXPECT().Get(10).Return(s.project(true), true)
+
+	s.Require().Equal(ErrCommentModifyNotAllowed, s.app.DeleteComment(1, 6))
+}
+
+func (s *CommentSuite) TestDeleteCommentAlreadyDeleted() {
+	s.mockComment.EXPECT().Get(1).Return(&models.Comment{ID: 1, UserID: 5, Deleted: true}, true)
+
+	s.Require().Equal(ErrCommentNotFound, s.app.DeleteComment(1, 5))
+}
+
+func TestCommentSuite(t *testing.T) {
+	suite.Run(t, new(CommentSuite))
+}
@@ -1,9 +1,12 @@
 package app
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/FreakyGranny/launchpad-api/internal/mocks"
@@ -12,30 +15,55 @@ import (
 
 type DonationSuite struct {
 	suite.Suite
-	mockDonationCtl *gomock.Controller
-	mockDonation    *mocks.MockDonationImpl
-	recalcChan      chan int
-	app             *App
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockDonationCtl     *gomock.Controller
+	mockDonation        *mocks.MockDonationImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockAdjustCtl       *gomock.Controller
+	mockAdjustment      *mocks.MockAdjustmentImpl
+	mockTierCtl         *gomock.Controller
+	mockTier            *mocks.MockTierImpl
+	notifier            *fakeNotifier
+	recalcChan          chan int
+	clock               clockwork.FakeClock
+	app                 *App
 }
 
 func (s *DonationSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
 	s.mockDonationCtl = gomock.NewController(s.T())
 	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockAdjustCtl = gomock.NewController(s.T())
+	s.mockAdjustment = mocks.NewMockAdjustmentImpl(s.mockAdjustCtl)
 	s.recalcChan = make(chan int, 1)
-	s.app = New(nil, nil, nil, nil, s.mockDonation, nil, nil, "", s.recalcChan)
+	s.mockTierCtl = gomock.NewController(s.T())
+	s.mockTier = mocks.NewMockTierImpl(s.mockTierCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.notifier = &fakeNotifier{}
+	s.app = New(nil, nil, s.mockProject, nil, s.mockDonation, s.mockAdjustment, s.mockTier, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, nil, nil, nil, s.notifier, s.clock, "", s.recalcChan)
 }
 
 func (s *DonationSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
 	s.mockDonationCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockAdjustCtl.Finish()
+	s.mockTierCtl.Finish()
 	close(s.recalcChan)
 }
 
-func (s *DonationSuite) TestGetProjectDonations() {
-	donations := []models.Donation{
+func (s *DonationSuite) projectDonations() []models.Donation {
+	return []models.Donation{
 		{
 			ID:      1,
 			Payment: 100,
 			Paid:    true,
+			UserID:  1,
 			User: models.User{
 				ID:        1,
 				FirstName: "John",
@@ -43,9 +71,11 @@ func (s *DonationSuite) TestGetProjectDonations() {
 			},
 		},
 		{
-			ID:      2,
-			Payment: 200,
-			Paid:    true,
+			ID:        2,
+			Payment:   200,
+			Paid:      true,
+			Anonymous: true,
+			UserID:    2,
 			User: models.User{
 				ID:        2,
 				FirstName: "Jane",
@@ -53,10 +83,90 @@ func (s *DonationSuite) TestGetProjectDonations() {
 			},
 		},
 	}
-	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil)
-	dons, err := s.app.GetProjectDonations(1)
+}
+
+func (s *DonationSuite) TestGetProjectDonations() {
+	s.mockCollaborator.EXPECT().Get(1, 3).Return(nil, false)
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 42}, true)
+	s.mockDonation.EXPECT().GetPageByProject(1, 0, 0).Return(s.projectDonations(), nil)
+	dons, next, hasNext, err := s.app.GetProjectDonations(1, 3, 0, 0)
 	s.Require().NoError(err)
-	s.Require().Equal(2, len(dons))
+	s.Require().Equal(0, next)
+	s.Require().False(hasNext)
+	first, second := int64(100), int64(200)
+	s.Require().Equal([]ShortDonation{
+		{
+			ID:      1,
+			Paid:    true,
+			Payment: &first,
+			User: models.User{
+				ID:        1,
+				FirstName: "John",
+				LastName:  "Doe",
+			},
+		},
+		{
+			ID:        2,
+			Paid:      true,
+			Anonymous: true,
+			Payment:   &second,
+		},
+	}, dons)
+}
+
+func (s *DonationSuite) TestGetProjectDonationsPrivate() {
+	s.mockCollaborator.EXPECT().Get(1, 2).Return(nil, false)
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 42, PrivateAmounts: true}, true)
+	s.mockDonation.EXPECT().GetPageByProject(1, 0, 0).Return(s.projectDonations(), nil)
+	dons, _, _, err := s.app.GetProjectDonations(1, 2, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Nil(dons[0].Payment)
+	s.Require().Equal(int64(200), *dons[1].Payment)
+	s.Require().Equal(2, dons[1].User.ID)
+}
+
+func (s *DonationSuite) TestGetProjectDonationsOwner() {
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 42, PrivateAmounts: true}, true)
+	s.mockDonation.EXPECT().GetPageByProject(1, 0, 0).Return(s.projectDonations(), nil)
+	dons, _, _, err := s.app.GetProjectDonations(1, 42, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), *dons[0].Payment)
+	s.Require().Equal(int64(200), *dons[1].Payment)
+	s.Require().Equal(2, dons[1].User.ID)
+}
+
+func (s *DonationSuite) TestGetProjectDonationsNotFound() {
+	s.mockProject.EXPECT().Get(1).Return(nil, false)
+	dons, _, _, err := s.app.GetProjectDonations(1, 42, 0, 0)
+	s.Require().Equal(ErrProjectNotFound, err)
+	s.Require().Nil(dons)
+}
+
+func (s *DonationSuite) TestGetProjectDonationsPage() {
+	s.mockCollaborator.EXPECT().Get(1, 3).Return(nil, false)
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 42}, true)
+	s.mockDonation.EXPECT().GetPageByProject(1, 5, 2).Return(s.projectDonations(), nil)
+	dons, next, hasNext, err := s.app.GetProjectDonations(1, 3, 5, 1)
+	s.Require().NoError(err)
+	s.Require().Len(dons, 1)
+	s.Require().Equal(1, next)
+	s.Require().True(hasNext)
+}
+
+func (s *DonationSuite) TestSetDonationAnonymity() {
+	donation := &models.Donation{ID: 1, UserID: 111, ProjectID: 10, Locked: true}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockDonation.EXPECT().UpdateAnonymity(donation).Return(nil)
+	newDon, err := s.app.SetDonationAnonymity(1, 111, true)
+	s.Require().NoError(err)
+	s.Require().True(newDon.Anonymous)
+}
+
+func (s *DonationSuite) TestSetDonationAnonymityWrongUser() {
+	s.mockDonation.EXPECT().Get(1).Return(&models.Donation{ID: 1, UserID: 111}, true)
+	newDon, err := s.app.SetDonationAnonymity(1, 112, true)
+	s.Require().Equal(ErrDonationModifyNotAllowed, err)
+	s.Require().Nil(newDon)
 }
 
 func (s *DonationSuite) TestGetUserDonations() {
@@ -74,11 +184,37 @@ func (s *DonationSuite) TestGetUserDonations() {
 			ProjectID: 20,
 		},
 	}
-	s.mockDonation.EXPECT().GetAllByUser(111).Return(donations, nil)
+	s.mockDonation.EXPECT().GetAllByUser(111, 0, 0).Return(donations, nil)
 
-	dons, err := s.app.GetUserDonations(111)
+	dons, next, hasNext, err := s.app.GetUserDonations(111, 0, 0)
 	s.Require().NoError(err)
 	s.Require().Equal(donations, dons)
+	s.Require().Equal(0, next)
+	s.Require().False(hasNext)
+}
+
+func (s *DonationSuite) TestGetUserDonationsPage() {
+	donations := []models.Donation{{ID: 3}, {ID: 4}, {ID: 5}}
+	s.mockDonation.EXPECT().GetAllByUser(111, 2, 3).Return(donations, nil)
+
+	dons, next, hasNext, err := s.app.GetUserDonations(111, 2, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(donations[:2], dons)
+	s.Require().Equal(4, next)
+	s.Require().True(hasNext)
+}
+
+func (s *DonationSuite) fairProject(locked, closed bool) *models.Project {
+	return &models.Project{
+		ID:        10,
+		Published: true,
+		Locked:    locked,
+		Closed:    closed,
+		ProjectType: models.ProjectType{
+			GoalByAmount: true,
+			GoalByPeople: true,
+		},
+	}
 }
 
 func (s *DonationSuite) TestCreateDonation() {
@@ -86,11 +222,26 @@ func (s *DonationSuite) TestCreateDonation() {
 		Payment:   100,
 		ProjectID: 10,
 		UserID:    111,
+		CreatedAt: s.clock.Now(),
 	}
-	s.mockDonation.EXPECT().Create(donation).Return(nil)
-	newDon, err := s.app.CreateDonation(111, 10, 100)
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{
+		ID:      10,
+		OwnerID: 42,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}, true)
+	s.mockDonation.EXPECT().Create(donation, false).Return(nil)
+	newDon, err := s.app.CreateDonation(111, 10, 0, 100, false, "")
 	s.Require().NoError(err)
 	s.Require().Equal(donation, newDon)
+	s.Require().Equal([]Event{{
+		Type:      EventParticipantJoined,
+		ProjectID: 10,
+		UserIDs:   []int{42},
+		Data:      map[string]interface{}{"donation": 0, "user": 111, "anonymous": false},
+	}}, s.notifier.events)
 
 	select {
 	case x := <-s.recalcChan:
@@ -100,6 +251,110 @@ func (s *DonationSuite) TestCreateDonation() {
 	}
 }
 
+func (s *DonationSuite) TestCreateDonationLockedForbidden() {
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{
+		ID:     10,
+		Locked: true,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}, true)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 100, false, "")
+	s.Require().Equal(models.ErrDonationForbidden, err)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestCreateDonationLockedFair() {
+	donation := &models.Donation{
+		ProjectID: 10,
+		UserID:    111,
+		Locked:    true,
+		CreatedAt: s.clock.Now(),
+	}
+	s.mockProject.EXPECT().Get(10).Return(s.fairProject(true, false), true)
+	s.mockDonation.EXPECT().Create(donation, true).Return(nil)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 0, false, "")
+	s.Require().NoError(err)
+	s.Require().Equal(donation, newDon)
+
+	select {
+	case x := <-s.recalcChan:
+		s.Require().Equal(10, x)
+	default:
+		s.T().Fail()
+	}
+}
+
+func (s *DonationSuite) TestCreateDonationLockedMeanwhile() {
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+	s.mockDonation.EXPECT().Create(gomock.Any(), false).Return(models.ErrDonationForbidden)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 100, false, "")
+	s.Require().Equal(models.ErrDonationForbidden, err)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) moneyProject() *models.Project {
+	return &models.Project{
+		ID:        10,
+		Published: true,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}
+}
+
+func (s *DonationSuite) TestCreateDonationTier() {
+	donation := &models.Donation{
+		Payment:   500,
+		ProjectID: 10,
+		UserID:    111,
+		TierID:    3,
+		CreatedAt: s.clock.Now(),
+	}
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+	s.mockTier.EXPECT().Get(3).Return(&models.Tier{ID: 3, ProjectID: 10, Amount: 500}, true)
+	s.mockDonation.EXPECT().Create(donation, false).Return(nil)
+
+	newDon, err := s.app.CreateDonation(111, 10, 3, 0, false, "")
+	s.Require().NoError(err)
+	s.Require().Equal(donation, newDon)
+	<-s.recalcChan
+}
+
+func (s *DonationSuite) TestCreateDonationTierLowPayment() {
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+	s.mockTier.EXPECT().Get(3).Return(&models.Tier{ID: 3, ProjectID: 10, Amount: 500}, true)
+
+	newDon, err := s.app.CreateDonation(111, 10, 3, 100, false, "")
+	s.Require().IsType(&ValidationError{}, err)
+	s.Require().Equal(CodeMin, err.(*ValidationError).Fields[0].Code)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestCreateDonationTierOtherProject() {
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+	s.mockTier.EXPECT().Get(3).Return(&models.Tier{ID: 3, ProjectID: 11, Amount: 500}, true)
+
+	newDon, err := s.app.CreateDonation(111, 10, 3, 500, false, "")
+	s.Require().Equal(ErrTierNotFound, err)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestCreateDonationTierSoldOut() {
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+	s.mockTier.EXPECT().Get(3).Return(&models.Tier{ID: 3, ProjectID: 10, Amount: 500, Quantity: 1}, true)
+	s.mockDonation.EXPECT().Create(gomock.Any(), false).Return(models.ErrTierSoldOut)
+
+	newDon, err := s.app.CreateDonation(111, 10, 3, 500, false, "")
+	s.Require().Equal(models.ErrTierSoldOut, err)
+	s.Require().Nil(newDon)
+}
+
 func (s *DonationSuite) TestSetPayment() {
 	donation := &models.Donation{
 		ID:        1,
@@ -110,8 +365,8 @@ func (s *DonationSuite) TestSetPayment() {
 		ProjectID: 33,
 	}
 	s.mockDonation.EXPECT().Get(1).Return(donation, true)
-	donation.Payment = 200
-	s.mockDonation.EXPECT().Update(donation).Return(nil)
+	s.mockProject.EXPECT().Get(33).Return(s.moneyProject(), true)
+	s.mockDonation.EXPECT().UpdatePayment(donation).Return(nil)
 
 	newDon, err := s.app.UpdateDonation(1, 111, 200, false)
 	s.Require().NoError(err)
@@ -125,6 +380,208 @@ func (s *DonationSuite) TestSetPayment() {
 	}
 }
 
+func (s *DonationSuite) TestCreateDonationPledgeRules() {
+	project := s.moneyProject()
+	project.GoalAmount = 100000
+	project.Currency = "RUB"
+	project.PledgeRules = models.PledgeRules{
+		MinPledge:       5000,
+		MaxPledge:       50000,
+		PledgeStep:      1000,
+		DenyOverfunding: true,
+	}
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 60500, false, "")
+	s.Require().Nil(newDon)
+	s.Require().IsType(&ValidationError{}, err)
+	s.Require().Equal([]FieldError{
+		{Field: "payment", Code: CodeMax, Message: "payment must be at most 500.00 RUB"},
+		{Field: "payment", Code: CodeStep, Message: "payment must be a multiple of 10.00 RUB"},
+	}, err.(*ValidationError).Fields)
+}
+
+func (s *DonationSuite) TestCreateDonationOverfunding() {
+	project := s.moneyProject()
+	project.DenyOverfunding = true
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockDonation.EXPECT().Create(gomock.Any(), false).Return(&models.OverfundingError{Remaining: 10000, Currency: "RUB"})
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 20000, false, "")
+	s.Require().Nil(newDon)
+	s.Require().Equal([]FieldError{
+		{Field: "payment", Code: CodeRemaining, Message: "payment exceeds remaining amount 100.00 RUB"},
+	}, err.(*ValidationError).Fields)
+}
+
+func (s *DonationSuite) TestCreateDonationNotPositive() {
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, -5, false, "")
+	s.Require().Nil(newDon)
+	s.Require().Equal([]FieldError{
+		{Field: "payment", Code: CodeRequired, Message: "payment must be positive"},
+	}, err.(*ValidationError).Fields)
+}
+
+func (s *DonationSuite) TestCreateDonationPaymentForEvent() {
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{
+		ID: 10,
+		ProjectType: models.ProjectType{
+			GoalByPeople:  true,
+			EndByGoalGain: true,
+		},
+	}, true)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 100, false, "")
+	s.Require().Nil(newDon)
+	s.Require().Equal([]FieldError{
+		{Field: "payment", Code: CodeNotAllowed, Message: "payment is not allowed for this project type"},
+	}, err.(*ValidationError).Fields)
+}
+
+func (s *DonationSuite) TestSetPaymentOverfunding() {
+	donation := &models.Donation{
+		ID:        1,
+		Payment:   100,
+		UserID:    111,
+		ProjectID: 10,
+	}
+	project := s.moneyProject()
+	project.DenyOverfunding = true
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockDonation.EXPECT().UpdatePayment(donation).Return(&models.OverfundingError{Remaining: 200, Currency: "RUB"})
+
+	newDon, err := s.app.UpdateDonation(1, 111, 201, false)
+	s.Require().Nil(newDon)
+	s.Require().Equal(CodeRemaining, err.(*ValidationError).Fields[0].Code)
+}
+
+func (s *DonationSuite) TestCreateDonationWithMessage() {
+	s.mockProject.EXPECT().Get(10).Return(s.moneyProject(), true)
+	expect := &models.Donation{
+		UserID:    111,
+		ProjectID: 10,
+		Payment:   100,
+		Message:   "Happy birthday!",
+		MessageAt: s.clock.Now(),
+		CreatedAt: s.clock.Now(),
+	}
+	s.mockDonation.EXPECT().Create(expect, false).Return(nil)
+
+	newDon, err := s.app.CreateDonation(111, 10, 0, 100, false, "Happy birthday!")
+	s.Require().NoError(err)
+	s.Require().Equal(expect, newDon)
+	s.Require().Equal(10, <-s.recalcChan)
+}
+
+func (s *DonationSuite) TestSetDonationMessage() {
+	donation := &models.Donation{ID: 1, UserID: 111, ProjectID: 10, MessageHidden: true}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockDonation.EXPECT().UpdateMessage(donation).Return(nil)
+
+	newDon, err := s.app.SetDonationMessage(1, 111, "Good luck")
+	s.Require().NoError(err)
+	s.Require().Equal("Good luck", newDon.Message)
+	s.Require().False(newDon.MessageHidden)
+	s.Require().Equal(s.clock.Now(), newDon.MessageAt)
+}
+
+func (s *DonationSuite) TestSetDonationMessageLocked() {
+	s.mockDonation.EXPECT().Get(1).Return(&models.Donation{ID: 1, UserID: 111, Locked: true}, true)
+
+	newDon, err := s.app.SetDonationMessage(1, 111, "Good luck")
+	s.Require().Equal(ErrDonationModifyNotAllowed, err)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestSetDonationMessageTooLong() {
+	newDon, err := s.app.SetDonationMessage(1, 111, strings.Repeat("я", maxMessageLength+1))
+	s.Require().IsType(&ValidationError{}, err)
+	s.Require().Equal("message", err.(*ValidationError).Fields[0].Field)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestSetDonationMessageRateLimit() {
+	donation := &models.Donation{ID: 1, UserID: 111}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true).Times(messageRateLimit + 2)
+	s.mockDonation.EXPECT().UpdateMessage(donation).Return(nil).Times(messageRateLimit + 1)
+	for i := 0; i < messageRateLimit; i++ {
+		_, err := s.app.SetDonationMessage(1, 111, "Good luck")
+		s.Require().NoError(err)
+	}
+	_, err := s.app.SetDonationMessage(1, 111, "Good luck")
+	s.Require().Equal(ErrMessageRateLimit, err)
+
+	s.clock.Advance(messageRateWindow)
+	_, err = s.app.SetDonationMessage(1, 111, "Good luck")
+	s.Require().NoError(err)
+}
+
+func (s *DonationSuite) TestHideDonationMessage() {
+	donation := &models.Donation{ID: 1, UserID: 111, Message: "spam", Project: models.Project{OwnerID: 42}}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockDonation.EXPECT().UpdateMessage(donation).Return(nil)
+
+	newDon, err := s.app.HideDonationMessage(1, 42, true)
+	s.Require().NoError(err)
+	s.Require().True(newDon.MessageHidden)
+}
+
+func (s *DonationSuite) TestHideDonationMessageNotOwner() {
+	s.mockCollaborator.EXPECT().Get(0, 111).Return(nil, false)
+	donation := &models.Donation{ID: 1, UserID: 111, Message: "spam", Project: models.Project{OwnerID: 42}}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+
+	newDon, err := s.app.HideDonationMessage(1, 111, true)
+	s.Require().Equal(ErrDonationModifyNotAllowed, err)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestDeleteDonationMessage() {
+	donation := &models.Donation{
+		ID:        1,
+		UserID:    111,
+		Message:   "spam",
+		MessageAt: time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC),
+		Project:   models.Project{OwnerID: 42},
+	}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockDonation.EXPECT().UpdateMessage(&models.Donation{ID: 1, UserID: 111, Project: models.Project{OwnerID: 42}}).Return(nil)
+
+	s.Require().NoError(s.app.DeleteDonationMessage(1, 42))
+}
+
+func (s *DonationSuite) TestGetGuestbook() {
+	s.mockCollaborator.EXPECT().Get(1, 3).Return(nil, false)
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 42}, true)
+	donations := s.projectDonations()
+	donations[0].Message = "Hi"
+	donations[1].Message = "Secret"
+	s.mockDonation.EXPECT().GetMessagesByProject(1, 1, 2, false).Return(donations, 3, nil)
+
+	entries, next, hasNext, err := s.app.GetGuestbook(1, 3, 1, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(2, next)
+	s.Require().True(hasNext)
+	s.Require().Equal([]GuestbookEntry{
+		{DonationID: 1, User: donations[0].User, Message: "Hi"},
+		{DonationID: 2, Anonymous: true, Message: "Secret"},
+	}, entries)
+}
+
+func (s *DonationSuite) TestGetGuestbookOwner() {
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 42}, true)
+	s.mockDonation.EXPECT().GetMessagesByProject(1, 2, 2, true).Return(s.projectDonations(), 4, nil)
+
+	entries, next, hasNext, err := s.app.GetGuestbook(1, 42, 2, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(0, next)
+	s.Require().False(hasNext)
+	s.Require().Equal(2, entries[1].User.ID)
+}
+
 func (s *DonationSuite) TestSetPaymentWrongUser() {
 	donation := &models.Donation{
 		ID:        1,
@@ -173,14 +630,63 @@ func (s *DonationSuite) TestCheckPaid() {
 	}
 	s.mockDonation.EXPECT().Get(1).Return(donation, true)
 	donation.Paid = true
-	s.mockDonation.EXPECT().Update(donation).Return(nil)
+	s.mockDonation.EXPECT().UpdatePaid(donation).Return(nil)
 
 	newDon, err := s.app.UpdateDonation(1, 1212, 0, true)
 	s.Require().NoError(err)
 	s.Require().Equal(donation, newDon)
+	s.Require().Len(s.notifier.events, 1)
+	s.Require().Equal(EventPaymentConfirmed, s.notifier.events[0].Type)
+	s.Require().Equal([]int{111}, s.notifier.events[0].UserIDs)
+}
+
+func (s *DonationSuite) TestCheckPaidKeepsCredit() {
+	donation := &models.Donation{
+		ID:         1,
+		Payment:    400,
+		PaidAmount: 500,
+		Credit:     100,
+		UserID:     111,
+		Paid:       true,
+		Locked:     true,
+		ProjectID:  33,
+		Project: models.Project{
+			OwnerID: 1212,
+		},
+	}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockDonation.EXPECT().UpdatePaid(donation).Return(nil)
+
+	newDon, err := s.app.UpdateDonation(1, 1212, 0, true)
+	s.Require().NoError(err)
+	s.Require().True(newDon.Paid)
+	s.Require().Equal(int64(500), newDon.PaidAmount)
+	s.Require().Equal(int64(100), newDon.Credit)
+}
+
+func (s *DonationSuite) TestCheckUnpaidWithCredit() {
+	donation := &models.Donation{
+		ID:         1,
+		Payment:    400,
+		PaidAmount: 500,
+		Credit:     100,
+		UserID:     111,
+		Paid:       true,
+		Locked:     true,
+		ProjectID:  33,
+		Project: models.Project{
+			OwnerID: 1212,
+		},
+	}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+
+	newDon, err := s.app.UpdateDonation(1, 1212, 0, false)
+	s.Require().Equal(ErrDonationModifyWrong, err)
+	s.Require().Nil(newDon)
 }
 
 func (s *DonationSuite) TestCheckPaidNotOwner() {
+	s.mockCollaborator.EXPECT().Get(0, 888).Return(nil, false)
 	donation := &models.Donation{
 		ID:        1,
 		Payment:   100,
@@ -240,6 +746,118 @@ func (s *DonationSuite) TestDeleteDonation() {
 	}
 }
 
+func (s *DonationSuite) TestDeleteLockedDonationFair() {
+	expect := &models.Donation{
+		ID:        1,
+		UserID:    111,
+		ProjectID: 10,
+		Locked:    true,
+	}
+	s.mockDonation.EXPECT().Get(1).Return(expect, true)
+	s.mockProject.EXPECT().Get(10).Return(s.fairProject(true, false), true)
+	s.mockDonation.EXPECT().Delete(expect).Return(nil)
+
+	s.Require().NoError(s.app.DeleteDonation(1, 111))
+
+	select {
+	case x := <-s.recalcChan:
+		s.Require().Equal(10, x)
+	default:
+		s.T().Fail()
+	}
+}
+
+func (s *DonationSuite) TestDeleteLockedDonationPaid() {
+	expect := &models.Donation{
+		ID:         1,
+		UserID:     111,
+		ProjectID:  10,
+		Locked:     true,
+		Paid:       true,
+		PaidAmount: 100,
+	}
+	s.mockDonation.EXPECT().Get(1).Return(expect, true)
+
+	err := s.app.DeleteDonation(1, 111)
+	s.Require().Equal(ErrDonationModifyNotAllowed, err)
+}
+
+func (s *DonationSuite) TestDeleteLockedDonationNotFair() {
+	expect := &models.Donation{
+		ID:        1,
+		UserID:    111,
+		ProjectID: 10,
+		Locked:    true,
+	}
+	s.mockDonation.EXPECT().Get(1).Return(expect, true)
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{
+		ID:     10,
+		Locked: true,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}, true)
+
+	err := s.app.DeleteDonation(1, 111)
+	s.Require().Equal(ErrDonationModifyNotAllowed, err)
+}
+
+func (s *DonationSuite) TestSettleCredit() {
+	donation := &models.Donation{
+		ID:         1,
+		Payment:    400,
+		PaidAmount: 500,
+		Credit:     100,
+		Paid:       true,
+		Locked:     true,
+		Project: models.Project{
+			OwnerID: 1212,
+		},
+	}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockDonation.EXPECT().UpdatePaid(donation).Return(nil)
+
+	newDon, err := s.app.SettleCredit(1, 1212)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(400), newDon.PaidAmount)
+	s.Require().Equal(int64(0), newDon.Credit)
+}
+
+func (s *DonationSuite) TestSettleCreditNoCredit() {
+	donation := &models.Donation{
+		ID:     1,
+		Locked: true,
+		Project: models.Project{
+			OwnerID: 1212,
+		},
+	}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+
+	newDon, err := s.app.SettleCredit(1, 1212)
+	s.Require().Equal(ErrDonationModifyWrong, err)
+	s.Require().Nil(newDon)
+}
+
+func (s *DonationSuite) TestGetUserAdjustments() {
+	adjustments := []models.Adjustment{
+		{
+			ID:         1,
+			DonationID: 3,
+			ProjectID:  10,
+			UserID:     111,
+			OldPayment: 500,
+			NewPayment: 400,
+			Credit:     100,
+		},
+	}
+	s.mockAdjustment.EXPECT().GetAllByUser(111).Return(adjustments, nil)
+
+	result, err := s.app.GetUserAdjustments(111)
+	s.Require().NoError(err)
+	s.Require().Equal(adjustments, result)
+}
+
 func TestDonationSuite(t *testing.T) {
 	suite.Run(t, new(DonationSuite))
 }
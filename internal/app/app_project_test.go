@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/FreakyGranny/launchpad-api/internal/mocks"
@@ -13,24 +14,46 @@ import (
 
 type ProjectSuite struct {
 	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockRevisionCtl     *gomock.Controller
+	mockRevision        *mocks.MockRevisionImpl
 	mockProjectCtl   *gomock.Controller
 	mockProject      *mocks.MockProjectImpl
 	mockPaginatorCtl *gomock.Controller
 	mockPaginator    *mocks.MockProjectPaginatorImpl
+	mockTierCtl      *gomock.Controller
+	mockTier         *mocks.MockTierImpl
+	mockTagCtl       *gomock.Controller
+	mockTag          *mocks.MockTagImpl
+	notifier         *fakeNotifier
 	app              *App
 }
 
 func (s *ProjectSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockRevisionCtl = gomock.NewController(s.T())
+	s.mockRevision = mocks.NewMockRevisionImpl(s.mockRevisionCtl)
 	s.mockProjectCtl = gomock.NewController(s.T())
 	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
 	s.mockPaginatorCtl = gomock.NewController(s.T())
 	s.mockPaginator = mocks.NewMockProjectPaginatorImpl(s.mockPaginatorCtl)
-	s.app = New(nil, nil, s.mockProject, nil, nil, nil, nil, "", nil)
+	s.mockTierCtl = gomock.NewController(s.T())
+	s.mockTier = mocks.NewMockTierImpl(s.mockTierCtl)
+	s.mockTagCtl = gomock.NewController(s.T())
+	s.mockTag = mocks.NewMockTagImpl(s.mockTagCtl)
+	s.notifier = &fakeNotifier{}
+	s.app = New(nil, nil, s.mockProject, nil, nil, nil, s.mockTier, nil, nil, nil, nil, nil, nil, nil, s.mockTag, nil, nil, nil, nil, s.mockCollaborator, nil, s.mockRevision, nil, nil, s.notifier, clockwork.NewFakeClock(), "", nil)
 }
 
 func (s *ProjectSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockRevisionCtl.Finish()
 	s.mockProjectCtl.Finish()
 	s.mockPaginatorCtl.Finish()
+	s.mockTierCtl.Finish()
+	s.mockTagCtl.Finish()
 }
 
 func (s *ProjectSuite) TestGetSingleProject() {
@@ -73,9 +96,13 @@ func (s *ProjectSuite) TestGetSingleProject() {
 		Description:  project.Description,
 		Instructions: project.Instructions,
 		Owner:        project.Owner,
+		Tiers:        []models.Tier{{ID: 3, ProjectID: 1, Amount: 500, Title: "basic", Quantity: 2, Taken: 1}},
+		Tags:         []models.Tag{{ID: 4, Alias: "for-kids", Name: "For kids", Curated: true}},
 	}
 
 	s.mockProject.EXPECT().Get(1).Return(project, true)
+	s.mockTier.EXPECT().GetAllByProject(1).Return(expect.Tiers, nil)
+	s.mockTag.EXPECT().GetByProject(1).Return(expect.Tags, nil)
 	pr, err := s.app.GetProject(1)
 	s.Require().NoError(err)
 	s.Require().Equal(expect, pr)
@@ -88,7 +115,7 @@ func (s *ProjectSuite) TestCreateProject() {
 	eventTime := time.Time{}
 	category := 1
 	goalPeople := 0
-	goalAmount := 1000
+	goalAmount := int64(1000)
 	imageLink := "https://avatar.com"
 	instructions := "instructions"
 	descr := "description"
@@ -102,20 +129,25 @@ func (s *ProjectSuite) TestCreateProject() {
 		EventDate:     eventTime,
 		GoalPeople:    goalPeople,
 		GoalAmount:    goalAmount,
+		Currency:      "RUB",
 		Description:   descr,
 		ImageLink:     imageLink,
 		Instructions:  instructions,
 		OwnerID:       userID,
 		CategoryID:    category,
 		ProjectTypeID: projectType,
+		PrivateAmounts: true,
 	}
 	s.mockProject.EXPECT().Create(&expect).Return(nil)
+	expectFirstRevision(s.mockRevision)
 	id, err := s.app.CreateProject(
 		userID, 
+		0,
 		goalPeople, 
 		goalAmount, 
 		category, 
 		projectType, 
+		"", 
 		title, 
 		subtitle, 
 		descr, 
@@ -123,11 +155,67 @@ func (s *ProjectSuite) TestCreateProject() {
 		instructions, 
 		releaseDate, 
 		eventTime,
+		models.PledgeRules{},
 	)
 	s.Require().NoError(err)
 	s.Require().Equal(0, id)
 }
 
+func (s *ProjectSuite) TestCreateProjectWrongCurrency() {
+	id, err := s.app.CreateProject(113, 0, 0, 1000, 1, 1, "XXX", "project", "", "", "", "", time.Time{}, time.Time{}, models.PledgeRules{})
+	s.Require().Equal(ErrProjectWrongCurrency, err)
+	s.Require().Equal(0, id)
+}
+
+func (s *ProjectSuite) TestUpdateProjectCurrency() {
+	expect := &models.Project{
+		ID:       17,
+		Currency: "RUB",
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+		OwnerID: 42,
+	}
+	s.mockProject.EXPECT().Get(17).Return(expect, true)
+	s.mockProject.EXPECT().Update(expect).Return(nil)
+	expectFirstRevision(s.mockRevision)
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "usd", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, nil, false, false)
+	s.Require().NoError(err)
+	s.Require().Equal("USD", eProject.Currency)
+}
+
+func (s *ProjectSuite) TestCreateProjectWrongPledgeRules() {
+	rules := models.PledgeRules{MinPledge: 1000, MaxPledge: 500, PledgeStep: -1}
+	id, err := s.app.CreateProject(113, 0, 0, 1000, 1, 1, "", "project", "", "", "", "", time.Time{}, time.Time{}, rules)
+	s.Require().Equal(0, id)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "pledge.max", Code: CodeMin, Message: "maximum pledge is less than minimum"},
+		{Field: "pledge.step", Code: CodeMin, Message: "pledge step can't be negative"},
+	}, vErr.Fields)
+}
+
+func (s *ProjectSuite) TestUpdateProjectPledgeRules() {
+	expect := &models.Project{
+		ID: 17,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+		OwnerID: 42,
+	}
+	rules := &models.PledgeRules{MinPledge: 10000, PledgeStep: 5000, DenyOverfunding: true}
+	s.mockProject.EXPECT().Get(17).Return(expect, true)
+	s.mockProject.EXPECT().UpdatePledgeRules(expect).Return(nil)
+	s.mockProject.EXPECT().Update(expect).Return(nil)
+	expectFirstRevision(s.mockRevision)
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, rules, false, false)
+	s.Require().NoError(err)
+	s.Require().Equal(*rules, eProject.Pledge)
+}
+
 func (s *ProjectSuite) TestUpdateProject() {
 	expect := &models.Project{
 		ID:    17,
@@ -140,14 +228,75 @@ func (s *ProjectSuite) TestUpdateProject() {
 	}
 	s.mockProject.EXPECT().Get(17).Return(expect, true)
 	s.mockProject.EXPECT().Update(expect).Return(nil)
-	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, false, false)
+	expectFirstRevision(s.mockRevision)
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, nil, false, false)
 	s.Require().NoError(err)
 	s.Require().Equal("ChangeProject", eProject.Title)
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *ProjectSuite) TestUpdateProjectKeepsUnsetFields() {
+	releaseDate := time.Date(2020, 5, 1, 0, 0, 0, 0, time.UTC)
+	expect := &models.Project{
+		ID:          17,
+		Title:       "before_Title",
+		Description: "descr",
+		CategoryID:  3,
+		GoalAmount:  100000,
+		GoalPeople:  5,
+		ReleaseDate: releaseDate,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+		OwnerID: 42,
+	}
+	s.mockProject.EXPECT().Get(17).Return(expect, true)
+	s.mockProject.EXPECT().Update(expect).Return(nil)
+	s.mockRevision.EXPECT().GetLast(17).Return(&models.ProjectRevision{
+		ProjectID: 17,
+		Number:    1,
+		Fields:    projectFields(expect),
+	}, true)
+	s.mockRevision.EXPECT().Create(gomock.Any()).DoAndReturn(func(r *models.ProjectRevision) error {
+		s.Require().Equal("100000", r.Fields["goal_amount"])
+		s.Require().Equal("3", r.Fields["category"])
+		s.Require().Equal("descr", r.Fields["description"])
+		s.Require().Equal(releaseDate.Format(DateLayout), r.Fields["release_date"])
+		s.Require().Empty(r.Flagged)
+		return nil
+	})
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, nil, false, false)
+	s.Require().NoError(err)
+	s.Require().Equal("ChangeProject", eProject.Title)
+	s.Require().Equal(int64(100000), eProject.GoalAmount)
+}
+
+func (s *ProjectSuite) TestUpdateProjectPublish() {
+	expect := &models.Project{
+		ID: 17,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+		OwnerID: 42,
+	}
+	s.mockProject.EXPECT().Get(17).Return(expect, true)
+	s.mockProject.EXPECT().Update(expect).Return(nil)
+	expectFirstRevision(s.mockRevision)
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "Pizza", "", "", "", "", time.Time{}, time.Time{}, nil, true, false)
+	s.Require().NoError(err)
+	s.Require().Equal("Pizza", eProject.Title)
+	s.Require().Equal([]Event{{
+		Type:      EventProjectPublished,
+		ProjectID: 17,
+		Data:      map[string]interface{}{"title": "Pizza"},
+	}}, s.notifier.events)
 }
 
 func (s *ProjectSuite) TestUpdateProjectNotFound() {
 	s.mockProject.EXPECT().Get(17).Return(nil, false)
-	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, false, false)
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, nil, false, false)
 	s.Require().Error(err)
 	s.Require().Equal(ErrProjectNotFound, err)
 	s.Require().Nil(eProject)
@@ -165,7 +314,7 @@ func (s *ProjectSuite) TestUpdateProjectPublished() {
 		OwnerID: 42,
 	}
 	s.mockProject.EXPECT().Get(17).Return(expect, true)
-	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, false, false)
+	eProject, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, nil, false, false)
 	s.Require().Error(err)
 	s.Require().Equal(ErrProjectModifyNotAllowed, err)
 	s.Require().Nil(eProject)
@@ -183,8 +332,34 @@ func (s *ProjectSuite) TestDropEventDate() {
 	}
 	s.mockProject.EXPECT().Get(17).Return(expect, true)
 	s.mockProject.EXPECT().DropEventDate(expect).Return(nil)
-	_, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "", "", "", "", time.Time{}, time.Time{}, false, true)
+	expectFirstRevision(s.mockRevision)
+	_, err := s.app.UpdateProject(17, 42, 0, 0, 0, 0, "", "", "", "", "", "", time.Time{}, time.Time{}, nil, false, true)
+	s.Require().NoError(err)
+}
+
+func (s *ProjectSuite) TestSetProjectPrivacy() {
+	project := &models.Project{
+		ID:      17,
+		OwnerID: 42,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+		Published: true,
+	}
+	s.mockProject.EXPECT().Get(17).Return(project, true)
+	s.mockProject.EXPECT().UpdatePrivacy(project).Return(nil)
+	eProject, err := s.app.SetProjectPrivacy(42, 17, true)
 	s.Require().NoError(err)
+	s.Require().True(eProject.PrivateAmounts)
+}
+
+func (s *ProjectSuite) TestSetProjectPrivacyNotOwner() {
+	s.mockCollaborator.EXPECT().Get(17, 43).Return(nil, false)
+	s.mockProject.EXPECT().Get(17).Return(&models.Project{ID: 17, OwnerID: 42}, true)
+	eProject, err := s.app.SetProjectPrivacy(43, 17, true)
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+	s.Require().Nil(eProject)
 }
 
 func (s *ProjectSuite) TestDeleteProject() {
@@ -228,22 +403,92 @@ func (s *ProjectSuite) TestDeleteProjectNotFound() {
 }
 
 func (s *ProjectSuite) TestGetProjectsWithPagination() {
-	category := 1
-	projectType := 2
+	filter := &models.ProjectFilter{Categories: []int{1}, ProjectTypes: []int{2}}
 	page := 1
 	pageSize := 2
 
-	s.mockProject.EXPECT().GetProjectsWithPagination(category, projectType, page, pageSize, false).Return(s.mockPaginator, nil)
+	s.mockProject.EXPECT().GetProjectsWithPagination(filter, page, pageSize).Return(s.mockPaginator, nil)
 	s.mockPaginator.EXPECT().NextPage().Return(0, false)
 	s.mockPaginator.EXPECT().Retrieve().Return(s.makeProjectList(), nil)
 
-	list, next, hasNext, err := s.app.GetProjectsWithPagination(category, projectType, page, pageSize, false)
+	list, next, hasNext, err := s.app.GetProjectsWithPagination(filter, page, pageSize)
 	s.Require().NoError(err)
 	s.Require().Equal(2, len(list))
 	s.Require().Equal(0, next)
 	s.Require().False(hasNext)
 }
 
+func (s *ProjectSuite) TestGetProjectsByTags() {
+	filter := &models.ProjectFilter{Tags: []string{"For Kids", "weekend"}}
+	s.mockProject.EXPECT().GetProjectsWithPagination(&models.ProjectFilter{Tags: []string{"for-kids", "weekend"}}, 1, 10).Return(s.mockPaginator, nil)
+	s.mockPaginator.EXPECT().NextPage().Return(0, false)
+	s.mockPaginator.EXPECT().Retrieve().Return(s.makeProjectList(), nil)
+
+	list, _, _, err := s.app.GetProjectsWithPagination(filter, 1, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(list))
+}
+
+func (s *ProjectSuite) TestSearchProjects() {
+	filter := &models.ProjectFilter{Search: " board games "}
+	s.mockProject.EXPECT().GetProjectsWithPagination(&models.ProjectFilter{Search: "board games"}, 1, 10).Return(s.mockPaginator, nil)
+	s.mockPaginator.EXPECT().NextPage().Return(0, false)
+	projects := s.makeProjectList()
+	(*projects)[0].ID = 1
+	(*projects)[1].ID = 2
+	s.mockPaginator.EXPECT().Retrieve().Return(projects, nil)
+	s.mockProject.EXPECT().GetSnippets([]int{1, 2}, "board games").Return(map[int]string{2: "collection of <mark>board</mark> <mark>games</mark>"}, nil)
+
+	list, _, _, err := s.app.GetProjectsWithPagination(filter, 1, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(list))
+	s.Require().Empty(list[0].Snippet)
+	s.Require().Equal("collection of <mark>board</mark> <mark>games</mark>", list[1].Snippet)
+}
+
+func (s *ProjectSuite) TestGetProjectsWithCursor() {
+	filter := &models.ProjectFilter{Sort: []models.ProjectSort{{Field: models.SortTotal, Desc: true}}}
+	cursor := &models.Cursor{Sort: "-total", Values: []string{"100"}, ID: 5}
+	next := &models.Cursor{Sort: "-total", Values: []string{"0"}, ID: 2}
+	s.mockProject.EXPECT().GetProjectsAfter(filter, cursor, 2).Return(s.makeProjectList(), next, nil)
+
+	list, nextCursor, err := s.app.GetProjectsWithCursor(filter, cursor, 2)
+	s.Require().NoError(err)
+	s.Require().Equal(2, len(list))
+	s.Require().Equal(next, nextCursor)
+}
+
+func (s *ProjectSuite) TestGetProjectsWithWrongCursor() {
+	filter := &models.ProjectFilter{}
+	cursor := &models.Cursor{Sort: "-total", Values: []string{"100"}, ID: 5}
+	s.mockProject.EXPECT().GetProjectsAfter(filter, cursor, 10).Return(nil, nil, models.ErrInvalidCursor)
+
+	_, _, err := s.app.GetProjectsWithCursor(filter, cursor, 10)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{{Field: "cursor", Code: CodeInvalid, Message: "cursor doesn't match list ordering"}}, vErr.Fields)
+}
+
+func (s *ProjectSuite) TestGetProjectsInvalidFilter() {
+	filter := &models.ProjectFilter{
+		Statuses:    []string{"draft"},
+		Sort:        []models.ProjectSort{{Field: "title"}, {Field: models.SortRelevance, Desc: true}},
+		ReleaseFrom: time.Date(2020, 10, 5, 0, 0, 0, 0, time.UTC),
+		ReleaseTo:   time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	_, _, _, err := s.app.GetProjectsWithPagination(filter, 1, 200)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "page_size", Code: CodeMax, Message: "page size can't be greater than 100"},
+		{Field: "status", Code: CodeNotAllowed, Message: `status "draft" is not supported`},
+		{Field: "sort", Code: CodeNotAllowed, Message: `sorting by "title" is not supported`},
+		{Field: "sort", Code: CodeNotAllowed, Message: "sorting by relevance requires search query"},
+		{Field: "release_to", Code: CodeMin, Message: "release date range is empty"},
+	}, vErr.Fields)
+}
+
 func (s *ProjectSuite) makeProjectList() *[]models.Project {
 	return &[]models.Project{
 		{
@@ -264,11 +509,26 @@ func (s *ProjectSuite) makeProjectList() *[]models.Project {
 }
 
 func (s *ProjectSuite) TestGetUserProjects() {
-	s.mockProject.EXPECT().GetUserProjects(1, false, false).Return(s.makeProjectList(), nil)
+	s.mockProject.EXPECT().GetUserProjects(1, 2, false, false, 0, 21).Return(s.makeProjectList(), nil)
 
-	list, err := s.app.GetUserProjects(1, false, false)
+	list, next, hasNext, err := s.app.GetUserProjects(1, 2, false, false, 0, 20)
 	s.Require().NoError(err)
 	s.Require().Equal(2, len(list))
+	s.Require().Equal(0, next)
+	s.Require().False(hasNext)
+}
+
+func (s *ProjectSuite) TestGetUserProjectsPage() {
+	projects := s.makeProjectList()
+	(*projects)[0].ID = 9
+	(*projects)[1].ID = 8
+	s.mockProject.EXPECT().GetUserProjects(1, 2, false, false, 10, 2).Return(projects, nil)
+
+	list, next, hasNext, err := s.app.GetUserProjects(1, 2, false, false, 10, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(1, len(list))
+	s.Require().Equal(9, next)
+	s.Require().True(hasNext)
 }
 
 func TestProjectSuite(t *testing.T) {
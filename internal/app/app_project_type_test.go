@@ -20,7 +20,7 @@ type ProjectTypeSuite struct {
 func (s *ProjectTypeSuite) SetupTest() {
 	s.mockProjectTypeCtl = gomock.NewController(s.T())
 	s.mockProjectType = mocks.NewMockProjectTypeImpl(s.mockProjectTypeCtl)
-	s.app = New(nil, nil, nil, s.mockProjectType, nil, nil, nil, "", nil)
+	s.app = New(nil, nil, nil, s.mockProjectType, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
 }
 
 func (s *ProjectTypeSuite) TearDownTest() {
@@ -0,0 +1,153 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ProjectUpdateSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockDonationCtl     *gomock.Controller
+	mockDonation        *mocks.MockDonationImpl
+	mockUpdateCtl       *gomock.Controller
+	mockUpdate          *mocks.MockProjectUpdateImpl
+	notifier            *fakeNotifier
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *ProjectUpdateSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockDonationCtl = gomock.NewController(s.T())
+	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.mockUpdateCtl = gomock.NewController(s.T())
+	s.mockUpdate = mocks.NewMockProjectUpdateImpl(s.mockUpdateCtl)
+	s.notifier = &fakeNotifier{}
+	s.clock = clockwork.NewFakeClock()
+	s.app = New(nil, nil, s.mockProject, nil, s.mockDonation, nil, nil, nil, s.mockUpdate, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, nil, nil, nil, s.notifier, s.clock, "", nil)
+}
+
+func (s *ProjectUpdateSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockDonationCtl.Finish()
+	s.mockUpdateCtl.Finish()
+}
+
+func (s *ProjectUpdateSuite) project() *models.Project {
+	return &models.Project{
+		ID:        10,
+		OwnerID:   42,
+		Published: true,
+	}
+}
+
+func (s *ProjectUpdateSuite) donations() []models.Donation {
+	return []models.Donation{
+		{ID: 1, UserID: 5, ProjectID: 10},
+		{ID: 2, UserID: 6, ProjectID: 10},
+	}
+}
+
+func (s *ProjectUpdateSuite) TestGetProjectUpdatesParticipant() {
+	s.mockCollaborator.EXPECT().Get(10, 6).Return(nil, false)
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return(s.donations(), nil)
+	s.mockUpdate.EXPECT().GetAllByProject(10, true).Return([]models.ProjectUpdate{{ID: 1}}, nil)
+
+	updates, err := s.app.GetProjectUpdates(10, 6)
+	s.Require().NoError(err)
+	s.Require().Len(updates, 1)
+}
+
+func (s *ProjectUpdateSuite) TestGetProjectUpdatesStranger() {
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(nil, false)
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return(s.donations(), nil)
+	s.mockUpdate.EXPECT().GetAllByProject(10, false).Return([]models.ProjectUpdate{}, nil)
+
+	updates, err := s.app.GetProjectUpdates(10, 7)
+	s.Require().NoError(err)
+	s.Require().Empty(updates)
+}
+
+func (s *ProjectUpdateSuite) TestGetProjectUpdatesOwner() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockUpdate.EXPECT().GetAllByProject(10, true).Return([]models.ProjectUpdate{}, nil)
+
+	_, err := s.app.GetProjectUpdates(10, 42)
+	s.Require().NoError(err)
+}
+
+func (s *ProjectUpdateSuite) TestCreateProjectUpdate() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	expect := &models.ProjectUpdate{
+		ProjectID:        10,
+		Title:            "Arrived",
+		Text:             "Pick it up at desk 4",
+		ParticipantsOnly: true,
+		CreatedAt:        s.clock.Now(),
+	}
+	s.mockUpdate.EXPECT().Create(expect).Return(nil)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return(s.donations(), nil)
+
+	update, err := s.app.CreateProjectUpdate(42, 10, "Arrived", "Pick it up at desk 4", true)
+	s.Require().NoError(err)
+	s.Require().Equal(expect, update)
+	s.Require().Equal([]Event{{
+		Type:      EventUpdatePosted,
+		ProjectID: 10,
+		UserIDs:   []int{5, 6},
+		Data:      map[string]interface{}{"update": 0, "title": "Arrived", "participants_only": true},
+	}}, s.notifier.events)
+}
+
+func (s *ProjectUpdateSuite) TestCreateProjectUpdateNotOwner() {
+	s.mockCollaborator.EXPECT().Get(10, 5).Return(nil, false)
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	update, err := s.app.CreateProjectUpdate(5, 10, "Arrived", "Pick it up at desk 4", false)
+	s.Require().Equal(ErrUpdateModifyNotAllowed, err)
+	s.Require().Nil(update)
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *ProjectUpdateSuite) TestCreateProjectUpdateEmpty() {
+	update, err := s.app.CreateProjectUpdate(42, 10, "", "", false)
+	s.Require().Nil(update)
+	s.Require().Len(err.(*ValidationError).Fields, 2)
+}
+
+func (s *ProjectUpdateSuite) TestDeleteProjectUpdate() {
+	update := &models.ProjectUpdate{ID: 3, ProjectID: 10}
+	s.mockUpdate.EXPECT().Get(3).Return(update, true)
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockUpdate.EXPECT().Delete(update).Return(nil)
+
+	s.Require().NoError(s.app.DeleteProjectUpdate(42, 3))
+}
+
+func (s *ProjectUpdateSuite) TestDeleteProjectUpdateNotOwner() {
+	s.mockCollaborator.EXPECT().Get(10, 5).Return(nil, false)
+	s.mockUpdate.EXPECT().Get(3).Return(&models.ProjectUpdate{ID: 3, ProjectID: 10}, true)
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	s.Require().Equal(ErrUpdateModifyNotAllowed, s.app.DeleteProjectUpdate(5, 3))
+}
+
+func TestProjectUpdateSuite(t *testing.T) {
+	suite.Run(t, new(ProjectUpdateSuite))
+}
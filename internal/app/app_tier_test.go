@@ -0,0 +1,125 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type TierSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockTierCtl         *gomock.Controller
+	mockTier            *mocks.MockTierImpl
+	app                 *App
+}
+
+func (s *TierSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockTierCtl = gomock.NewController(s.T())
+	s.mockTier = mocks.NewMockTierImpl(s.mockTierCtl)
+	s.app = New(nil, nil, s.mockProject, nil, nil, nil, s.mockTier, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, nil, nil, nil, nil, nil, "", nil)
+}
+
+func (s *TierSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockTierCtl.Finish()
+}
+
+func (s *TierSuite) project(published bool, pt models.ProjectType) *models.Project {
+	return &models.Project{
+		ID:          10,
+		OwnerID:     42,
+		Published:   published,
+		ProjectType: pt,
+	}
+}
+
+func (s *TierSuite) TestGetProjectTiers() {
+	tiers := []models.Tier{
+		{ID: 1, ProjectID: 10, Amount: 500, Title: "basic"},
+		{ID: 2, ProjectID: 10, Amount: 1500, Title: "weekend", Quantity: 2, Taken: 2},
+	}
+	s.mockTier.EXPECT().GetAllByProject(10).Return(tiers, nil)
+
+	result, err := s.app.GetProjectTiers(10)
+	s.Require().NoError(err)
+	s.Require().Equal(tiers, result)
+	s.Require().True(result[1].SoldOut())
+}
+
+func (s *TierSuite) TestCreateTier() {
+	pt := models.ProjectType{GoalByAmount: true, EndByGoalGain: true}
+	expect := &models.Tier{
+		ProjectID:   10,
+		Amount:      1500,
+		Quantity:    2,
+		Title:       "weekend",
+		Description: "take it home on weekends",
+	}
+	s.mockProject.EXPECT().Get(10).Return(s.project(false, pt), true)
+	s.mockTier.EXPECT().Create(expect).Return(nil)
+
+	tier, err := s.app.CreateTier(42, 10, 1500, 2, "weekend", "take it home on weekends")
+	s.Require().NoError(err)
+	s.Require().Equal(expect, tier)
+}
+
+func (s *TierSuite) TestCreateTierPublished() {
+	pt := models.ProjectType{GoalByAmount: true, EndByGoalGain: true}
+	s.mockProject.EXPECT().Get(10).Return(s.project(true, pt), true)
+
+	tier, err := s.app.CreateTier(42, 10, 1500, 2, "weekend", "")
+	s.Require().Equal(ErrTierModifyNotAllowed, err)
+	s.Require().Nil(tier)
+}
+
+func (s *TierSuite) TestCreateTierNotOwner() {
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(nil, false)
+	pt := models.ProjectType{GoalByAmount: true, EndByGoalGain: true}
+	s.mockProject.EXPECT().Get(10).Return(s.project(false, pt), true)
+
+	tier, err := s.app.CreateTier(7, 10, 1500, 2, "weekend", "")
+	s.Require().Equal(ErrTierModifyNotAllowed, err)
+	s.Require().Nil(tier)
+}
+
+func (s *TierSuite) TestCreateTierEvent() {
+	pt := models.ProjectType{GoalByPeople: true, EndByGoalGain: true}
+	s.mockProject.EXPECT().Get(10).Return(s.project(false, pt), true)
+
+	tier, err := s.app.CreateTier(42, 10, 1500, 2, "weekend", "")
+	s.Require().Equal(ErrTierWrong, err)
+	s.Require().Nil(tier)
+}
+
+func (s *TierSuite) TestDeleteTier() {
+	pt := models.ProjectType{GoalByAmount: true, EndByGoalGain: true}
+	tier := &models.Tier{ID: 3, ProjectID: 10}
+	s.mockTier.EXPECT().Get(3).Return(tier, true)
+	s.mockProject.EXPECT().Get(10).Return(s.project(false, pt), true)
+	s.mockTier.EXPECT().Delete(tier).Return(nil)
+
+	s.Require().NoError(s.app.DeleteTier(42, 3))
+}
+
+func (s *TierSuite) TestDeleteTierNotFound() {
+	s.mockTier.EXPECT().Get(3).Return(nil, false)
+
+	s.Require().Equal(ErrTierNotFound, s.app.DeleteTier(42, 3))
+}
+
+func TestTierSuite(t *testing.T) {
+	suite.Run(t, new(TierSuite))
+}
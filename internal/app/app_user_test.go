@@ -21,7 +21,7 @@ type UserSuite struct {
 func (s *UserSuite) SetupTest() {
 	s.mockUserCtl = gomock.NewController(s.T())
 	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
-	s.app = New(nil, s.mockUser, nil, nil, nil, nil, nil, "", nil)
+	s.app = New(nil, s.mockUser, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
 }
 
 func (s *UserSuite) TearDownTest() {
@@ -49,18 +49,18 @@ func (s *UserSuite) TestGetUserByID() {
 	}
 
 	s.mockUser.EXPECT().Get(1).Return(user, true)
-	s.mockUser.EXPECT().GetParticipation(1).Return(pts, nil)
+	s.mockUser.EXPECT().GetParticipation(1, true).Return(pts, nil)
 
-	extUser, err := s.app.GetUser(1)
+	extUser, err := s.app.GetUser(1, 1)
 	s.Require().NoError(err)
 	s.Require().NotNil(extUser)
 }
 
 func (s *UserSuite) TestGetParticipationErr() {
 	s.mockUser.EXPECT().Get(1).Return(&models.User{}, true)
-	s.mockUser.EXPECT().GetParticipation(1).Return(nil, errors.New("unexpected error"))
+	s.mockUser.EXPECT().GetParticipation(1, false).Return(nil, errors.New("unexpected error"))
 
-	extUser, err := s.app.GetUser(1)
+	extUser, err := s.app.GetUser(1, 2)
 	s.Require().Error(err)
 	s.Require().Nil(extUser)
 	s.Require().Equal(ErrGetUserParticipation, err)
@@ -69,7 +69,7 @@ func (s *UserSuite) TestGetParticipationErr() {
 func (s *UserSuite) TestGetUserNotFound() {
 	s.mockUser.EXPECT().Get(1).Return(&models.User{}, false)
 
-	extUser, err := s.app.GetUser(1)
+	extUser, err := s.app.GetUser(1, 1)
 	s.Require().Error(err)
 	s.Require().Nil(extUser)
 	s.Require().Equal(ErrUserNotFound, err)
@@ -7,32 +7,77 @@ import (
 	"time"
 
 	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/jonboulle/clockwork"
 	"github.com/labstack/gommon/log"
 )
 
+// DigestSender sends notifications accumulated during the day.
+type DigestSender interface {
+	SendDigests()
+}
+
+// WebhookRetrier retries failed webhook deliveries.
+type WebhookRetrier interface {
+	RetryPending()
+}
+
 // Background process
 type Background struct {
-	systemModel  models.SystemImpl
-	projectModel models.ProjectImpl
-	userModel    models.UserImpl
-	recalcChan   chan int
-	updateChan   chan int
-	searchChan   chan *models.Project
-	harverstChan chan *models.Project
-	wg           *sync.WaitGroup
+	systemModel       models.SystemImpl
+	projectModel      models.ProjectImpl
+	userModel         models.UserImpl
+	donationModel     models.DonationImpl
+	notificationModel models.NotificationImpl
+	rankingModel      models.RankingImpl
+	notifier          Notifier
+	digest            DigestSender
+	webhooks          WebhookRetrier
+	retention         time.Duration
+	reminders         ReminderPolicy
+	rankings          RankingPolicy
+	clock             clockwork.Clock
+	recalcChan        chan int
+	updateChan        chan int
+	searchChan        chan *models.Project
+	harverstChan      chan *models.Project
+	wg                *sync.WaitGroup
 }
 
 // NewBackground return new background instance
-func NewBackground(ms models.SystemImpl, mp models.ProjectImpl, mu models.UserImpl) *Background {
+func NewBackground(
+	ms models.SystemImpl,
+	mp models.ProjectImpl,
+	mu models.UserImpl,
+	md models.DonationImpl,
+	mn models.NotificationImpl,
+	mr models.RankingImpl,
+	n Notifier,
+	ds DigestSender,
+	wr WebhookRetrier,
+	retention time.Duration,
+	reminders ReminderPolicy,
+	rankings RankingPolicy,
+	clock clockwork.Clock,
+) *Background {
 	return &Background{
-		systemModel:  ms,
-		projectModel: mp,
-		userModel:    mu,
-		recalcChan:   make(chan int, 100),
-		updateChan:   make(chan int, 100),
-		searchChan:   make(chan *models.Project, 10),
-		harverstChan: make(chan *models.Project, 10),
-		wg:           &sync.WaitGroup{},
+		systemModel:       ms,
+		projectModel:      mp,
+		userModel:         mu,
+		donationModel:     md,
+		notificationModel: mn,
+		rankingModel:      mr,
+		notifier:          n,
+		digest:            ds,
+		webhooks:          wr,
+		retention:         retention,
+		reminders:         reminders,
+		rankings:          rankings,
+		clock:             clock,
+		recalcChan:        make(chan int, 100),
+		updateChan:        make(chan int, 100),
+		searchChan:        make(chan *models.Project, 10),
+		harverstChan:      make(chan *models.Project, 10),
+		wg:                &sync.WaitGroup{},
 	}
 }
 
@@ -48,7 +93,9 @@ func (b *Background) Start(ctx context.Context) {
 	go b.CheckSearch(b.wg)
 	go b.HarvestCheck(b.wg)
 	go b.UpdateUser(b.wg)
-	b.wg.Add(5)
+	go b.RetryWebhooks(ctx, b.wg)
+	go b.UpdateRankings(ctx, b.wg)
+	b.wg.Add(7)
 
 }
 
@@ -63,8 +110,20 @@ func (b *Background) PeriodicCheck(ctx context.Context, wg *sync.WaitGroup) {
 	defer close(b.recalcChan)
 	ticker := time.NewTicker(time.Second * 1)
 	defer ticker.Stop()
+	var reminders <-chan time.Time
+	if b.reminders.CheckInterval > 0 {
+		reminderTicker := time.NewTicker(b.reminders.CheckInterval)
+		defer reminderTicker.Stop()
+		reminders = reminderTicker.C
+	}
+	publishTicker := time.NewTicker(publishCheckInterval)
+	defer publishTicker.Stop()
 	for {
 		select {
+		case t := <-publishTicker.C:
+			b.publishScheduled(t)
+		case <-reminders:
+			b.checkHarvestProjects()
 		case t := <-ticker.C:
 			system, err := b.systemModel.Get()
 			if err != nil {
@@ -80,12 +139,20 @@ func (b *Background) PeriodicCheck(ctx context.Context, wg *sync.WaitGroup) {
 				log.Error(err)
 				continue
 			}
+			b.cleanNotifications(t)
+			if b.digest != nil {
+				b.digest.SendDigests()
+			}
 			projects, err := b.projectModel.GetActiveProjects()
 			if err != nil {
 				log.Error(err)
 				continue
 			}
-			for _, project := range *projects {
+			for i := range *projects {
+				project := &(*projects)[i]
+				if isTomorrow(project.EventDate, t) {
+					b.notifyProject(EventEventTomorrow, project)
+				}
 				b.recalcChan <- project.ID
 			}
 		case <-ctx.Done():
@@ -95,6 +162,39 @@ func (b *Background) PeriodicCheck(ctx context.Context, wg *sync.WaitGroup) {
 	}
 }
 
+// RetryWebhooks retries failed webhook deliveries until context is done.
+func (b *Background) RetryWebhooks(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if b.webhooks == nil {
+		return
+	}
+	ticker := time.NewTicker(webhookRetryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.webhooks.RetryPending()
+		case <-ctx.Done():
+			log.Info("stop webhook retries")
+			return
+		}
+	}
+}
+
+// checkHarvestProjects sends projects on harvest stage through pipeline to remind about payments.
+func (b *Background) checkHarvestProjects() {
+	projects, err := b.projectModel.GetActiveProjects()
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	for _, project := range *projects {
+		if project.Locked {
+			b.recalcChan <- project.ID
+		}
+	}
+}
+
 // RecalcProject update total for project
 func (b *Background) RecalcProject(wg *sync.WaitGroup) {
 	defer wg.Done()
@@ -113,25 +213,134 @@ func (b *Background) RecalcProject(wg *sync.WaitGroup) {
 			log.Errorf("project %d not found", projectID)
 			continue
 		}
-		if project.Locked {
-			b.searchChan <- project
-			continue
-		}
 		strategy, err := GetStrategy(&project.ProjectType, b.projectModel)
 		if err != nil {
 			log.Errorf("unable to get stategy for project %d", projectID)
 			continue
 		}
+		if project.Closed {
+			// closed projects never change stage again, only total of failed and cancelled ones is kept up to date
+			if !project.Locked {
+				err = strategy.Recalc(project)
+				if err != nil {
+					log.Errorf("unable to recalc project %d: %s", projectID, err)
+				}
+			}
+			continue
+		}
+		if project.Locked {
+			b.resplit(strategy, project)
+			b.searchChan <- project
+			continue
+		}
+		total, percent := project.Total, strategy.Percent(project)
 		err = strategy.Recalc(project)
 		if err != nil {
 			log.Error(err)
 			log.Errorf("unable to recalc project %d", projectID)
 			continue
 		}
+		if project.Total != total || strategy.Percent(project) != percent {
+			b.notifier.Notify(progressEvent(strategy, project))
+		}
 		b.searchChan <- project
 	}
 }
 
+func (b *Background) resplit(strategy Strategy, project *models.Project) {
+	adjustments, err := strategy.Resplit(project)
+	if err != nil {
+		log.Error(err)
+		log.Errorf("unable to resplit project %d", project.ID)
+		return
+	}
+	for _, adjustment := range adjustments {
+		b.notifier.Notify(Event{
+			Type:      EventShareChanged,
+			ProjectID: adjustment.ProjectID,
+			UserIDs:   []int{adjustment.UserID},
+			Data: map[string]interface{}{
+				"old_payment": adjustment.OldPayment,
+				"new_payment": adjustment.NewPayment,
+				"credit":      adjustment.Credit,
+				"currency":    project.Currency,
+			},
+		})
+	}
+}
+
+// cleanNotifications deletes notifications older than retention period.
+func (b *Background) cleanNotifications(now time.Time) {
+	deleted, err := b.notificationModel.DeleteOlderThan(now.Add(-b.retention))
+	if err != nil {
+		log.Errorf("unable to clean notifications: %s", err)
+		return
+	}
+	log.Infof("%d outdated notifications deleted", deleted)
+}
+
+// isTomorrow returns true if date is on the next calendar day after now.
+func isTomorrow(date, now time.Time) bool {
+	if date.IsZero() {
+		return false
+	}
+	tomorrow := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, now.Location())
+	date = date.In(now.Location())
+
+	return !date.Before(tomorrow) && date.Before(tomorrow.AddDate(0, 0, 1))
+}
+
+// notifyProject sends event to owner and participants of project.
+func (b *Background) notifyProject(eventType EventType, project *models.Project) []models.Donation {
+	donations, err := b.donationModel.GetAllByProject(project.ID)
+	if err != nil {
+		log.Errorf("unable to get donations of project %d", project.ID)
+		return nil
+	}
+	userIDs := make([]int, 0, len(donations)+1)
+	userIDs = append(userIDs, project.OwnerID)
+	for _, d := range donations {
+		if d.UserID != project.OwnerID {
+			userIDs = append(userIDs, d.UserID)
+		}
+	}
+	data := map[string]interface{}{"title": project.Title}
+	if !project.EventDate.IsZero() {
+		data["event_date"] = project.EventDate.Format(DateTimeLayout)
+	}
+	b.notifier.Notify(Event{
+		Type:      eventType,
+		ProjectID: project.ID,
+		UserIDs:   userIDs,
+		Data:      data,
+	})
+
+	return donations
+}
+
+// projectLocked notifies about lock and asks participants of money project to pay.
+func (b *Background) projectLocked(project *models.Project) {
+	donations := b.notifyProject(EventProjectLocked, project)
+	if !project.ProjectType.GoalByAmount {
+		return
+	}
+	for _, d := range donations {
+		if d.Paid || d.Payment == 0 {
+			continue
+		}
+		b.notifier.Notify(Event{
+			Type:      EventPaymentDue,
+			ProjectID: project.ID,
+			UserIDs:   []int{d.UserID},
+			Data: map[string]interface{}{
+				"title":    project.Title,
+				"amount":   d.Payment - d.PaidAmount,
+				"currency": project.Currency,
+			},
+		})
+	}
+}
+
 // CheckSearch check project for search stage
 func (b *Background) CheckSearch(wg *sync.WaitGroup) {
 	defer wg.Done()
@@ -151,11 +360,15 @@ func (b *Background) CheckSearch(wg *sync.WaitGroup) {
 			log.Errorf("unable to get stategy for project %d", project.ID)
 			continue
 		}
-		_, err = strategy.CheckSearch(project)
+		locked, err := strategy.CheckSearch(project, b.clock.Now())
 		if err != nil {
 			log.Errorf("unable to check search for project %d", project.ID)
 			continue
 		}
+		if locked {
+			b.notifier.Notify(progressEvent(strategy, project))
+			b.projectLocked(project)
+		}
 		b.harverstChan <- project
 	}
 }
@@ -181,6 +394,8 @@ func (b *Background) HarvestCheck(wg *sync.WaitGroup) {
 				log.Errorf("unable to check outdate for project %d", project.ID)
 			}
 			if closed {
+				b.notifier.Notify(progressEvent(strategy, project))
+				b.notifyProject(EventProjectFailed, project)
 				b.updateChan <- project.OwnerID
 			}
 
@@ -192,6 +407,12 @@ func (b *Background) HarvestCheck(wg *sync.WaitGroup) {
 			continue
 		}
 		if evolved {
+			b.notifier.Notify(progressEvent(strategy, project))
+			b.notifyProject(EventProjectSucceeded, project)
+			b.updateChan <- project.OwnerID
+			continue
+		}
+		if b.remindPayments(strategy, project, b.clock.Now()) {
 			b.updateChan <- project.OwnerID
 		}
 	}
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type PipelineSuite struct {
+	suite.Suite
+	mockProjectCtl *gomock.Controller
+	mockProject    *mocks.MockProjectImpl
+	notifier       *fakeNotifier
+	background     *Background
+}
+
+func (s *PipelineSuite) SetupTest() {
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.notifier = &fakeNotifier{}
+	s.background = NewBackground(nil, s.mockProject, nil, nil, nil, nil, s.notifier, nil, nil, 0, ReminderPolicy{}, RankingPolicy{}, clockwork.NewFakeClock())
+}
+
+func (s *PipelineSuite) TearDownTest() {
+	s.mockProjectCtl.Finish()
+}
+
+// recalc passes project through recalc stage and returns projects sent to search stage.
+func (s *PipelineSuite) recalc(projectID int) []*models.Project {
+	s.background.recalcChan <- projectID
+	close(s.background.recalcChan)
+	s.background.wg.Add(1)
+	s.background.RecalcProject(s.background.wg)
+	forwarded := make([]*models.Project, 0)
+	for p := range s.background.searchChan {
+		forwarded = append(forwarded, p)
+	}
+
+	return forwarded
+}
+
+func (s *PipelineSuite) TestRecalcCancelledProject() {
+	project := &models.Project{
+		ID:          1,
+		Published:   true,
+		Closed:      true,
+		GoalAmount:  1000,
+		Total:       1000,
+		ReleaseDate: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+		CancelledAt: time.Date(2020, 9, 20, 0, 0, 0, 0, time.UTC),
+		ProjectType: models.ProjectType{GoalByAmount: true, EndByGoalGain: true},
+	}
+	s.mockProject.EXPECT().Get(1).Return(project, true)
+	s.mockProject.EXPECT().UpdateTotalByPayment(project).Return(nil)
+
+	s.Require().Empty(s.recalc(1))
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *PipelineSuite) TestRecalcSucceededProject() {
+	project := &models.Project{
+		ID:          1,
+		Published:   true,
+		Closed:      true,
+		Locked:      true,
+		ProjectType: models.ProjectType{GoalByAmount: true, EndByGoalGain: true},
+	}
+	s.mockProject.EXPECT().Get(1).Return(project, true)
+
+	s.Require().Empty(s.recalc(1))
+}
+
+func (s *PipelineSuite) TestRecalcSearchingProject() {
+	project := &models.Project{
+		ID:          1,
+		Published:   true,
+		GoalAmount:  1000,
+		ProjectType: models.ProjectType{GoalByAmount: true, EndByGoalGain: true},
+	}
+	s.mockProject.EXPECT().Get(1).Return(project, true)
+	s.mockProject.EXPECT().UpdateTotalByPayment(project).Return(nil)
+
+	s.Require().Equal([]*models.Project{project}, s.recalc(1))
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *PipelineSuite) TestRecalcProgressChanged() {
+	project := &models.Project{
+		ID:          1,
+		Published:   true,
+		GoalAmount:  1000,
+		ProjectType: models.ProjectType{GoalByAmount: true, EndByGoalGain: true},
+	}
+	s.mockProject.EXPECT().Get(1).Return(project, true)
+	s.mockProject.EXPECT().UpdateTotalByPayment(project).DoAndReturn(func(p *models.Project) error {
+		p.Total = 500
+		return nil
+	})
+
+	s.Require().Equal([]*models.Project{project}, s.recalc(1))
+	s.Require().Len(s.notifier.events, 1)
+	s.Require().Equal(EventProjectProgress, s.notifier.events[0].Type)
+	s.Require().Equal(50, s.notifier.events[0].Data["percent"])
+}
+
+func TestPipelineSuite(t *testing.T) {
+	suite.Run(t, new(PipelineSuite))
+}
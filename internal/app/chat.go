@@ -0,0 +1,101 @@
+package app
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrChatLinkCodeInvalid chat link code is unknown or expired.
+	ErrChatLinkCodeInvalid = errors.New("invalid link code")
+	// ErrChatAccountNotLinked chat account is not linked to any user.
+	ErrChatAccountNotLinked = errors.New("chat account is not linked")
+)
+
+const (
+	chatLinkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	chatLinkCodeLength   = 8
+	chatLinkTTL          = 10 * time.Minute
+)
+
+func newChatLinkCode() (string, error) {
+	code := make([]byte, chatLinkCodeLength)
+	max := big.NewInt(int64(len(chatLinkCodeAlphabet)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = chatLinkCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
+
+// CreateChatLinkCode returns one-time code which user sends to chat bot to link chat account.
+func (a *App) CreateChatLinkCode(userID int) (string, time.Time, error) {
+	code, err := newChatLinkCode()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	account, ok := a.chatAccountModel.Get(userID)
+	if !ok {
+		account = &models.ChatAccount{UserID: userID}
+	}
+	account.LinkCode = code
+	account.LinkExpiresAt = a.clock.Now().Add(chatLinkTTL)
+	err = a.chatAccountModel.Save(account)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return code, account.LinkExpiresAt, nil
+}
+
+// LinkChatAccount links chat account to user who created the code.
+// Chat account previously linked to another user is moved.
+func (a *App) LinkChatAccount(code, chatUserID, chatUsername string) (int, error) {
+	if code == "" {
+		return 0, ErrChatLinkCodeInvalid
+	}
+	account, ok := a.chatAccountModel.GetByCode(code)
+	if !ok || a.clock.Now().After(account.LinkExpiresAt) {
+		return 0, ErrChatLinkCodeInvalid
+	}
+	linked, ok := a.chatAccountModel.GetByChatUser(chatUserID)
+	if ok && linked.UserID != account.UserID {
+		err := a.chatAccountModel.Delete(linked.UserID)
+		if err != nil {
+			return 0, err
+		}
+	}
+	account.ChatUserID = chatUserID
+	account.ChatUsername = chatUsername
+	account.LinkCode = ""
+	account.LinkExpiresAt = time.Time{}
+	err := a.chatAccountModel.Save(account)
+	if err != nil {
+		return 0, err
+	}
+
+	return account.UserID, nil
+}
+
+// UnlinkChatAccount removes link between user and chat account.
+func (a *App) UnlinkChatAccount(userID int) error {
+	return a.chatAccountModel.Delete(userID)
+}
+
+// GetUserByChatAccount returns id of user linked to chat account.
+func (a *App) GetUserByChatAccount(chatUserID string) (int, error) {
+	account, ok := a.chatAccountModel.GetByChatUser(chatUserID)
+	if !ok {
+		return 0, ErrChatAccountNotLinked
+	}
+
+	return account.UserID, nil
+}
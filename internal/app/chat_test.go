@@ -0,0 +1,76 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ChatSuite struct {
+	suite.Suite
+	mockChatAccountCtl *gomock.Controller
+	mockChatAccount    *mocks.MockChatAccountImpl
+	clock              clockwork.FakeClock
+	app                *App
+}
+
+func (s *ChatSuite) SetupTest() {
+	s.mockChatAccountCtl = gomock.NewController(s.T())
+	s.mockChatAccount = mocks.NewMockChatAccountImpl(s.mockChatAccountCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.app = New(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockChatAccount, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *ChatSuite) TearDownTest() {
+	s.mockChatAccountCtl.Finish()
+}
+
+func (s *ChatSuite) TestCreateChatLinkCode() {
+	s.mockChatAccount.EXPECT().Get(1).Return(nil, false)
+	s.mockChatAccount.EXPECT().Save(gomock.Any()).Return(nil)
+
+	code, expiresAt, err := s.app.CreateChatLinkCode(1)
+	s.Require().NoError(err)
+	s.Require().Len(code, chatLinkCodeLength)
+	s.Require().Equal(s.clock.Now().Add(chatLinkTTL), expiresAt)
+}
+
+func (s *ChatSuite) TestLinkChatAccount() {
+	account := &models.ChatAccount{UserID: 1, LinkCode: "ABCD2345", LinkExpiresAt: s.clock.Now().Add(time.Minute)}
+	s.mockChatAccount.EXPECT().GetByCode("ABCD2345").Return(account, true)
+	s.mockChatAccount.EXPECT().GetByChatUser("100").Return(&models.ChatAccount{UserID: 2, ChatUserID: "100"}, true)
+	s.mockChatAccount.EXPECT().Delete(2).Return(nil)
+	s.mockChatAccount.EXPECT().Save(account).Return(nil)
+
+	userID, err := s.app.LinkChatAccount("ABCD2345", "100", "user")
+	s.Require().NoError(err)
+	s.Require().Equal(1, userID)
+	s.Require().Equal("100", account.ChatUserID)
+	s.Require().Equal("user", account.ChatUsername)
+	s.Require().Empty(account.LinkCode)
+}
+
+func (s *ChatSuite) TestLinkChatAccountExpired() {
+	account := &models.ChatAccount{UserID: 1, LinkCode: "ABCD2345", LinkExpiresAt: s.clock.Now().Add(-time.Minute)}
+	s.mockChatAccount.EXPECT().GetByCode("ABCD2345").Return(account, true)
+
+	_, err := s.app.LinkChatAccount("ABCD2345", "100", "user")
+	s.Require().Equal(ErrChatLinkCodeInvalid, err)
+}
+
+func (s *ChatSuite) TestGetUserByChatAccountNotLinked() {
+	s.mockChatAccount.EXPECT().GetByChatUser("100").Return(nil, false)
+
+	_, err := s.app.GetUserByChatAccount("100")
+	s.Require().Equal(ErrChatAccountNotLinked, err)
+}
+
+func TestChatSuite(t *testing.T) {
+	suite.Run(t, new(ChatSuite))
+}
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+const week = 7 * 24 * time.Hour
+
+// cloneShift returns how far dates of cloned project are moved.
+// Without requested release date they are moved by whole weeks, so weekday is kept
+// and release date is in the future.
+func cloneShift(release, requested, now time.Time) time.Duration {
+	if release.IsZero() {
+		return 0
+	}
+	if !requested.IsZero() {
+		return requested.Sub(release)
+	}
+	if release.After(now) {
+		return 0
+	}
+
+	return (now.Sub(release)/week + 1) * week
+}
+
+// shiftDate moves date, empty date stays empty.
+func shiftDate(date time.Time, shift time.Duration) time.Time {
+	if date.IsZero() {
+		return date
+	}
+
+	return date.Add(shift)
+}
+
+// CloneProject copies project managed by user with its tiers and tags into new draft owned by user.
+// Dates are shifted, progress and participants are not copied.
+func (a *App) CloneProject(userID, projectID int, releaseDate time.Time) (int, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return 0, ErrProjectNotFound
+	}
+	if !a.can(project, userID, permEdit) {
+		return 0, ErrProjectModifyNotAllowed
+	}
+	shift := cloneShift(project.ReleaseDate, releaseDate, a.clock.Now())
+	clone := models.Project{
+		OwnerID:        userID,
+		Title:          project.Title,
+		SubTitle:       project.SubTitle,
+		ReleaseDate:    shiftDate(project.ReleaseDate, shift),
+		EventDate:      shiftDate(project.EventDate, shift),
+		GoalPeople:     project.GoalPeople,
+		GoalAmount:     project.GoalAmount,
+		Currency:       project.Currency,
+		Description:    project.Description,
+		ImageLink:      project.ImageLink,
+		Instructions:   project.Instructions,
+		CategoryID:     project.CategoryID,
+		ProjectTypeID:  project.ProjectTypeID,
+		PrivateAmounts: project.PrivateAmounts,
+		PledgeRules:    project.PledgeRules,
+	}
+	if !releaseDate.IsZero() {
+		clone.ReleaseDate = releaseDate
+	}
+	err := a.projectModel.Clone(project.ID, &clone)
+	if err != nil {
+		return 0, err
+	}
+
+	return clone.ID, a.saveRevision(&clone, userID)
+}
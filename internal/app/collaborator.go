@@ -0,0 +1,212 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrAdminRequired action is allowed only for admins.
+	ErrAdminRequired = errors.New("admin required")
+	// ErrCollaboratorNotFound user is not collaborator or invited to project.
+	ErrCollaboratorNotFound = errors.New("collaborator not found")
+)
+
+// permission action on project which may be delegated to collaborators.
+type permission int
+
+const (
+	// permEdit edit draft, tiers, tags and privacy, post updates.
+	permEdit permission = iota
+	// permPayments confirm payments, settle credits and see private amounts.
+	permPayments
+	// permModerate moderate guestbook and comments.
+	permModerate
+)
+
+// rolePermissions permissions granted by roles in project.
+var rolePermissions = map[string]map[permission]bool{
+	models.RoleOwner:     {permEdit: true, permPayments: true, permModerate: true},
+	models.RoleCoOwner:   {permEdit: true, permPayments: true, permModerate: true},
+	models.RoleTreasurer: {permPayments: true},
+	models.RoleModerator: {permModerate: true},
+}
+
+// projectRole returns role of user in project, empty if user isn't member of project.
+func (a *App) projectRole(p *models.Project, userID int) string {
+	if p.OwnerID == userID {
+		return models.RoleOwner
+	}
+	if userID == 0 {
+		return ""
+	}
+	collaborator, ok := a.collaboratorModel.Get(p.ID, userID)
+	if !ok || !collaborator.Accepted() {
+		return ""
+	}
+
+	return collaborator.Role
+}
+
+// can checks user has role in project granting permission.
+func (a *App) can(p *models.Project, userID int, perm permission) bool {
+	return rolePermissions[a.projectRole(p, userID)][perm]
+}
+
+// canViewProject checks project is visible to user.
+// Drafts are visible only to owner and collaborators.
+func (a *App) canViewProject(p *models.Project, userID int) bool {
+	return p.Published || a.projectRole(p, userID) != ""
+}
+
+// requireAdmin returns error if user is not admin.
+func (a *App) requireAdmin(userID int) error {
+	user, ok := a.userModel.Get(userID)
+	if !ok || !user.IsAdmin {
+		return ErrAdminRequired
+	}
+
+	return nil
+}
+
+// GetCollaborators returns collaborators and pending invitations of project visible to viewer.
+func (a *App) GetCollaborators(viewerID, projectID int) ([]models.Collaborator, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, viewerID) {
+		return nil, ErrProjectNotFound
+	}
+
+	return a.collaboratorModel.GetAllByProject(projectID)
+}
+
+// InviteCollaborator invites user to manage project with role, only owner can invite.
+func (a *App) InviteCollaborator(ownerID, projectID, userID int, role string) (*models.Collaborator, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if project.OwnerID != ownerID {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	verr := &ValidationError{}
+	if role == models.RoleOwner || rolePermissions[role] == nil {
+		verr.add("role", CodeInvalid, fmt.Sprintf("unknown role %q", role))
+	}
+	if userID == ownerID {
+		verr.add("user", CodeNotAllowed, "owner can't be invited")
+	} else if _, ok := a.userModel.Get(userID); !ok {
+		verr.add("user", CodeInvalid, "user not found")
+	} else if _, ok := a.collaboratorModel.Get(projectID, userID); ok {
+		verr.add("user", CodeNotAllowed, "user is already invited")
+	}
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+	collaborator := &models.Collaborator{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: ownerID,
+		CreatedAt: a.clock.Now(),
+	}
+	err := a.collaboratorModel.Create(collaborator)
+	if err != nil {
+		return nil, err
+	}
+	a.notifier.Notify(Event{
+		Type:      EventCollaboratorInvited,
+		ProjectID: projectID,
+		UserIDs:   []int{userID},
+		Data: map[string]interface{}{
+			"title": project.Title,
+			"role":  role,
+		},
+	})
+
+	return collaborator, nil
+}
+
+// GetInvitations returns pending invitations of user.
+func (a *App) GetInvitations(userID int) ([]models.Collaborator, error) {
+	return a.collaboratorModel.GetInvitations(userID)
+}
+
+// AcceptInvitation accepts invitation of user to project.
+func (a *App) AcceptInvitation(userID, projectID int) (*models.Collaborator, error) {
+	collaborator, ok := a.collaboratorModel.Get(projectID, userID)
+	if !ok {
+		return nil, ErrCollaboratorNotFound
+	}
+	if collaborator.Accepted() {
+		return collaborator, nil
+	}
+	collaborator.AcceptedAt = a.clock.Now()
+	err := a.collaboratorModel.Accept(collaborator)
+	if err != nil {
+		return nil, err
+	}
+
+	return collaborator, nil
+}
+
+// RemoveCollaborator removes collaborator or declines invitation.
+// Owner can remove anyone, collaborators can only leave.
+func (a *App) RemoveCollaborator(actorID, projectID, userID int) error {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return ErrProjectNotFound
+	}
+	if actorID != userID && project.OwnerID != actorID {
+		return ErrProjectModifyNotAllowed
+	}
+	collaborator, ok := a.collaboratorModel.Get(projectID, userID)
+	if !ok {
+		return ErrCollaboratorNotFound
+	}
+
+	return a.collaboratorModel.Delete(collaborator)
+}
+
+// TransferOwnership makes collaborator owner of project, previous owner stays co-owner.
+func (a *App) TransferOwnership(ownerID, projectID, userID int) (*ExtendedProject, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if project.OwnerID != ownerID {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	collaborator, ok := a.collaboratorModel.Get(projectID, userID)
+	if !ok || !collaborator.Accepted() {
+		return nil, ErrCollaboratorNotFound
+	}
+	now := a.clock.Now()
+	previous := &models.Collaborator{
+		ProjectID:  projectID,
+		UserID:     ownerID,
+		Role:       models.RoleCoOwner,
+		InvitedBy:  userID,
+		AcceptedAt: now,
+		CreatedAt:  now,
+	}
+	err := a.collaboratorModel.TransferOwnership(project, collaborator, previous)
+	if err != nil {
+		return nil, err
+	}
+	a.notifier.Notify(Event{
+		Type:      EventOwnershipTransferred,
+		ProjectID: projectID,
+		UserIDs:   []int{userID},
+		Data: map[string]interface{}{
+			"title":          project.Title,
+			"previous_owner": ownerID,
+		},
+	})
+	if owner, ok := a.userModel.Get(userID); ok {
+		project.Owner = *owner
+	}
+
+	return a.extendProject(project)
+}
@@ -0,0 +1,244 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type CollaboratorSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockUserCtl         *gomock.Controller
+	mockUser            *mocks.MockUserImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockDonationCtl     *gomock.Controller
+	mockDonation        *mocks.MockDonationImpl
+	notifier            *fakeNotifier
+	recalcChan          chan int
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *CollaboratorSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockDonationCtl = gomock.NewController(s.T())
+	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.notifier = &fakeNotifier{}
+	s.recalcChan = make(chan int, 1)
+	s.clock = clockwork.NewFakeClockAt(time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC))
+	s.app = New(nil, s.mockUser, s.mockProject, nil, s.mockDonation, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, nil, nil, nil, s.notifier, s.clock, "", s.recalcChan)
+}
+
+func (s *CollaboratorSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockDonationCtl.Finish()
+}
+
+func (s *CollaboratorSuite) project() *models.Project {
+	return &models.Project{
+		ID:      10,
+		OwnerID: 42,
+		Title:   "Pizza",
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}
+}
+
+func (s *CollaboratorSuite) TestInviteCollaborator() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockUser.EXPECT().Get(7).Return(&models.User{ID: 7}, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(nil, false)
+	expected := &models.Collaborator{
+		ProjectID: 10,
+		UserID:    7,
+		Role:      models.RoleTreasurer,
+		InvitedBy: 42,
+		CreatedAt: s.clock.Now(),
+	}
+	s.mockCollaborator.EXPECT().Create(expected).Return(nil)
+
+	collaborator, err := s.app.InviteCollaborator(42, 10, 7, models.RoleTreasurer)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, collaborator)
+	s.Require().Equal([]Event{{
+		Type:      EventCollaboratorInvited,
+		ProjectID: 10,
+		UserIDs:   []int{7},
+		Data: map[string]interface{}{
+			"title": "Pizza",
+			"role":  models.RoleTreasurer,
+		},
+	}}, s.notifier.events)
+}
+
+func (s *CollaboratorSuite) TestInviteCollaboratorNotOwner() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	_, err := s.app.InviteCollaborator(7, 10, 8, models.RoleModerator)
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+}
+
+func (s *CollaboratorSuite) TestInviteCollaboratorInvalid() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	_, err := s.app.InviteCollaborator(42, 10, 42, models.RoleOwner)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "role", Code: CodeInvalid, Message: `unknown role "owner"`},
+		{Field: "user", Code: CodeNotAllowed, Message: "owner can't be invited"},
+	}, vErr.Fields)
+}
+
+func (s *CollaboratorSuite) TestInviteCollaboratorTwice() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockUser.EXPECT().Get(7).Return(&models.User{ID: 7}, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{ProjectID: 10, UserID: 7}, true)
+
+	_, err := s.app.InviteCollaborator(42, 10, 7, models.RoleCoOwner)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "user", Code: CodeNotAllowed, Message: "user is already invited"},
+	}, vErr.Fields)
+}
+
+func (s *CollaboratorSuite) TestAcceptInvitation() {
+	collaborator := &models.Collaborator{ProjectID: 10, UserID: 7, Role: models.RoleModerator}
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(collaborator, true)
+	s.mockCollaborator.EXPECT().Accept(collaborator).Return(nil)
+
+	result, err := s.app.AcceptInvitation(7, 10)
+	s.Require().NoError(err)
+	s.Require().Equal(s.clock.Now(), result.AcceptedAt)
+}
+
+func (s *CollaboratorSuite) TestAcceptInvitationNotFound() {
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(nil, false)
+
+	_, err := s.app.AcceptInvitation(7, 10)
+	s.Require().Equal(ErrCollaboratorNotFound, err)
+}
+
+func (s *CollaboratorSuite) TestTreasurerConfirmsPayment() {
+	donation := &models.Donation{ID: 1, UserID: 111, ProjectID: 10, Payment: 500, Locked: true, Project: *s.project()}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{
+		ProjectID:  10,
+		UserID:     7,
+		Role:       models.RoleTreasurer,
+		AcceptedAt: s.clock.Now(),
+	}, true)
+	s.mockDonation.EXPECT().UpdatePaid(donation).Return(nil)
+
+	result, err := s.app.UpdateDonation(1, 7, 0, true)
+	s.Require().NoError(err)
+	s.Require().True(result.Paid)
+	s.Require().Equal(int64(500), result.PaidAmount)
+}
+
+func (s *CollaboratorSuite) TestPendingInvitationGrantsNothing() {
+	donation := &models.Donation{ID: 1, UserID: 111, ProjectID: 10, Payment: 500, Locked: true, Project: *s.project()}
+	s.mockDonation.EXPECT().Get(1).Return(donation, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{ProjectID: 10, UserID: 7, Role: models.RoleTreasurer}, true)
+
+	_, err := s.app.UpdateDonation(1, 7, 0, true)
+	s.Require().Equal(ErrDonationModifyNotAllowed, err)
+}
+
+func (s *CollaboratorSuite) TestModeratorCantEditProject() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{
+		ProjectID:  10,
+		UserID:     7,
+		Role:       models.RoleModerator,
+		AcceptedAt: s.clock.Now(),
+	}, true)
+
+	_, err := s.app.SetProjectPrivacy(7, 10, true)
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+}
+
+func (s *CollaboratorSuite) TestCoOwnerEditsProject() {
+	project := s.project()
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{
+		ProjectID:  10,
+		UserID:     7,
+		Role:       models.RoleCoOwner,
+		AcceptedAt: s.clock.Now(),
+	}, true)
+	s.mockProject.EXPECT().UpdatePrivacy(project).Return(nil)
+
+	result, err := s.app.SetProjectPrivacy(7, 10, true)
+	s.Require().NoError(err)
+	s.Require().True(result.PrivateAmounts)
+}
+
+func (s *CollaboratorSuite) TestRemoveCollaboratorLeave() {
+	collaborator := &models.Collaborator{ProjectID: 10, UserID: 7}
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(collaborator, true)
+	s.mockCollaborator.EXPECT().Delete(collaborator).Return(nil)
+
+	s.Require().NoError(s.app.RemoveCollaborator(7, 10, 7))
+}
+
+func (s *CollaboratorSuite) TestRemoveCollaboratorNotAllowed() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	s.Require().Equal(ErrProjectModifyNotAllowed, s.app.RemoveCollaborator(8, 10, 7))
+}
+
+func (s *CollaboratorSuite) TestTransferOwnership() {
+	project := s.project()
+	collaborator := &models.Collaborator{ProjectID: 10, UserID: 7, Role: models.RoleCoOwner, AcceptedAt: s.clock.Now()}
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(collaborator, true)
+	s.mockCollaborator.EXPECT().TransferOwnership(project, collaborator, &models.Collaborator{
+		ProjectID:  10,
+		UserID:     42,
+		Role:       models.RoleCoOwner,
+		InvitedBy:  7,
+		AcceptedAt: s.clock.Now(),
+		CreatedAt:  s.clock.Now(),
+	}).Return(nil)
+	s.mockUser.EXPECT().Get(7).Return(&models.User{ID: 7, Username: "new_owner"}, true)
+
+	result, err := s.app.TransferOwnership(42, 10, 7)
+	s.Require().NoError(err)
+	s.Require().Equal(7, result.Owner.ID)
+	s.Require().Len(s.notifier.events, 1)
+	s.Require().Equal(EventOwnershipTransferred, s.notifier.events[0].Type)
+	s.Require().Equal([]int{7}, s.notifier.events[0].UserIDs)
+}
+
+func (s *CollaboratorSuite) TestTransferOwnershipPending() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{ProjectID: 10, UserID: 7, Role: models.RoleCoOwner}, true)
+
+	_, err := s.app.TransferOwnership(42, 10, 7)
+	s.Require().Equal(ErrCollaboratorNotFound, err)
+}
+
+func TestCollaboratorSuite(t *testing.T) {
+	suite.Run(t, new(CollaboratorSuite))
+}
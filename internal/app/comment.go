@@ -0,0 +1,214 @@
+package app
+
+import (
+	"errors"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrCommentNotFound comment with given id not found.
+	ErrCommentNotFound = errors.New("comment not found")
+	// ErrCommentModifyNotAllowed comment modifying not allowed.
+	ErrCommentModifyNotAllowed = errors.New("modifying forbidden")
+)
+
+const maxCommentLength = 2000
+
+var mentionRe = regexp.MustCompile(`(?:^|[^\w@])@(\w{1,32})`)
+
+// parseMentions returns unique usernames mentioned in text.
+func parseMentions(text string) []string {
+	usernames := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, m := range mentionRe.FindAllStringSubmatch(text, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			usernames = append(usernames, m[1])
+		}
+	}
+
+	return usernames
+}
+
+// validateComment checks comment text.
+func validateComment(text string) error {
+	verr := &ValidationError{}
+	length := utf8.RuneCountInString(text)
+	if length == 0 {
+		verr.add("text", CodeRequired, "text is required")
+	}
+	if length > maxCommentLength {
+		verr.add("text", CodeMax, "text is too long")
+	}
+
+	return verr.errOrNil()
+}
+
+// resolveMentions sets mentioned users of comment by its text.
+func (a *App) resolveMentions(c *models.Comment) error {
+	users, err := a.userModel.GetByUsernames(parseMentions(c.Text))
+	if err != nil {
+		return err
+	}
+	c.Mentions = users
+	c.MentionIDs = make([]int, 0, len(users))
+	for _, u := range users {
+		c.MentionIDs = append(c.MentionIDs, u.ID)
+	}
+
+	return nil
+}
+
+// fillMentions loads mentioned users of comments and hides text of deleted ones.
+func (a *App) fillMentions(comments []models.Comment) error {
+	ids := make([]int, 0)
+	for _, c := range comments {
+		ids = append(ids, c.MentionIDs...)
+	}
+	users, err := a.userModel.GetByIDs(ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]models.User, len(users))
+	for _, u := range users {
+		byID[u.ID] = u
+	}
+	for i := range comments {
+		comments[i].Mentions = make([]models.User, 0, len(comments[i].MentionIDs))
+		if comments[i].Deleted {
+			comments[i].Text = ""
+			continue
+		}
+		for _, id := range comments[i].MentionIDs {
+			if u, ok := byID[id]; ok {
+				comments[i].Mentions = append(comments[i].Mentions, u)
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetProjectComments returns top level comments of project visible to viewer.
+func (a *App) GetProjectComments(projectID, viewerID, cursor, limit int) ([]models.Comment, int, bool, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, viewerID) {
+		return nil, 0, false, ErrProjectNotFound
+	}
+	comments, err := a.commentModel.GetThreads(projectID, cursor, pageFetchLimit(limit))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	n, next, hasNext := cutPage(len(comments), limit, func(i int) int { return comments[i].ID })
+	comments = comments[:n]
+
+	return comments, next, hasNext, a.fillMentions(comments)
+}
+
+// GetCommentReplies returns replies to comment visible to viewer.
+func (a *App) GetCommentReplies(commentID, viewerID, cursor, limit int) ([]models.Comment, int, bool, error) {
+	parent, ok := a.commentModel.Get(commentID)
+	if !ok {
+		return nil, 0, false, ErrCommentNotFound
+	}
+	project, ok := a.projectModel.Get(parent.ProjectID)
+	if !ok || !a.canViewProject(project, viewerID) {
+		return nil, 0, false, ErrCommentNotFound
+	}
+	comments, err := a.commentModel.GetReplies(commentID, cursor, pageFetchLimit(limit))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	n, next, hasNext := cutPage(len(comments), limit, func(i int) int { return comments[i].ID })
+	comments = comments[:n]
+
+	return comments, next, hasNext, a.fillMentions(comments)
+}
+
+// CreateComment creates comment or reply to comment of project.
+// Reply to reply is attached to thread of root comment.
+func (a *App) CreateComment(userID, projectID, parentID int, text string) (*models.Comment, error) {
+	err := validateComment(text)
+	if err != nil {
+		return nil, err
+	}
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, userID) {
+		return nil, ErrProjectNotFound
+	}
+	if parentID != 0 {
+		parent, ok := a.commentModel.Get(parentID)
+		if !ok || parent.ProjectID != projectID {
+			return nil, ErrCommentNotFound
+		}
+		if parent.ParentID != 0 {
+			parentID = parent.ParentID
+		}
+	}
+	comment := &models.Comment{
+		ProjectID: projectID,
+		ParentID:  parentID,
+		UserID:    userID,
+		Text:      text,
+		CreatedAt: a.clock.Now(),
+	}
+	err = a.resolveMentions(comment)
+	if err != nil {
+		return nil, err
+	}
+	err = a.commentModel.Create(comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// UpdateComment changes text of comment by its author.
+func (a *App) UpdateComment(commentID, userID int, text string) (*models.Comment, error) {
+	err := validateComment(text)
+	if err != nil {
+		return nil, err
+	}
+	comment, ok := a.commentModel.Get(commentID)
+	if !ok || comment.Deleted {
+		return nil, ErrCommentNotFound
+	}
+	if comment.UserID != userID {
+		return nil, ErrCommentModifyNotAllowed
+	}
+	comment.Text = text
+	editedAt := a.clock.Now()
+	comment.EditedAt = &editedAt
+	err = a.resolveMentions(comment)
+	if err != nil {
+		return nil, err
+	}
+	err = a.commentModel.Update(comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// DeleteComment marks comment as deleted by its author, project owner or moderator.
+// Replies of deleted comment are kept.
+func (a *App) DeleteComment(commentID, userID int) error {
+	comment, ok := a.commentModel.Get(commentID)
+	if !ok || comment.Deleted {
+		return ErrCommentNotFound
+	}
+	if comment.UserID != userID {
+		project, ok := a.projectModel.Get(comment.ProjectID)
+		if !ok || !a.can(project, userID, permModerate) {
+			return ErrCommentModifyNotAllowed
+		}
+	}
+	comment.Deleted = true
+
+	return a.commentModel.Update(comment)
+}
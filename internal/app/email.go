@@ -0,0 +1,183 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mail"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/jonboulle/clockwork"
+	"github.com/labstack/gommon/log"
+)
+
+// emailEvents event types which could be delivered by email.
+var emailEvents = []EventType{
+	EventProjectLocked,
+	EventPaymentDue,
+	EventPaymentSummary,
+	EventHarvestOverdue,
+	EventPaymentConfirmed,
+	EventEventTomorrow,
+	EventProjectFailed,
+	EventProjectCancelled,
+	EventProjectApproved,
+	EventProjectRejected,
+	EventFollowedActivity,
+}
+
+func isEmailEvent(eventType EventType) bool {
+	for _, e := range emailEvents {
+		if e == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateEmailSettings checks locale and event types of email settings.
+func validateEmailSettings(locale string, events []string) error {
+	verr := &ValidationError{}
+	if _, ok := emailLocales[locale]; !ok {
+		verr.add("locale", CodeNotAllowed, fmt.Sprintf("locale %q is not supported", locale))
+	}
+	for _, e := range events {
+		if !isEmailEvent(EventType(e)) {
+			verr.add("events", CodeNotAllowed, fmt.Sprintf("event %q can't be sent by email", e))
+		}
+	}
+
+	return verr.errOrNil()
+}
+
+// EmailNotifier sends events by email to users who opted in.
+type EmailNotifier struct {
+	userModel          models.UserImpl
+	emailSettingsModel models.EmailSettingsImpl
+	pendingEmailModel  models.PendingEmailImpl
+	mailer             mail.Mailer
+	clock              clockwork.Clock
+}
+
+// NewEmailNotifier returns new email notifier.
+func NewEmailNotifier(
+	mu models.UserImpl,
+	mes models.EmailSettingsImpl,
+	mpe models.PendingEmailImpl,
+	mailer mail.Mailer,
+	clock clockwork.Clock,
+) *EmailNotifier {
+	return &EmailNotifier{
+		userModel:          mu,
+		emailSettingsModel: mes,
+		pendingEmailModel:  mpe,
+		mailer:             mailer,
+		clock:              clock,
+	}
+}
+
+// Notify sends event by email in background, so smtp server doesn't slow down requests.
+func (n *EmailNotifier) Notify(e Event) {
+	if !isEmailEvent(e.Type) {
+		return
+	}
+	go n.deliver(e)
+}
+
+// deliver sends email to every addressee or puts event to digest.
+func (n *EmailNotifier) deliver(e Event) {
+	users, err := n.userModel.GetByIDs(e.UserIDs)
+	if err != nil {
+		log.Errorf("unable to get addressees of %s event: %s", e.Type, err)
+		return
+	}
+	for _, user := range users {
+		if user.Email == "" {
+			continue
+		}
+		settings, ok := n.emailSettingsModel.Get(user.ID)
+		if !ok || !settings.Enabled(string(e.Type)) {
+			continue
+		}
+		if settings.Digest {
+			err = n.pendingEmailModel.Create(&models.PendingEmail{
+				UserID:    user.ID,
+				Type:      string(e.Type),
+				ProjectID: e.ProjectID,
+				Data:      e.Data,
+				CreatedAt: n.clock.Now(),
+			})
+			if err != nil {
+				log.Errorf("unable to save %s email for user %d: %s", e.Type, user.ID, err)
+			}
+			continue
+		}
+		subject, body, err := renderEvent(settings.Locale, e.Type, e.Data)
+		if err != nil {
+			log.Errorf("unable to render %s email: %s", e.Type, err)
+			continue
+		}
+		n.send(user.Email, settings.Locale, subject, []string{body})
+	}
+}
+
+func (n *EmailNotifier) send(to, locale, subject string, lines []string) bool {
+	msg, err := newEmail(to, locale, subject, lines)
+	if err != nil {
+		log.Errorf("unable to build email: %s", err)
+		return false
+	}
+	err = n.mailer.Send(msg)
+	if err != nil {
+		log.Errorf("unable to send email to %s: %s", to, err)
+		return false
+	}
+
+	return true
+}
+
+// SendDigests sends one email with all pending events to every user in digest mode.
+func (n *EmailNotifier) SendDigests() {
+	pending, err := n.pendingEmailModel.GetAll()
+	if err != nil {
+		log.Errorf("unable to get pending emails: %s", err)
+		return
+	}
+	for len(pending) > 0 {
+		end := 1
+		for end < len(pending) && pending[end].UserID == pending[0].UserID {
+			end++
+		}
+		n.sendDigest(pending[:end])
+		pending = pending[end:]
+	}
+}
+
+// sendDigest sends digest of pending emails of single user.
+func (n *EmailNotifier) sendDigest(pending []models.PendingEmail) {
+	userID := pending[0].UserID
+	ids := make([]int, 0, len(pending))
+	for _, p := range pending {
+		ids = append(ids, p.ID)
+	}
+	user, ok := n.userModel.Get(userID)
+	settings, enabled := n.emailSettingsModel.Get(userID)
+	if ok && enabled && user.Email != "" {
+		lines := []string{getEmailLocale(settings.Locale).digestIntro}
+		for _, p := range pending {
+			_, body, err := renderEvent(settings.Locale, EventType(p.Type), p.Data)
+			if err != nil {
+				log.Errorf("unable to render %s email: %s", p.Type, err)
+				continue
+			}
+			lines = append(lines, body)
+		}
+		subject := getEmailLocale(settings.Locale).digestSubject
+		if !n.send(user.Email, settings.Locale, subject, lines) {
+			return
+		}
+	}
+	err := n.pendingEmailModel.Delete(ids)
+	if err != nil {
+		log.Errorf("unable to delete pending emails of user %d: %s", userID, err)
+	}
+}
@@ -0,0 +1,266 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	htmltemplate "html/template"
+	"strings"
+	"text/template"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mail"
+	"github.com/FreakyGranny/launchpad-api/internal/money"
+)
+
+var (
+	// ErrEmailTemplateNotFound there is no email template for event.
+	ErrEmailTemplateNotFound = errors.New("email template not found")
+)
+
+const defaultEmailLocale = "ru"
+
+// emailTemplate subject and body of email about single event.
+type emailTemplate struct {
+	subject string
+	body    string
+}
+
+// emailLocale localized texts of emails.
+type emailLocale struct {
+	footer        string
+	digestSubject string
+	digestIntro   string
+	events        map[EventType]emailTemplate
+}
+
+var emailLocales = map[string]emailLocale{
+	"en": {
+		footer:        "You receive this email because you enabled notifications in Launchpad settings.",
+		digestSubject: "Launchpad: daily digest",
+		digestIntro:   "Here is what happened with your projects:",
+		events: map[EventType]emailTemplate{
+			EventProjectLocked: {
+				subject: `Project "{{.Title}}" is assembled`,
+				body:    `Project "{{.Title}}" reached its goal, participants are fixed.`,
+			},
+			EventPaymentDue: {
+				subject: `{{if .Final}}Last reminder: {{else if .Reminder}}Reminder: {{end}}Payment for "{{.Title}}"`,
+				body: `{{if .Reminder}}Payment for project "{{.Title}}" is still pending, please pay {{.Amount}}.` +
+					`{{else}}Harvest of project "{{.Title}}" started, please pay {{.Amount}}.{{end}}` +
+					`{{if .Deadline}} Harvest ends at {{.Deadline}}.{{end}}`,
+			},
+			EventPaymentSummary: {
+				subject: `Payments for "{{.Title}}"`,
+				body:    `{{.Unpaid}} participants of project "{{.Title}}" have not paid yet, {{.Amount}} is outstanding. They were reminded.`,
+			},
+			EventHarvestOverdue: {
+				subject: `Harvest of "{{.Title}}" is overdue`,
+				body:    `Project "{{.Title}}" is on harvest stage longer than allowed, {{.Unpaid}} participants have not paid {{.Amount}}.`,
+			},
+			EventPaymentConfirmed: {
+				subject: `Payment for "{{.Title}}" is confirmed`,
+				body:    `Owner of project "{{.Title}}" confirmed your payment of {{.Amount}}.`,
+			},
+			EventEventTomorrow: {
+				subject: `"{{.Title}}" is tomorrow`,
+				body:    `Event "{{.Title}}" takes place at {{.EventDate}}.`,
+			},
+			EventProjectFailed: {
+				subject: `Project "{{.Title}}" failed`,
+				body:    `Project "{{.Title}}" is closed without reaching its goal.`,
+			},
+			EventProjectCancelled: {
+				subject: `Project "{{.Title}}" is cancelled`,
+				body:    `Owner cancelled project "{{.Title}}"{{if .Reason}}: {{.Reason}}{{end}}. Unpaid pledges are released.`,
+			},
+			EventProjectApproved: {
+				subject: `Project "{{.Title}}" is approved`,
+				body:    `Moderator approved project "{{.Title}}"{{if .Comment}}: {{.Comment}}{{end}}.`,
+			},
+			EventProjectRejected: {
+				subject: `Project "{{.Title}}" needs changes`,
+				body:    `Moderator rejected project "{{.Title}}": {{.Comment}}`,
+			},
+			EventFollowedActivity: {
+				subject: `{{if eq .Activity "project_published"}}New project "{{.ProjectTitle}}"{{else}}News of "{{.ProjectTitle}}"{{end}}`,
+				body: `{{if eq .Activity "project_published"}}Project "{{.ProjectTitle}}" you may like is published.` +
+					`{{else if eq .Activity "update_posted"}}Project "{{.ProjectTitle}}" posted update "{{.Title}}".` +
+					`{{else if eq .Activity "project_locked"}}Project "{{.ProjectTitle}}" reached its goal.` +
+					`{{else if eq .Activity "project_succeeded"}}Project "{{.ProjectTitle}}" is successfully finished.` +
+					`{{else if eq .Activity "project_cancelled"}}Project "{{.ProjectTitle}}" is cancelled by owner.` +
+					`{{else}}Project "{{.ProjectTitle}}" is closed without reaching its goal.{{end}}`,
+			},
+		},
+	},
+	"ru": {
+		footer:        "Вы получили это письмо, потому что включили уведомления в настройках Launchpad.",
+		digestSubject: "Launchpad: сводка за день",
+		digestIntro:   "Что произошло с вашими проектами:",
+		events: map[EventType]emailTemplate{
+			EventProjectLocked: {
+				subject: `Проект «{{.Title}}» собран`,
+				body:    `Проект «{{.Title}}» достиг цели, состав участников зафиксирован.`,
+			},
+			EventPaymentDue: {
+				subject: `{{if .Final}}Последнее напоминание: {{else if .Reminder}}Напоминание: {{end}}Оплата проекта «{{.Title}}»`,
+				body: `{{if .Reminder}}Оплата проекта «{{.Title}}» всё ещё ожидается, пожалуйста, оплатите {{.Amount}}.` +
+					`{{else}}Начался сбор средств по проекту «{{.Title}}», пожалуйста, оплатите {{.Amount}}.{{end}}` +
+					`{{if .Deadline}} Сбор завершится {{.Deadline}}.{{end}}`,
+			},
+			EventPaymentSummary: {
+				subject: `Оплаты по проекту «{{.Title}}»`,
+				body:    `Участников проекта «{{.Title}}», ещё не оплативших участие: {{.Unpaid}}, ожидается {{.Amount}}. Им отправлены напоминания.`,
+			},
+			EventHarvestOverdue: {
+				subject: `Сбор средств по проекту «{{.Title}}» затянулся`,
+				body:    `Проект «{{.Title}}» находится на стадии сбора средств дольше допустимого, участников без оплаты: {{.Unpaid}}, ожидается {{.Amount}}.`,
+			},
+			EventPaymentConfirmed: {
+				subject: `Оплата проекта «{{.Title}}» подтверждена`,
+				body:    `Автор проекта «{{.Title}}» подтвердил вашу оплату {{.Amount}}.`,
+			},
+			EventEventTomorrow: {
+				subject: `«{{.Title}}» уже завтра`,
+				body:    `Событие «{{.Title}}» состоится {{.EventDate}}.`,
+			},
+			EventProjectFailed: {
+				subject: `Проект «{{.Title}}» не состоялся`,
+				body:    `Проект «{{.Title}}» закрыт, не достигнув цели.`,
+			},
+			EventProjectCancelled: {
+				subject: `Проект «{{.Title}}» отменён`,
+				body:    `Автор отменил проект «{{.Title}}»{{if .Reason}}: {{.Reason}}{{end}}. Неоплаченные взносы аннулированы.`,
+			},
+			EventProjectApproved: {
+				subject: `Проект «{{.Title}}» одобрен`,
+				body:    `Модератор одобрил проект «{{.Title}}»{{if .Comment}}: {{.Comment}}{{end}}.`,
+			},
+			EventProjectRejected: {
+				subject: `Проект «{{.Title}}» требует доработки`,
+				body:    `Модератор отклонил проект «{{.Title}}»: {{.Comment}}`,
+			},
+			EventFollowedActivity: {
+				subject: `{{if eq .Activity "project_published"}}Новый проект «{{.ProjectTitle}}»{{else}}Новости проекта «{{.ProjectTitle}}»{{end}}`,
+				body: `{{if eq .Activity "project_published"}}Опубликован проект «{{.ProjectTitle}}», который может вас заинтересовать.` +
+					`{{else if eq .Activity "update_posted"}}В проекте «{{.ProjectTitle}}» опубликована новость «{{.Title}}».` +
+					`{{else if eq .Activity "project_locked"}}Проект «{{.ProjectTitle}}» достиг цели.` +
+					`{{else if eq .Activity "project_succeeded"}}Проект «{{.ProjectTitle}}» успешно завершён.` +
+					`{{else if eq .Activity "project_cancelled"}}Проект «{{.ProjectTitle}}» отменён автором.` +
+					`{{else}}Проект «{{.ProjectTitle}}» закрыт, не достигнув цели.{{end}}`,
+			},
+		},
+	},
+}
+
+var emailLayout = htmltemplate.Must(htmltemplate.New("layout").Parse(
+	`<!DOCTYPE html><html><body>{{range .Lines}}<p>{{.}}</p>{{end}}<hr><p><small>{{.Footer}}</small></p></body></html>`,
+))
+
+// emailView values available in email templates.
+type emailView struct {
+	Title        string
+	Amount       string
+	EventDate    string
+	Deadline     string
+	Reminder     int64
+	Final        bool
+	Unpaid       int64
+	Activity     string
+	ProjectTitle string
+	Reason       string
+	Comment      string
+}
+
+// newEmailView fills template values from event data.
+// Data may come from database, so numbers are accepted in any representation.
+func newEmailView(data map[string]interface{}) emailView {
+	view := emailView{}
+	view.Title, _ = data["title"].(string)
+	view.EventDate, _ = data["event_date"].(string)
+	view.Deadline, _ = data["deadline"].(string)
+	view.Reminder, _ = toInt64(data["reminder"])
+	view.Final, _ = data["final"].(bool)
+	view.Unpaid, _ = toInt64(data["unpaid"])
+	view.Activity, _ = data["activity"].(string)
+	view.ProjectTitle, _ = data["project_title"].(string)
+	view.Reason, _ = data["reason"].(string)
+	view.Comment, _ = data["comment"].(string)
+	currency, _ := data["currency"].(string)
+	if amount, ok := toInt64(data["amount"]); ok && currency != "" {
+		view.Amount = money.New(amount, currency).String()
+	}
+
+	return view
+}
+
+func toInt64(v interface{}) (int64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return n, true
+	case int:
+		return int64(n), true
+	case float64:
+		return int64(n), true
+	default:
+		return 0, false
+	}
+}
+
+// getEmailLocale returns texts for locale, falls back to default one.
+func getEmailLocale(locale string) emailLocale {
+	l, ok := emailLocales[locale]
+	if !ok {
+		return emailLocales[defaultEmailLocale]
+	}
+
+	return l
+}
+
+func execute(text string, view emailView) (string, error) {
+	t, err := template.New("email").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	buf := &bytes.Buffer{}
+	err = t.Execute(buf, view)
+
+	return buf.String(), err
+}
+
+// renderEvent returns localized subject and body of email about event.
+func renderEvent(locale string, eventType EventType, data map[string]interface{}) (string, string, error) {
+	tmpl, ok := getEmailLocale(locale).events[eventType]
+	if !ok {
+		return "", "", ErrEmailTemplateNotFound
+	}
+	view := newEmailView(data)
+	subject, err := execute(tmpl.subject, view)
+	if err != nil {
+		return "", "", err
+	}
+	body, err := execute(tmpl.body, view)
+	if err != nil {
+		return "", "", err
+	}
+
+	return subject, body, nil
+}
+
+// newEmail builds text and html email from lines of body.
+func newEmail(to, locale, subject string, lines []string) (*mail.Message, error) {
+	l := getEmailLocale(locale)
+	html := &bytes.Buffer{}
+	err := emailLayout.Execute(html, struct {
+		Lines  []string
+		Footer string
+	}{lines, l.footer})
+	if err != nil {
+		return nil, err
+	}
+
+	return &mail.Message{
+		To:      to,
+		Subject: subject,
+		Text:    strings.Join(lines, "\n\n") + "\n\n--\n" + l.footer,
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,190 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mail"
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type fakeMailer struct {
+	sent []*mail.Message
+}
+
+func (m *fakeMailer) Send(msg *mail.Message) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+type EmailSuite struct {
+	suite.Suite
+	mockUserCtl          *gomock.Controller
+	mockUser             *mocks.MockUserImpl
+	mockEmailSettingsCtl *gomock.Controller
+	mockEmailSettings    *mocks.MockEmailSettingsImpl
+	mockPendingEmailCtl  *gomock.Controller
+	mockPendingEmail     *mocks.MockPendingEmailImpl
+	mailer               *fakeMailer
+	clock                clockwork.FakeClock
+	notifier             *EmailNotifier
+}
+
+func (s *EmailSuite) SetupTest() {
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockEmailSettingsCtl = gomock.NewController(s.T())
+	s.mockEmailSettings = mocks.NewMockEmailSettingsImpl(s.mockEmailSettingsCtl)
+	s.mockPendingEmailCtl = gomock.NewController(s.T())
+	s.mockPendingEmail = mocks.NewMockPendingEmailImpl(s.mockPendingEmailCtl)
+	s.mailer = &fakeMailer{}
+	s.clock = clockwork.NewFakeClock()
+	s.notifier = NewEmailNotifier(s.mockUser, s.mockEmailSettings, s.mockPendingEmail, s.mailer, s.clock)
+}
+
+func (s *EmailSuite) TearDownTest() {
+	s.mockUserCtl.Finish()
+	s.mockEmailSettingsCtl.Finish()
+	s.mockPendingEmailCtl.Finish()
+}
+
+func (s *EmailSuite) event() Event {
+	return Event{
+		Type:      EventPaymentDue,
+		ProjectID: 10,
+		UserIDs:   []int{5, 6, 7},
+		Data:      map[string]interface{}{"title": "Pizza", "amount": int64(1050), "currency": "RUB"},
+	}
+}
+
+func (s *EmailSuite) TestDeliver() {
+	s.mockUser.EXPECT().GetByIDs([]int{5, 6, 7}).Return([]models.User{
+		{ID: 5, Email: "five@example.com"},
+		{ID: 6, Email: "six@example.com"},
+		{ID: 7},
+	}, nil)
+	s.mockEmailSettings.EXPECT().Get(5).Return(&models.EmailSettings{
+		UserID: 5,
+		Locale: "en",
+		Events: []string{"payment_due"},
+	}, true)
+	s.mockEmailSettings.EXPECT().Get(6).Return(&models.EmailSettings{
+		UserID: 6,
+		Locale: "en",
+		Events: []string{"project_failed"},
+	}, true)
+
+	s.notifier.deliver(s.event())
+	s.Require().Len(s.mailer.sent, 1)
+	msg := s.mailer.sent[0]
+	s.Require().Equal("five@example.com", msg.To)
+	s.Require().Equal(`Payment for "Pizza"`, msg.Subject)
+	s.Require().Contains(msg.Text, `Harvest of project "Pizza" started, please pay 10.50 RUB.`)
+	s.Require().Contains(msg.HTML, `<p>Harvest of project &#34;Pizza&#34; started, please pay 10.50 RUB.</p>`)
+}
+
+func (s *EmailSuite) TestDeliverDigest() {
+	s.mockUser.EXPECT().GetByIDs([]int{5, 6, 7}).Return([]models.User{{ID: 5, Email: "five@example.com"}}, nil)
+	s.mockEmailSettings.EXPECT().Get(5).Return(&models.EmailSettings{
+		UserID: 5,
+		Locale: "ru",
+		Digest: true,
+		Events: []string{"payment_due"},
+	}, true)
+	s.mockPendingEmail.EXPECT().Create(&models.PendingEmail{
+		UserID:    5,
+		Type:      "payment_due",
+		ProjectID: 10,
+		Data:      s.event().Data,
+		CreatedAt: s.clock.Now(),
+	}).Return(nil)
+
+	s.notifier.deliver(s.event())
+	s.Require().Empty(s.mailer.sent)
+}
+
+func (s *EmailSuite) TestSendDigests() {
+	s.mockPendingEmail.EXPECT().GetAll().Return([]models.PendingEmail{
+		{ID: 1, UserID: 5, Type: "project_locked", Data: map[string]interface{}{"title": "Pizza"}},
+		{ID: 2, UserID: 5, Type: "payment_due", Data: map[string]interface{}{"title": "Pizza", "amount": float64(500), "currency": "RUB"}},
+		{ID: 3, UserID: 6, Type: "project_failed", Data: map[string]interface{}{"title": "Sushi"}},
+	}, nil)
+	s.mockUser.EXPECT().Get(5).Return(&models.User{ID: 5, Email: "five@example.com"}, true)
+	s.mockEmailSettings.EXPECT().Get(5).Return(&models.EmailSettings{UserID: 5, Locale: "ru", Digest: true}, true)
+	s.mockPendingEmail.EXPECT().Delete([]int{1, 2}).Return(nil)
+	s.mockUser.EXPECT().Get(6).Return(&models.User{ID: 6}, true)
+	s.mockEmailSettings.EXPECT().Get(6).Return(&models.EmailSettings{UserID: 6, Locale: "ru", Digest: true}, true)
+	s.mockPendingEmail.EXPECT().Delete([]int{3}).Return(nil)
+
+	s.notifier.SendDigests()
+	s.Require().Len(s.mailer.sent, 1)
+	msg := s.mailer.sent[0]
+	s.Require().Equal("five@example.com", msg.To)
+	s.Require().Equal("Launchpad: сводка за день", msg.Subject)
+	s.Require().Contains(msg.Text, "Проект «Pizza» достиг цели, состав участников зафиксирован.")
+	s.Require().Contains(msg.Text, "пожалуйста, оплатите 5.00 RUB.")
+}
+
+func (s *EmailSuite) TestRenderEventUnknownLocale() {
+	subject, _, err := renderEvent("de", EventProjectFailed, map[string]interface{}{"title": "Pizza"})
+	s.Require().NoError(err)
+	s.Require().Equal("Проект «Pizza» не состоялся", subject)
+}
+
+func (s *EmailSuite) TestRenderPaymentReminder() {
+	data := map[string]interface{}{
+		"title":    "Pizza",
+		"amount":   int64(1050),
+		"currency": "RUB",
+		"reminder": 2,
+		"final":    true,
+		"deadline": "2020-10-08 12:00:00",
+	}
+	subject, body, err := renderEvent("en", EventPaymentDue, data)
+	s.Require().NoError(err)
+	s.Require().Equal(`Last reminder: Payment for "Pizza"`, subject)
+	s.Require().Equal(`Payment for project "Pizza" is still pending, please pay 10.50 RUB. Harvest ends at 2020-10-08 12:00:00.`, body)
+}
+
+func (s *EmailSuite) TestRenderEventNotEmail() {
+	_, _, err := renderEvent("en", EventShareChanged, nil)
+	s.Require().Equal(ErrEmailTemplateNotFound, err)
+}
+
+func (s *EmailSuite) TestUpdateEmailSettings() {
+	a := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockEmailSettings, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+	expect := &models.EmailSettings{UserID: 5, Locale: "ru", Events: []string{"event_tomorrow"}}
+	s.mockEmailSettings.EXPECT().Save(expect).Return(nil)
+
+	settings, err := a.UpdateEmailSettings(5, "", false, []string{"event_tomorrow"})
+	s.Require().NoError(err)
+	s.Require().Equal(expect, settings)
+}
+
+func (s *EmailSuite) TestUpdateEmailSettingsInvalid() {
+	a := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockEmailSettings, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+
+	settings, err := a.UpdateEmailSettings(5, "de", false, []string{"share_changed"})
+	s.Require().Nil(settings)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Len(vErr.Fields, 2)
+}
+
+func (s *EmailSuite) TestIsTomorrow() {
+	now := time.Date(2020, 8, 20, 23, 0, 0, 0, time.UTC)
+	s.Require().False(isTomorrow(time.Time{}, now))
+	s.Require().False(isTomorrow(time.Date(2020, 8, 20, 23, 30, 0, 0, time.UTC), now))
+	s.Require().True(isTomorrow(time.Date(2020, 8, 21, 0, 0, 0, 0, time.UTC), now))
+	s.Require().True(isTomorrow(time.Date(2020, 8, 21, 23, 59, 0, 0, time.UTC), now))
+	s.Require().False(isTomorrow(time.Date(2020, 8, 22, 0, 0, 0, 0, time.UTC), now))
+}
+
+func TestEmailSuite(t *testing.T) {
+	suite.Run(t, new(EmailSuite))
+}
@@ -1,6 +1,10 @@
 package app
 
-import "github.com/FreakyGranny/launchpad-api/internal/models"
+import (
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
 
 // ExtendedUser user extended with participation.
 type ExtendedUser struct {
@@ -10,29 +14,51 @@ type ExtendedUser struct {
 
 // ExtendedProject light project entry
 type ExtendedProject struct {
-	ID           int                `json:"id"`
-	Title        string             `json:"title"`
-	SubTitle     string             `json:"subtitle"`
-	Status       string             `json:"status"`
-	ReleaseDate  string             `json:"release_date"`
-	EventDate    *string            `json:"event_date"`
-	ImageLink    string             `json:"image_link"`
-	Total        int                `json:"total"`
-	Percent      int                `json:"percent"`
-	Category     models.Category    `json:"category"`
-	ProjectType  models.ProjectType `json:"project_type"`
-	GoalPeople   int                `json:"goal_people"`
-	GoalAmount   int                `json:"goal_amount"`
-	Description  string             `json:"description"`
-	Instructions string             `json:"instructions"`
-	Owner        models.User        `json:"owner"`
+	ID             int                `json:"id"`
+	Title          string             `json:"title"`
+	SubTitle       string             `json:"subtitle"`
+	Status         string             `json:"status"`
+	ReleaseDate    string             `json:"release_date"`
+	EventDate      *string            `json:"event_date"`
+	ImageLink      string             `json:"image_link"`
+	Total          int64              `json:"total"`
+	Currency       string             `json:"currency"`
+	Percent        int                `json:"percent"`
+	Category       models.Category    `json:"category"`
+	ProjectType    models.ProjectType `json:"project_type"`
+	GoalPeople     int                `json:"goal_people"`
+	GoalAmount     int64              `json:"goal_amount"`
+	Description    string             `json:"description"`
+	Instructions   string             `json:"instructions"`
+	Owner          models.User        `json:"owner"`
+	Pledge         models.PledgeRules `json:"pledge"`
+	PrivateAmounts bool               `json:"private_amounts"`
+	CancelReason   string             `json:"cancel_reason,omitempty"`
+	PublishAt      *string            `json:"publish_at,omitempty"`
+	ReviewStatus   string             `json:"review_status,omitempty"`
+	ReviewComment  string             `json:"review_comment,omitempty"`
+	Tiers          []models.Tier      `json:"tiers,omitempty"`
+	Tags           []models.Tag       `json:"tags,omitempty"`
+	Snippet        string             `json:"snippet,omitempty"`
 }
 
-
-// ShortDonation project donation without payment
+// ShortDonation project donation visible to other participants,
+// payment is set only for donor, those who manage payments and projects with public amounts
 type ShortDonation struct {
-	ID     int         `json:"id"`
-	User   models.User `json:"user"`
-	Locked bool        `json:"locked"`
-	Paid   bool        `json:"paid"`
+	ID        int         `json:"id"`
+	User      models.User `json:"user"`
+	Anonymous bool        `json:"anonymous"`
+	Payment   *int64      `json:"payment,omitempty"`
+	Locked    bool        `json:"locked"`
+	Paid      bool        `json:"paid"`
+}
+
+// GuestbookEntry donation message of project guestbook
+type GuestbookEntry struct {
+	DonationID int         `json:"donation"`
+	User       models.User `json:"user"`
+	Anonymous  bool        `json:"anonymous"`
+	Message    string      `json:"message"`
+	Hidden     bool        `json:"hidden"`
+	CreatedAt  time.Time   `json:"created_at"`
 }
@@ -23,6 +23,8 @@ var (
 	ErrProjectNotFound = errors.New("project not found")
 	// ErrProjectModifyNotAllowed project modifying not allowed.
 	ErrProjectModifyNotAllowed = errors.New("modifying forbidden")
+	// ErrProjectWrongCurrency project currency is not supported.
+	ErrProjectWrongCurrency = errors.New("unsupported currency")
 )
 
 var (
@@ -32,7 +34,17 @@ var (
 	ErrDonationModifyNotAllowed = errors.New("modifying forbidden")
 	// ErrDonationModifyWrong modifying params are wrong.
 	ErrDonationModifyWrong = errors.New("wrong modifying params")
+	// ErrMessageRateLimit user posts messages too often.
+	ErrMessageRateLimit = errors.New("too many messages")
+)
 
+var (
+	// ErrTierNotFound tier with given id not found.
+	ErrTierNotFound = errors.New("tier not found")
+	// ErrTierModifyNotAllowed tier modifying not allowed.
+	ErrTierModifyNotAllowed = errors.New("modifying forbidden")
+	// ErrTierWrong tier params are wrong.
+	ErrTierWrong = errors.New("wrong tier params")
 )
 
 var (
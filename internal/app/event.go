@@ -0,0 +1,77 @@
+package app
+
+// EventType kind of project event.
+type EventType string
+
+const (
+	// EventProjectPublished owner published project.
+	EventProjectPublished EventType = "project_published"
+	// EventProjectLocked project reached its goal and participants are fixed.
+	EventProjectLocked EventType = "project_locked"
+	// EventProjectSucceeded project is successfully finished.
+	EventProjectSucceeded EventType = "project_succeeded"
+	// EventEventTomorrow event of project takes place tomorrow.
+	EventEventTomorrow EventType = "event_tomorrow"
+	// EventProjectFailed project is closed without reaching its goal.
+	EventProjectFailed EventType = "project_failed"
+	// EventPaymentDue participant has to pay for locked project.
+	EventPaymentDue EventType = "payment_due"
+	// EventPaymentSummary owner got summary of unpaid participants after reminders.
+	EventPaymentSummary EventType = "payment_summary"
+	// EventHarvestOverdue project stays on harvest stage longer than grace period.
+	EventHarvestOverdue EventType = "harvest_overdue"
+	// EventPaymentConfirmed owner confirmed payment of participant.
+	EventPaymentConfirmed EventType = "payment_confirmed"
+	// EventShareChanged share of participant changed after lock.
+	EventShareChanged EventType = "share_changed"
+	// EventCreditSettled owner returned credit to participant.
+	EventCreditSettled EventType = "credit_settled"
+	// EventParticipantJoined new participant joined project.
+	EventParticipantJoined EventType = "participant_joined"
+	// EventParticipantLeft participant left project.
+	EventParticipantLeft EventType = "participant_left"
+	// EventUpdatePosted owner posted project update.
+	EventUpdatePosted EventType = "update_posted"
+	// EventProjectProgress total, percent or status of project changed.
+	EventProjectProgress EventType = "project_progress"
+	// EventFollowedActivity something happened with followed project, category or user.
+	EventFollowedActivity EventType = "followed_activity"
+	// EventCollaboratorInvited user invited to manage project.
+	EventCollaboratorInvited EventType = "collaborator_invited"
+	// EventOwnershipTransferred user became owner of project.
+	EventOwnershipTransferred EventType = "ownership_transferred"
+	// EventProjectCancelled project is cancelled by owner.
+	EventProjectCancelled EventType = "project_cancelled"
+	// EventProjectExtended release date of project is extended.
+	EventProjectExtended EventType = "project_extended"
+	// EventProjectReopened failed project is reopened by admin.
+	EventProjectReopened EventType = "project_reopened"
+	// EventReviewRequested draft is waiting for moderator approval.
+	EventReviewRequested EventType = "review_requested"
+	// EventProjectApproved draft is approved by moderator.
+	EventProjectApproved EventType = "project_approved"
+	// EventProjectRejected draft is rejected by moderator.
+	EventProjectRejected EventType = "project_rejected"
+)
+
+// Event something happened with project, addressed to users.
+type Event struct {
+	Type      EventType
+	ProjectID int
+	UserIDs   []int
+	Data      map[string]interface{}
+}
+
+// publicEventData returns copy of event data safe to show outside of project,
+// personal data of anonymous donors is removed.
+func publicEventData(e Event) map[string]interface{} {
+	data := make(map[string]interface{}, len(e.Data)+1)
+	for k, v := range e.Data {
+		data[k] = v
+	}
+	if anonymous, _ := data["anonymous"].(bool); anonymous {
+		delete(data, "user")
+	}
+
+	return data
+}
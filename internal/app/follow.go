@@ -0,0 +1,187 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/jonboulle/clockwork"
+	"github.com/labstack/gommon/log"
+)
+
+var (
+	// ErrFollowTargetNotFound followed project, category or user not found.
+	ErrFollowTargetNotFound = errors.New("follow target not found")
+)
+
+// feedEvents events shown in feeds of followers.
+var feedEvents = map[EventType]bool{
+	EventProjectPublished: true,
+	EventUpdatePosted:     true,
+	EventProjectLocked:    true,
+	EventProjectSucceeded: true,
+	EventProjectFailed:    true,
+	EventProjectCancelled: true,
+}
+
+// FollowNotifier records public project events to feeds and passes them to followers.
+type FollowNotifier struct {
+	next          Notifier
+	projectModel  models.ProjectImpl
+	followModel   models.FollowImpl
+	activityModel models.ActivityImpl
+	clock         clockwork.Clock
+}
+
+// NewFollowNotifier returns notifier which passes events to next notifier
+// and notifies followers about them with followed_activity event.
+func NewFollowNotifier(
+	next Notifier,
+	mp models.ProjectImpl,
+	mf models.FollowImpl,
+	ma models.ActivityImpl,
+	clock clockwork.Clock,
+) *FollowNotifier {
+	return &FollowNotifier{
+		next:          next,
+		projectModel:  mp,
+		followModel:   mf,
+		activityModel: ma,
+		clock:         clock,
+	}
+}
+
+// Notify passes event to next notifier and to followers in background if event is public.
+func (n *FollowNotifier) Notify(e Event) {
+	n.next.Notify(e)
+	if !feedEvents[e.Type] {
+		return
+	}
+	if participantsOnly, _ := e.Data["participants_only"].(bool); participantsOnly {
+		return
+	}
+	go n.notifyFollowers(e)
+}
+
+// notifyFollowers records event to feed and notifies followers about it.
+func (n *FollowNotifier) notifyFollowers(e Event) {
+	project, ok := n.projectModel.Get(e.ProjectID)
+	if !ok {
+		log.Errorf("unable to get project %d for %s activity", e.ProjectID, e.Type)
+		return
+	}
+	activity := &models.Activity{
+		Type:       string(e.Type),
+		ProjectID:  project.ID,
+		CategoryID: project.CategoryID,
+		OwnerID:    project.OwnerID,
+		Data:       publicEventData(e),
+		CreatedAt:  n.clock.Now(),
+	}
+	err := n.activityModel.Create(activity)
+	if err != nil {
+		log.Errorf("unable to save %s activity of project %d: %s", e.Type, e.ProjectID, err)
+		return
+	}
+	followers, err := n.followModel.GetFollowerIDs(activity.ID)
+	if err != nil {
+		log.Errorf("unable to get followers of project %d: %s", e.ProjectID, err)
+		return
+	}
+	// owner and addressees of event already know about it
+	notified := map[int]bool{project.OwnerID: true}
+	for _, id := range e.UserIDs {
+		notified[id] = true
+	}
+	userIDs := make([]int, 0, len(followers))
+	for _, id := range followers {
+		if !notified[id] {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+	data := publicEventData(e)
+	data["activity"] = string(e.Type)
+	data["project_title"] = project.Title
+	n.next.Notify(Event{
+		Type:      EventFollowedActivity,
+		ProjectID: e.ProjectID,
+		UserIDs:   userIDs,
+		Data:      data,
+	})
+}
+
+// newFollow checks followed entity exists and returns follow of user.
+func (a *App) newFollow(userID int, targetType string, targetID int) (*models.Follow, error) {
+	verr := &ValidationError{}
+	switch targetType {
+	case models.FollowProject:
+		project, ok := a.projectModel.Get(targetID)
+		if !ok || !project.Published {
+			return nil, ErrFollowTargetNotFound
+		}
+	case models.FollowCategory:
+		if _, ok := a.categoryModel.Get(targetID); !ok {
+			return nil, ErrFollowTargetNotFound
+		}
+	case models.FollowUser:
+		if targetID == userID {
+			verr.add("id", CodeNotAllowed, "users can't follow themselves")
+			return nil, verr
+		}
+		if _, ok := a.userModel.Get(targetID); !ok {
+			return nil, ErrFollowTargetNotFound
+		}
+	default:
+		verr.add("type", CodeNotAllowed, fmt.Sprintf("following %q is not supported", targetType))
+		return nil, verr
+	}
+
+	return &models.Follow{
+		UserID:     userID,
+		TargetType: targetType,
+		TargetID:   targetID,
+		CreatedAt:  a.clock.Now(),
+	}, nil
+}
+
+// GetFollows returns projects, categories and users followed by user.
+func (a *App) GetFollows(userID int) ([]models.Follow, error) {
+	return a.followModel.GetAllByUser(userID)
+}
+
+// Follow subscribes user to project, category or other user.
+func (a *App) Follow(userID int, targetType string, targetID int) (*models.Follow, error) {
+	follow, err := a.newFollow(userID, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	err = a.followModel.Create(follow)
+	if err != nil {
+		return nil, err
+	}
+
+	return follow, nil
+}
+
+// Unfollow removes subscription of user.
+func (a *App) Unfollow(userID int, targetType string, targetID int) error {
+	return a.followModel.Delete(&models.Follow{
+		UserID:     userID,
+		TargetType: targetType,
+		TargetID:   targetID,
+	})
+}
+
+// GetFeed returns page of activities followed by user, newest first.
+func (a *App) GetFeed(userID, cursor, limit int) ([]models.Activity, int, bool, error) {
+	activities, err := a.activityModel.GetFeed(userID, cursor, pageFetchLimit(limit))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	n, next, hasNext := cutPage(len(activities), limit, func(i int) int { return activities[i].ID })
+
+	return activities[:n], next, hasNext, nil
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type FollowSuite struct {
+	suite.Suite
+	mockCategoryCtl *gomock.Controller
+	mockCategory    *mocks.MockCategoryImpl
+	mockUserCtl     *gomock.Controller
+	mockUser        *mocks.MockUserImpl
+	mockProjectCtl  *gomock.Controller
+	mockProject     *mocks.MockProjectImpl
+	mockFollowCtl   *gomock.Controller
+	mockFollow      *mocks.MockFollowImpl
+	mockActivityCtl *gomock.Controller
+	mockActivity    *mocks.MockActivityImpl
+	clock           clockwork.FakeClock
+	next            *fakeNotifier
+	notifier        *FollowNotifier
+	app             *App
+}
+
+func (s *FollowSuite) SetupTest() {
+	s.mockCategoryCtl = gomock.NewController(s.T())
+	s.mockCategory = mocks.NewMockCategoryImpl(s.mockCategoryCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockFollowCtl = gomock.NewController(s.T())
+	s.mockFollow = mocks.NewMockFollowImpl(s.mockFollowCtl)
+	s.mockActivityCtl = gomock.NewController(s.T())
+	s.mockActivity = mocks.NewMockActivityImpl(s.mockActivityCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.next = &fakeNotifier{}
+	s.notifier = NewFollowNotifier(s.next, s.mockProject, s.mockFollow, s.mockActivity, s.clock)
+	s.app = New(s.mockCategory, s.mockUser, s.mockProject, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockFollow, s.mockActivity, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *FollowSuite) TearDownTest() {
+	s.mockCategoryCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockFollowCtl.Finish()
+	s.mockActivityCtl.Finish()
+}
+
+func (s *FollowSuite) TestNotifyFollowers() {
+	project := &models.Project{ID: 10, Title: "Pizza", OwnerID: 1, CategoryID: 3}
+	e := Event{
+		Type:      EventProjectLocked,
+		ProjectID: 10,
+		UserIDs:   []int{1, 5},
+		Data:      map[string]interface{}{"title": "Pizza"},
+	}
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockActivity.EXPECT().Create(&models.Activity{
+		Type:       "project_locked",
+		ProjectID:  10,
+		CategoryID: 3,
+		OwnerID:    1,
+		Data:       map[string]interface{}{"title": "Pizza"},
+		CreatedAt:  s.clock.Now(),
+	}).DoAndReturn(func(a *models.Activity) error {
+		a.ID = 7
+		return nil
+	})
+	s.mockFollow.EXPECT().GetFollowerIDs(7).Return([]int{1, 5, 6}, nil)
+
+	s.notifier.notifyFollowers(e)
+	s.Require().Equal([]Event{
+		{
+			Type:      EventFollowedActivity,
+			ProjectID: 10,
+			UserIDs:   []int{6},
+			Data:      map[string]interface{}{"title": "Pizza", "activity": "project_locked", "project_title": "Pizza"},
+		},
+	}, s.next.events)
+}
+
+func (s *FollowSuite) TestNotifyNoFollowers() {
+	e := Event{Type: EventProjectPublished, ProjectID: 10, Data: map[string]interface{}{"title": "Pizza"}}
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{ID: 10, OwnerID: 1}, true)
+	s.mockActivity.EXPECT().Create(gomock.Any()).Return(nil)
+	s.mockFollow.EXPECT().GetFollowerIDs(0).Return([]int{}, nil)
+
+	s.notifier.notifyFollowers(e)
+	s.Require().Empty(s.next.events)
+}
+
+func (s *FollowSuite) TestNotifyPrivateEvents() {
+	update := Event{Type: EventUpdatePosted, ProjectID: 10, Data: map[string]interface{}{"participants_only": true}}
+	share := Event{Type: EventShareChanged, ProjectID: 10, UserIDs: []int{5}}
+
+	s.notifier.Notify(update)
+	s.notifier.Notify(share)
+	s.Require().Equal([]Event{update, share}, s.next.events)
+}
+
+func (s *FollowSuite) TestFollowCategory() {
+	s.mockCategory.EXPECT().Get(3).Return(&models.Category{ID: 3}, true)
+	follow := &models.Follow{UserID: 5, TargetType: "category", TargetID: 3, CreatedAt: s.clock.Now()}
+	s.mockFollow.EXPECT().Create(follow).Return(nil)
+
+	result, err := s.app.Follow(5, "category", 3)
+	s.Require().NoError(err)
+	s.Require().Equal(follow, result)
+}
+
+func (s *FollowSuite) TestFollowDraftProject() {
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{ID: 10}, true)
+
+	_, err := s.app.Follow(5, "project", 10)
+	s.Require().Equal(ErrFollowTargetNotFound, err)
+}
+
+func (s *FollowSuite) TestFollowWrongTarget() {
+	_, err := s.app.Follow(5, "tag", 1)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{{Field: "type", Code: CodeNotAllowed, Message: `following "tag" is not supported`}}, vErr.Fields)
+
+	_, err = s.app.Follow(5, "user", 5)
+	vErr, ok = err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{{Field: "id", Code: CodeNotAllowed, Message: "users can't follow themselves"}}, vErr.Fields)
+}
+
+func (s *FollowSuite) TestGetFeedPage() {
+	s.mockActivity.EXPECT().GetFeed(5, 0, 3).Return([]models.Activity{{ID: 9}, {ID: 8}, {ID: 7}}, nil)
+
+	activities, next, hasNext, err := s.app.GetFeed(5, 0, 2)
+	s.Require().NoError(err)
+	s.Require().Equal([]models.Activity{{ID: 9}, {ID: 8}}, activities)
+	s.Require().Equal(8, next)
+	s.Require().True(hasNext)
+}
+
+func TestFollowSuite(t *testing.T) {
+	suite.Run(t, new(FollowSuite))
+}
@@ -0,0 +1,271 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrProjectWrongStatus action isn't allowed in current status of project.
+	ErrProjectWrongStatus = errors.New("action isn't allowed in current project status")
+)
+
+const (
+	// maxExtensions how many times release date of published project can be extended.
+	maxExtensions = 1
+	// maxExtension how far release date can be moved by one extension.
+	maxExtension = 4 * week
+	// maxCancelReasonLength limit of cancel reason length in characters.
+	maxCancelReasonLength = 500
+)
+
+// isSearching checks project is published and still gathers participants.
+func isSearching(p *models.Project) bool {
+	return p.Status() == models.StatusSearch
+}
+
+// notifyParticipants sends event about project to participants and owner except actor.
+func (a *App) notifyParticipants(eventType EventType, project *models.Project, ids []int, actorID int, data map[string]interface{}) {
+	recipients := make([]int, 0, len(ids)+1)
+	seen := map[int]bool{actorID: true}
+	for _, id := range append([]int{project.OwnerID}, ids...) {
+		if !seen[id] {
+			seen[id] = true
+			recipients = append(recipients, id)
+		}
+	}
+	data["title"] = project.Title
+	a.notifier.Notify(Event{
+		Type:      eventType,
+		ProjectID: project.ID,
+		UserIDs:   recipients,
+		Data:      data,
+	})
+}
+
+// CancelProject closes published project by owner with reason.
+// Unpaid pledges are released, paid donations stay for refunds.
+func (a *App) CancelProject(userID, projectID int, reason string) (*ExtendedProject, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if project.OwnerID != userID {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	if !project.Published || project.Closed {
+		return nil, ErrProjectWrongStatus
+	}
+	reason = strings.TrimSpace(reason)
+	verr := &ValidationError{}
+	if reason == "" {
+		verr.add("reason", CodeRequired, "reason is required")
+	}
+	if utf8.RuneCountInString(reason) > maxCancelReasonLength {
+		verr.add("reason", CodeMax, fmt.Sprintf("reason must be at most %d characters", maxCancelReasonLength))
+	}
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+	// participants are collected before unpaid donations are released
+	ids, err := a.participants(projectID)
+	if err != nil {
+		return nil, err
+	}
+	now := a.clock.Now()
+	project.CancelledAt = now
+	project.CancelReason = reason
+	err = a.projectModel.Cancel(project, &models.ProjectAudit{
+		ProjectID: projectID,
+		UserID:    userID,
+		Action:    models.AuditCancel,
+		NewValue:  reason,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	a.notifyParticipants(EventProjectCancelled, project, ids, userID, map[string]interface{}{
+		"reason": reason,
+	})
+	a.reCalcCh <- projectID
+
+	return a.extendProject(project)
+}
+
+// ExtendProject moves release date of project on search stage.
+// Release date can be extended limited number of times and not too far.
+func (a *App) ExtendProject(userID, projectID int, releaseDate time.Time) (*ExtendedProject, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if !a.can(project, userID, permEdit) {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	if !isSearching(project) {
+		return nil, ErrProjectWrongStatus
+	}
+	verr := &ValidationError{}
+	if project.Extensions >= maxExtensions {
+		verr.add("release_date", CodeNotAllowed, fmt.Sprintf("release date can be extended at most %d times", maxExtensions))
+	} else if !releaseDate.After(project.ReleaseDate) {
+		verr.add("release_date", CodeMin, "release date must be after current one")
+	} else if releaseDate.Sub(project.ReleaseDate) > maxExtension {
+		verr.add("release_date", CodeMax, fmt.Sprintf("release date can be extended by at most %d days", maxExtension/(24*time.Hour)))
+	}
+	if err := verr.errOrNil(); err != nil {
+		return nil, err
+	}
+	ids, err := a.participants(projectID)
+	if err != nil {
+		return nil, err
+	}
+	audit := &models.ProjectAudit{
+		ProjectID: projectID,
+		UserID:    userID,
+		Action:    models.AuditExtend,
+		Field:     "release_date",
+		OldValue:  project.ReleaseDate.Format(DateLayout),
+		NewValue:  releaseDate.Format(DateLayout),
+		CreatedAt: a.clock.Now(),
+	}
+	project.ReleaseDate = releaseDate
+	project.Extensions++
+	err = a.projectModel.Extend(project, audit)
+	if err != nil {
+		return nil, err
+	}
+	err = a.saveRevision(project, userID)
+	if err != nil {
+		return nil, err
+	}
+	a.notifyParticipants(EventProjectExtended, project, ids, userID, map[string]interface{}{
+		"release_date": audit.NewValue,
+	})
+
+	return a.extendProject(project)
+}
+
+// EditProject changes descriptive fields of published project, nil fields stay unchanged.
+// Every changed field is recorded to audit trail.
+func (a *App) EditProject(userID, projectID int, title, subtitle, descr, imageLink, instructions *string) (*ExtendedProject, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if !a.can(project, userID, permEdit) {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	if !project.Published || project.Status() == models.StatusCancelled {
+		return nil, ErrProjectWrongStatus
+	}
+	if title != nil && strings.TrimSpace(*title) == "" {
+		verr := &ValidationError{}
+		verr.add("title", CodeRequired, "title is required")
+		return nil, verr.errOrNil()
+	}
+	now := a.clock.Now()
+	audit := make([]models.ProjectAudit, 0)
+	fields := []struct {
+		name  string
+		value *string
+		dest  *string
+	}{
+		{"title", title, &project.Title},
+		{"subtitle", subtitle, &project.SubTitle},
+		{"description", descr, &project.Description},
+		{"image_link", imageLink, &project.ImageLink},
+		{"instructions", instructions, &project.Instructions},
+	}
+	for _, f := range fields {
+		if f.value == nil || *f.value == *f.dest {
+			continue
+		}
+		audit = append(audit, models.ProjectAudit{
+			ProjectID: projectID,
+			UserID:    userID,
+			Action:    models.AuditEdit,
+			Field:     f.name,
+			OldValue:  *f.dest,
+			NewValue:  *f.value,
+			CreatedAt: now,
+		})
+		*f.dest = *f.value
+	}
+	if len(audit) > 0 {
+		err := a.projectModel.Edit(project, audit)
+		if err != nil {
+			return nil, err
+		}
+		err = a.saveRevision(project, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.extendProject(project)
+}
+
+// ReopenProject returns failed project to search stage with new release date, only admins can reopen.
+func (a *App) ReopenProject(userID, projectID int, releaseDate time.Time) (*ExtendedProject, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if project.Status() != models.StatusFail {
+		return nil, ErrProjectWrongStatus
+	}
+	now := a.clock.Now()
+	if !releaseDate.After(now) {
+		verr := &ValidationError{}
+		verr.add("release_date", CodeMin, "release date must be in the future")
+		return nil, verr.errOrNil()
+	}
+	ids, err := a.participants(projectID)
+	if err != nil {
+		return nil, err
+	}
+	audit := &models.ProjectAudit{
+		ProjectID: projectID,
+		UserID:    userID,
+		Action:    models.AuditReopen,
+		Field:     "release_date",
+		OldValue:  project.ReleaseDate.Format(DateLayout),
+		NewValue:  releaseDate.Format(DateLayout),
+		CreatedAt: now,
+	}
+	project.ReleaseDate = releaseDate
+	err = a.projectModel.Reopen(project, audit)
+	if err != nil {
+		return nil, err
+	}
+	err = a.saveRevision(project, userID)
+	if err != nil {
+		return nil, err
+	}
+	a.notifyParticipants(EventProjectReopened, project, ids, userID, map[string]interface{}{
+		"release_date": audit.NewValue,
+	})
+
+	return a.extendProject(project)
+}
+
+// GetProjectAudit returns changes of published project visible to viewer, newest first.
+func (a *App) GetProjectAudit(viewerID, projectID int) ([]models.ProjectAudit, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, viewerID) {
+		return nil, ErrProjectNotFound
+	}
+
+	return a.auditModel.GetAllByProject(projectID)
+}
@@ -0,0 +1,297 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type LifecycleSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockRevisionCtl     *gomock.Controller
+	mockRevision        *mocks.MockRevisionImpl
+	mockUserCtl         *gomock.Controller
+	mockUser            *mocks.MockUserImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockDonationCtl     *gomock.Controller
+	mockDonation        *mocks.MockDonationImpl
+	mockAuditCtl        *gomock.Controller
+	mockAudit           *mocks.MockAuditImpl
+	notifier            *fakeNotifier
+	recalcChan          chan int
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *LifecycleSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockRevisionCtl = gomock.NewController(s.T())
+	s.mockRevision = mocks.NewMockRevisionImpl(s.mockRevisionCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockDonationCtl = gomock.NewController(s.T())
+	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.mockAuditCtl = gomock.NewController(s.T())
+	s.mockAudit = mocks.NewMockAuditImpl(s.mockAuditCtl)
+	s.notifier = &fakeNotifier{}
+	s.recalcChan = make(chan int, 1)
+	s.clock = clockwork.NewFakeClockAt(time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC))
+	s.app = New(nil, s.mockUser, s.mockProject, nil, s.mockDonation, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, s.mockAudit, s.mockRevision, nil, nil, s.notifier, s.clock, "", s.recalcChan)
+}
+
+func (s *LifecycleSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockRevisionCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockDonationCtl.Finish()
+	s.mockAuditCtl.Finish()
+}
+
+func (s *LifecycleSuite) project() *models.Project {
+	return &models.Project{
+		ID:          10,
+		OwnerID:     42,
+		Title:       "Pizza",
+		Published:   true,
+		ReleaseDate: time.Date(2020, 10, 10, 0, 0, 0, 0, time.UTC),
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}
+}
+
+func (s *LifecycleSuite) TestCancelProject() {
+	project := s.project()
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return([]models.Donation{{UserID: 111}, {UserID: 42}}, nil)
+	s.mockProject.EXPECT().Cancel(project, &models.ProjectAudit{
+		ProjectID: 10,
+		UserID:    42,
+		Action:    models.AuditCancel,
+		NewValue:  "Pizzeria is closed",
+		CreatedAt: s.clock.Now(),
+	}).DoAndReturn(func(p *models.Project, _ *models.ProjectAudit) error {
+		p.Closed = true
+		return nil
+	})
+
+	result, err := s.app.CancelProject(42, 10, " Pizzeria is closed ")
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusCancelled, result.Status)
+	s.Require().Equal("Pizzeria is closed", result.CancelReason)
+	s.Require().Equal(10, <-s.recalcChan)
+	s.Require().Equal([]Event{{
+		Type:      EventProjectCancelled,
+		ProjectID: 10,
+		UserIDs:   []int{111},
+		Data: map[string]interface{}{
+			"title":  "Pizza",
+			"reason": "Pizzeria is closed",
+		},
+	}}, s.notifier.events)
+}
+
+func (s *LifecycleSuite) TestCancelProjectNotOwner() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	_, err := s.app.CancelProject(7, 10, "Pizzeria is closed")
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+}
+
+func (s *LifecycleSuite) TestCancelProjectClosed() {
+	project := s.project()
+	project.Closed = true
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	_, err := s.app.CancelProject(42, 10, "Pizzeria is closed")
+	s.Require().Equal(ErrProjectWrongStatus, err)
+}
+
+func (s *LifecycleSuite) TestCancelProjectWithoutReason() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	_, err := s.app.CancelProject(42, 10, " ")
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "reason", Code: CodeRequired, Message: "reason is required"},
+	}, vErr.Fields)
+}
+
+func (s *LifecycleSuite) TestExtendProject() {
+	project := s.project()
+	release := time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return([]models.Donation{{UserID: 111}}, nil)
+	s.mockProject.EXPECT().Extend(project, &models.ProjectAudit{
+		ProjectID: 10,
+		UserID:    42,
+		Action:    models.AuditExtend,
+		Field:     "release_date",
+		OldValue:  "2020-10-10",
+		NewValue:  "2020-10-20",
+		CreatedAt: s.clock.Now(),
+	}).Return(nil)
+
+	expectFirstRevision(s.mockRevision)
+	result, err := s.app.ExtendProject(42, 10, release)
+	s.Require().NoError(err)
+	s.Require().Equal("2020-10-20", result.ReleaseDate)
+	s.Require().Equal(1, project.Extensions)
+	s.Require().Len(s.notifier.events, 1)
+	s.Require().Equal(EventProjectExtended, s.notifier.events[0].Type)
+	s.Require().Equal([]int{111}, s.notifier.events[0].UserIDs)
+}
+
+func (s *LifecycleSuite) TestExtendProjectTwice() {
+	project := s.project()
+	project.Extensions = 1
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	_, err := s.app.ExtendProject(42, 10, time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC))
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "release_date", Code: CodeNotAllowed, Message: "release date can be extended at most 1 times"},
+	}, vErr.Fields)
+}
+
+func (s *LifecycleSuite) TestExtendProjectTooFar() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	_, err := s.app.ExtendProject(42, 10, time.Date(2020, 12, 1, 0, 0, 0, 0, time.UTC))
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "release_date", Code: CodeMax, Message: "release date can be extended by at most 28 days"},
+	}, vErr.Fields)
+}
+
+func (s *LifecycleSuite) TestExtendLockedProject() {
+	project := s.project()
+	project.Locked = true
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	_, err := s.app.ExtendProject(42, 10, time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC))
+	s.Require().Equal(ErrProjectWrongStatus, err)
+}
+
+func (s *LifecycleSuite) TestEditProject() {
+	project := s.project()
+	project.Description = "Friday pizza"
+	title := "Big pizza"
+	descr := "Friday pizza"
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().Edit(project, []models.ProjectAudit{{
+		ProjectID: 10,
+		UserID:    42,
+		Action:    models.AuditEdit,
+		Field:     "title",
+		OldValue:  "Pizza",
+		NewValue:  "Big pizza",
+		CreatedAt: s.clock.Now(),
+	}}).Return(nil)
+
+	expectFirstRevision(s.mockRevision)
+	result, err := s.app.EditProject(42, 10, &title, nil, &descr, nil, nil)
+	s.Require().NoError(err)
+	s.Require().Equal("Big pizza", result.Title)
+}
+
+func (s *LifecycleSuite) TestEditProjectUnchanged() {
+	title := "Pizza"
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+
+	result, err := s.app.EditProject(42, 10, &title, nil, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Require().Equal("Pizza", result.Title)
+}
+
+func (s *LifecycleSuite) TestEditProjectModerator() {
+	title := "Big pizza"
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(&models.Collaborator{
+		ProjectID:  10,
+		UserID:     7,
+		Role:       models.RoleModerator,
+		AcceptedAt: s.clock.Now(),
+	}, true)
+
+	_, err := s.app.EditProject(7, 10, &title, nil, nil, nil, nil)
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+}
+
+func (s *LifecycleSuite) TestReopenProject() {
+	project := s.project()
+	project.Closed = true
+	release := time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return([]models.Donation{{UserID: 111}}, nil)
+	s.mockProject.EXPECT().Reopen(project, &models.ProjectAudit{
+		ProjectID: 10,
+		UserID:    1,
+		Action:    models.AuditReopen,
+		Field:     "release_date",
+		OldValue:  "2020-10-10",
+		NewValue:  "2020-11-01",
+		CreatedAt: s.clock.Now(),
+	}).DoAndReturn(func(p *models.Project, _ *models.ProjectAudit) error {
+		p.Closed = false
+		return nil
+	})
+
+	expectFirstRevision(s.mockRevision)
+	result, err := s.app.ReopenProject(1, 10, release)
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusSearch, result.Status)
+	s.Require().Len(s.notifier.events, 1)
+	s.Require().Equal(EventProjectReopened, s.notifier.events[0].Type)
+	s.Require().Equal([]int{42, 111}, s.notifier.events[0].UserIDs)
+}
+
+func (s *LifecycleSuite) TestReopenProjectNotAdmin() {
+	s.mockUser.EXPECT().Get(42).Return(&models.User{ID: 42}, true)
+
+	_, err := s.app.ReopenProject(42, 10, time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().Equal(ErrAdminRequired, err)
+}
+
+func (s *LifecycleSuite) TestReopenCancelledProject() {
+	project := s.project()
+	project.Closed = true
+	project.CancelledAt = s.clock.Now()
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	_, err := s.app.ReopenProject(1, 10, time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().Equal(ErrProjectWrongStatus, err)
+}
+
+func (s *LifecycleSuite) TestGetProjectAuditDraft() {
+	project := s.project()
+	project.Published = false
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockCollaborator.EXPECT().Get(10, 7).Return(nil, false)
+
+	_, err := s.app.GetProjectAudit(7, 10)
+	s.Require().Equal(ErrProjectNotFound, err)
+}
+
+func TestLifecycleSuite(t *testing.T) {
+	suite.Run(t, new(LifecycleSuite))
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// rateLimiter limits count of user actions within sliding window.
+// Actions are counted in memory, so limit applies to every process separately.
+type rateLimiter struct {
+	mu     sync.Mutex
+	clock  clockwork.Clock
+	limit  int
+	window time.Duration
+	events map[int][]time.Time
+	swept  time.Time
+}
+
+func newRateLimiter(clock clockwork.Clock, limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{
+		clock:  clock,
+		limit:  limit,
+		window: window,
+		events: make(map[int][]time.Time),
+	}
+}
+
+// Allow registers action of user if limit is not exceeded yet.
+func (l *rateLimiter) Allow(userID int) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock.Now()
+	l.sweep(now)
+	events := l.events[userID][:0]
+	for _, t := range l.events[userID] {
+		if now.Sub(t) < l.window {
+			events = append(events, t)
+		}
+	}
+	if len(events) >= l.limit {
+		l.events[userID] = events
+		return false
+	}
+	l.events[userID] = append(events, now)
+
+	return true
+}
+
+// sweep forgets users without actions within window, once per window.
+func (l *rateLimiter) sweep(now time.Time) {
+	if l.swept.IsZero() {
+		l.swept = now
+	}
+	if now.Sub(l.swept) < l.window {
+		return
+	}
+	l.swept = now
+	for userID, events := range l.events {
+		if len(events) == 0 || now.Sub(events[len(events)-1]) >= l.window {
+			delete(l.events, userID)
+		}
+	}
+}
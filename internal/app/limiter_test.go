@@ -0,0 +1,51 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+)
+
+type LimiterSuite struct {
+	suite.Suite
+	clock   clockwork.FakeClock
+	limiter *rateLimiter
+}
+
+func (s *LimiterSuite) SetupTest() {
+	s.clock = clockwork.NewFakeClock()
+	s.limiter = newRateLimiter(s.clock, 2, time.Minute)
+}
+
+func (s *LimiterSuite) TestLimitExceeded() {
+	s.Require().True(s.limiter.Allow(1))
+	s.Require().True(s.limiter.Allow(1))
+	s.Require().False(s.limiter.Allow(1))
+	s.Require().True(s.limiter.Allow(2))
+}
+
+func (s *LimiterSuite) TestWindowPassed() {
+	s.Require().True(s.limiter.Allow(1))
+	s.clock.Advance(30 * time.Second)
+	s.Require().True(s.limiter.Allow(1))
+	s.Require().False(s.limiter.Allow(1))
+	s.clock.Advance(31 * time.Second)
+	s.Require().True(s.limiter.Allow(1))
+	s.Require().False(s.limiter.Allow(1))
+}
+
+func (s *LimiterSuite) TestStaleUsersEvicted() {
+	s.Require().True(s.limiter.Allow(1))
+	s.clock.Advance(30 * time.Second)
+	s.Require().True(s.limiter.Allow(2))
+	s.clock.Advance(40 * time.Second)
+	s.Require().True(s.limiter.Allow(3))
+	s.Require().Len(s.limiter.events, 2)
+	s.Require().NotContains(s.limiter.events, 1)
+}
+
+func TestLimiterSuite(t *testing.T) {
+	suite.Run(t, new(LimiterSuite))
+}
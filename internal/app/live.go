@@ -0,0 +1,143 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	liveChannel    = "project_live"
+	liveBufferSize = 16
+)
+
+// liveEvents event types streamed to live subscribers of project.
+var liveEvents = map[EventType]bool{
+	EventProjectProgress:   true,
+	EventParticipantJoined: true,
+	EventParticipantLeft:   true,
+}
+
+// LiveMessage project update streamed to live subscribers.
+type LiveMessage struct {
+	Event     EventType              `json:"event"`
+	ProjectID int                    `json:"project"`
+	Data      map[string]interface{} `json:"data"`
+}
+
+// progressEvent returns event with current total, percent and status of project.
+func progressEvent(strategy Strategy, p *models.Project) Event {
+	return Event{
+		Type:      EventProjectProgress,
+		ProjectID: p.ID,
+		Data: map[string]interface{}{
+			"total":   p.Total,
+			"percent": strategy.Percent(p),
+			"status":  p.Status(),
+		},
+	}
+}
+
+// LiveHub fans project updates out to subscribers of all instances through postgres channel.
+type LiveHub struct {
+	channelModel models.ChannelImpl
+	mu           sync.Mutex
+	subscribers  map[int]map[chan LiveMessage]struct{}
+}
+
+// NewLiveHub returns new live hub.
+func NewLiveHub(mc models.ChannelImpl) *LiveHub {
+	return &LiveHub{
+		channelModel: mc,
+		subscribers:  make(map[int]map[chan LiveMessage]struct{}),
+	}
+}
+
+// Notify publishes event to all instances.
+func (h *LiveHub) Notify(e Event) {
+	if !liveEvents[e.Type] {
+		return
+	}
+	body, err := json.Marshal(LiveMessage{
+		Event:     e.Type,
+		ProjectID: e.ProjectID,
+		Data:      publicEventData(e),
+	})
+	if err != nil {
+		log.Errorf("unable to encode live message: %s", err)
+		return
+	}
+	err = h.channelModel.Notify(liveChannel, string(body))
+	if err != nil {
+		log.Errorf("unable to publish live message of project %d: %s", e.ProjectID, err)
+	}
+}
+
+// Run receives messages published by all instances and passes them to local subscribers.
+func (h *LiveHub) Run(ctx context.Context) {
+	for payload := range h.channelModel.Listen(ctx, liveChannel) {
+		msg := LiveMessage{}
+		err := json.Unmarshal([]byte(payload), &msg)
+		if err != nil {
+			log.Errorf("unable to decode live message: %s", err)
+			continue
+		}
+		h.broadcast(msg)
+	}
+	log.Info("stop live hub")
+}
+
+// broadcast passes message to subscribers of project, slow subscribers miss it.
+func (h *LiveHub) broadcast(msg LiveMessage) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subscribers[msg.ProjectID] {
+		select {
+		case ch <- msg:
+		default:
+		}
+	}
+}
+
+// Subscribe returns channel with updates of project and function to unsubscribe.
+func (h *LiveHub) Subscribe(projectID int) (<-chan LiveMessage, func()) {
+	ch := make(chan LiveMessage, liveBufferSize)
+	h.mu.Lock()
+	if h.subscribers[projectID] == nil {
+		h.subscribers[projectID] = make(map[chan LiveMessage]struct{})
+	}
+	h.subscribers[projectID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			delete(h.subscribers[projectID], ch)
+			if len(h.subscribers[projectID]) == 0 {
+				delete(h.subscribers, projectID)
+			}
+			close(ch)
+		})
+	}
+}
+
+// SubscribeProject returns current progress of project visible to user and channel with its updates.
+func (a *App) SubscribeProject(userID, projectID int) (*LiveMessage, <-chan LiveMessage, func(), error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, userID) {
+		return nil, nil, nil, ErrProjectNotFound
+	}
+	strategy, err := GetStrategy(&project.ProjectType, a.projectModel)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	e := progressEvent(strategy, project)
+	ch, cancel := a.live.Subscribe(projectID)
+
+	return &LiveMessage{Event: e.Type, ProjectID: e.ProjectID, Data: e.Data}, ch, cancel, nil
+}
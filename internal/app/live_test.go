@@ -0,0 +1,129 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type LiveSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockChannelCtl      *gomock.Controller
+	mockChannel         *mocks.MockChannelImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	hub                 *LiveHub
+	app                 *App
+}
+
+func (s *LiveSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockChannelCtl = gomock.NewController(s.T())
+	s.mockChannel = mocks.NewMockChannelImpl(s.mockChannelCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.hub = NewLiveHub(s.mockChannel)
+	s.app = New(nil, nil, s.mockProject, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, nil, s.hub, nil, nil, nil, "", nil)
+}
+
+func (s *LiveSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockChannelCtl.Finish()
+	s.mockProjectCtl.Finish()
+}
+
+func (s *LiveSuite) TestNotify() {
+	s.mockChannel.EXPECT().Notify(liveChannel, `{"event":"participant_joined","project":10,"data":{"anonymous":true,"donation":3}}`).Return(nil)
+
+	s.hub.Notify(Event{
+		Type:      EventParticipantJoined,
+		ProjectID: 10,
+		UserIDs:   []int{42},
+		Data:      map[string]interface{}{"donation": 3, "user": 5, "anonymous": true},
+	})
+}
+
+func (s *LiveSuite) TestNotifyNotLive() {
+	s.hub.Notify(Event{Type: EventPaymentDue, ProjectID: 10, UserIDs: []int{5}})
+}
+
+func (s *LiveSuite) TestRun() {
+	payloads := make(chan string, 3)
+	s.mockChannel.EXPECT().Listen(gomock.Any(), liveChannel).Return(payloads)
+	updates, cancel := s.hub.Subscribe(10)
+	defer cancel()
+	other, cancelOther := s.hub.Subscribe(11)
+	defer cancelOther()
+
+	payloads <- `{"event":"project_progress","project":10,"data":{"percent":50}}`
+	payloads <- `broken`
+	payloads <- `{"event":"project_progress","project":12,"data":{"percent":10}}`
+	close(payloads)
+	s.hub.Run(context.Background())
+
+	s.Require().Equal(LiveMessage{
+		Event:     EventProjectProgress,
+		ProjectID: 10,
+		Data:      map[string]interface{}{"percent": float64(50)},
+	}, <-updates)
+	s.Require().Empty(updates)
+	s.Require().Empty(other)
+}
+
+func (s *LiveSuite) TestUnsubscribe() {
+	updates, cancel := s.hub.Subscribe(10)
+	cancel()
+	cancel()
+
+	_, open := <-updates
+	s.Require().False(open)
+	s.Require().Empty(s.hub.subscribers)
+	s.hub.broadcast(LiveMessage{ProjectID: 10})
+}
+
+func (s *LiveSuite) TestSubscribeProject() {
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{
+		ID:         10,
+		Published:  true,
+		Total:      500,
+		GoalAmount: 1000,
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}, true)
+
+	current, updates, cancel, err := s.app.SubscribeProject(5, 10)
+	s.Require().NoError(err)
+	defer cancel()
+	s.Require().Equal(&LiveMessage{
+		Event:     EventProjectProgress,
+		ProjectID: 10,
+		Data: map[string]interface{}{
+			"total":   int64(500),
+			"percent": 50,
+			"status":  models.StatusSearch,
+		},
+	}, current)
+	s.Require().NotNil(updates)
+}
+
+func (s *LiveSuite) TestSubscribeProjectDraft() {
+	s.mockCollaborator.EXPECT().Get(10, 5).Return(nil, false)
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{ID: 10, OwnerID: 42}, true)
+
+	_, _, _, err := s.app.SubscribeProject(5, 10)
+	s.Require().Equal(ErrProjectNotFound, err)
+}
+
+func TestLiveSuite(t *testing.T) {
+	suite.Run(t, new(LiveSuite))
+}
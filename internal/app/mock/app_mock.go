@@ -51,18 +51,18 @@ func (mr *MockApplicationMockRecorder) GetCategories() *gomock.Call {
 }
 
 // GetUser mocks base method
-func (m *MockApplication) GetUser(id int) (*app.ExtendedUser, error) {
+func (m *MockApplication) GetUser(id, viewerID int) (*app.ExtendedUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", id)
+	ret := m.ctrl.Call(m, "GetUser", id, viewerID)
 	ret0, _ := ret[0].(*app.ExtendedUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUser indicates an expected call of GetUser
-func (mr *MockApplicationMockRecorder) GetUser(id interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) GetUser(id, viewerID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockApplication)(nil).GetUser), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockApplication)(nil).GetUser), id, viewerID)
 }
 
 // Authentificate mocks base method
@@ -111,9 +111,9 @@ func (mr *MockApplicationMockRecorder) GetProject(id interface{}) *gomock.Call {
 }
 
 // GetProjectsWithPagination mocks base method
-func (m *MockApplication) GetProjectsWithPagination(category, projectType, page, pageSize int, onlyOpen bool) ([]*app.ExtendedProject, int, bool, error) {
+func (m *MockApplication) GetProjectsWithPagination(filter *models.ProjectFilter, page, pageSize int) ([]*app.ExtendedProject, int, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectsWithPagination", category, projectType, page, pageSize, onlyOpen)
+	ret := m.ctrl.Call(m, "GetProjectsWithPagination", filter, page, pageSize)
 	ret0, _ := ret[0].([]*app.ExtendedProject)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(bool)
@@ -122,54 +122,102 @@ func (m *MockApplication) GetProjectsWithPagination(category, projectType, page,
 }
 
 // GetProjectsWithPagination indicates an expected call of GetProjectsWithPagination
-func (mr *MockApplicationMockRecorder) GetProjectsWithPagination(category, projectType, page, pageSize, onlyOpen interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) GetProjectsWithPagination(filter, page, pageSize interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsWithPagination", reflect.TypeOf((*MockApplication)(nil).GetProjectsWithPagination), category, projectType, page, pageSize, onlyOpen)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsWithPagination", reflect.TypeOf((*MockApplication)(nil).GetProjectsWithPagination), filter, page, pageSize)
+}
+
+// GetProjectsWithCursor mocks base method
+func (m *MockApplication) GetProjectsWithCursor(filter *models.ProjectFilter, cursor *models.Cursor, limit int) ([]*app.ExtendedProject, *models.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectsWithCursor", filter, cursor, limit)
+	ret0, _ := ret[0].([]*app.ExtendedProject)
+	ret1, _ := ret[1].(*models.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectsWithCursor indicates an expected call of GetProjectsWithCursor
+func (mr *MockApplicationMockRecorder) GetProjectsWithCursor(filter, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsWithCursor", reflect.TypeOf((*MockApplication)(nil).GetProjectsWithCursor), filter, cursor, limit)
 }
 
 // GetUserProjects mocks base method
-func (m *MockApplication) GetUserProjects(user int, onlyContributed, onlyOwned bool) ([]*app.ExtendedProject, error) {
+func (m *MockApplication) GetUserProjects(user, viewerID int, onlyContributed, onlyOwned bool, cursor, limit int) ([]*app.ExtendedProject, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProjects", user, viewerID, onlyContributed, onlyOwned, cursor, limit)
+	ret0, _ := ret[0].([]*app.ExtendedProject)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetUserProjects indicates an expected call of GetUserProjects
+func (mr *MockApplicationMockRecorder) GetUserProjects(user, viewerID, onlyContributed, onlyOwned, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProjects", reflect.TypeOf((*MockApplication)(nil).GetUserProjects), user, viewerID, onlyContributed, onlyOwned, cursor, limit)
+}
+
+// GetTrendingProjects mocks base method
+func (m *MockApplication) GetTrendingProjects(limit int) ([]*app.ExtendedProject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserProjects", user, onlyContributed, onlyOwned)
+	ret := m.ctrl.Call(m, "GetTrendingProjects", limit)
 	ret0, _ := ret[0].([]*app.ExtendedProject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserProjects indicates an expected call of GetUserProjects
-func (mr *MockApplicationMockRecorder) GetUserProjects(user, onlyContributed, onlyOwned interface{}) *gomock.Call {
+// GetTrendingProjects indicates an expected call of GetTrendingProjects
+func (mr *MockApplicationMockRecorder) GetTrendingProjects(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrendingProjects", reflect.TypeOf((*MockApplication)(nil).GetTrendingProjects), limit)
+}
+
+// GetRecommendedProjects mocks base method
+func (m *MockApplication) GetRecommendedProjects(userID, limit int) ([]*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendedProjects", userID, limit)
+	ret0, _ := ret[0].([]*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendedProjects indicates an expected call of GetRecommendedProjects
+func (mr *MockApplicationMockRecorder) GetRecommendedProjects(userID, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProjects", reflect.TypeOf((*MockApplication)(nil).GetUserProjects), user, onlyContributed, onlyOwned)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendedProjects", reflect.TypeOf((*MockApplication)(nil).GetRecommendedProjects), userID, limit)
 }
 
 // CreateProject mocks base method
-func (m *MockApplication) CreateProject(user, goalPeople, goalAmount, category, projectType int, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time) (int, error) {
+func (m *MockApplication) CreateProject(user, template, goalPeople int, goalAmount int64, category, projectType int, currency, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, rules models.PledgeRules) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProject", user, goalPeople, goalAmount, category, projectType, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime)
+	ret := m.ctrl.Call(m, "CreateProject", user, template, goalPeople, goalAmount, category, projectType, currency, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, rules)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateProject indicates an expected call of CreateProject
-func (mr *MockApplicationMockRecorder) CreateProject(user, goalPeople, goalAmount, category, projectType, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) CreateProject(user, template, goalPeople, goalAmount, category, projectType, currency, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, rules interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockApplication)(nil).CreateProject), user, goalPeople, goalAmount, category, projectType, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockApplication)(nil).CreateProject), user, template, goalPeople, goalAmount, category, projectType, currency, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, rules)
 }
 
 // UpdateProject mocks base method
-func (m *MockApplication) UpdateProject(id, user, goalPeople, goalAmount, category, projectType int, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, published, dropEventDate bool) (*app.ExtendedProject, error) {
+func (m *MockApplication) UpdateProject(id, user, goalPeople int, goalAmount int64, category, projectType int, currency, title, subtitle, descr, imageLink, instructions string, releaseDate, eventTime time.Time, rules *models.PledgeRules, published, dropEventDate bool) (*app.ExtendedProject, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateProject", id, user, goalPeople, goalAmount, category, projectType, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, published, dropEventDate)
+	ret := m.ctrl.Call(m, "UpdateProject", id, user, goalPeople, goalAmount, category, projectType, currency, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, rules, published, dropEventDate)
 	ret0, _ := ret[0].(*app.ExtendedProject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateProject indicates an expected call of UpdateProject
-func (mr *MockApplicationMockRecorder) UpdateProject(id, user, goalPeople, goalAmount, category, projectType, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, published, dropEventDate interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) UpdateProject(id, user, goalPeople, goalAmount, category, projectType, currency, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, rules, published, dropEventDate interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockApplication)(nil).UpdateProject), id, user, goalPeople, goalAmount, category, projectType, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, published, dropEventDate)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockApplication)(nil).UpdateProject), id, user, goalPeople, goalAmount, category, projectType, currency, title, subtitle, descr, imageLink, instructions, releaseDate, eventTime, rules, published, dropEventDate)
 }
 
 // DeleteProject mocks base method
@@ -186,49 +234,381 @@ func (mr *MockApplicationMockRecorder) DeleteProject(iserID, projectID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockApplication)(nil).DeleteProject), iserID, projectID)
 }
 
-// GetUserDonations mocks base method
-func (m *MockApplication) GetUserDonations(id int) ([]models.Donation, error) {
+// GetCollaborators mocks base method
+func (m *MockApplication) GetCollaborators(viewerID, projectID int) ([]models.Collaborator, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserDonations", id)
-	ret0, _ := ret[0].([]models.Donation)
+	ret := m.ctrl.Call(m, "GetCollaborators", viewerID, projectID)
+	ret0, _ := ret[0].([]models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollaborators indicates an expected call of GetCollaborators
+func (mr *MockApplicationMockRecorder) GetCollaborators(viewerID, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollaborators", reflect.TypeOf((*MockApplication)(nil).GetCollaborators), viewerID, projectID)
+}
+
+// InviteCollaborator mocks base method
+func (m *MockApplication) InviteCollaborator(ownerID, projectID, userID int, role string) (*models.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteCollaborator", ownerID, projectID, userID, role)
+	ret0, _ := ret[0].(*models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteCollaborator indicates an expected call of InviteCollaborator
+func (mr *MockApplicationMockRecorder) InviteCollaborator(ownerID, projectID, userID, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCollaborator", reflect.TypeOf((*MockApplication)(nil).InviteCollaborator), ownerID, projectID, userID, role)
+}
+
+// GetInvitations mocks base method
+func (m *MockApplication) GetInvitations(userID int) ([]models.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", userID)
+	ret0, _ := ret[0].([]models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations
+func (mr *MockApplicationMockRecorder) GetInvitations(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockApplication)(nil).GetInvitations), userID)
+}
+
+// AcceptInvitation mocks base method
+func (m *MockApplication) AcceptInvitation(userID, projectID int) (*models.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", userID, projectID)
+	ret0, _ := ret[0].(*models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation
+func (mr *MockApplicationMockRecorder) AcceptInvitation(userID, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockApplication)(nil).AcceptInvitation), userID, projectID)
+}
+
+// RemoveCollaborator mocks base method
+func (m *MockApplication) RemoveCollaborator(actorID, projectID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", actorID, projectID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator
+func (mr *MockApplicationMockRecorder) RemoveCollaborator(actorID, projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockApplication)(nil).RemoveCollaborator), actorID, projectID, userID)
+}
+
+// TransferOwnership mocks base method
+func (m *MockApplication) TransferOwnership(ownerID, projectID, userID int) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", ownerID, projectID, userID)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership
+func (mr *MockApplicationMockRecorder) TransferOwnership(ownerID, projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockApplication)(nil).TransferOwnership), ownerID, projectID, userID)
+}
+
+// CancelProject mocks base method
+func (m *MockApplication) CancelProject(userID, projectID int, reason string) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelProject", userID, projectID, reason)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelProject indicates an expected call of CancelProject
+func (mr *MockApplicationMockRecorder) CancelProject(userID, projectID, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelProject", reflect.TypeOf((*MockApplication)(nil).CancelProject), userID, projectID, reason)
+}
+
+// ExtendProject mocks base method
+func (m *MockApplication) ExtendProject(userID, projectID int, releaseDate time.Time) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendProject", userID, projectID, releaseDate)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtendProject indicates an expected call of ExtendProject
+func (mr *MockApplicationMockRecorder) ExtendProject(userID, projectID, releaseDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendProject", reflect.TypeOf((*MockApplication)(nil).ExtendProject), userID, projectID, releaseDate)
+}
+
+// EditProject mocks base method
+func (m *MockApplication) EditProject(userID, projectID int, title, subtitle, descr, imageLink, instructions *string) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditProject", userID, projectID, title, subtitle, descr, imageLink, instructions)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditProject indicates an expected call of EditProject
+func (mr *MockApplicationMockRecorder) EditProject(userID, projectID, title, subtitle, descr, imageLink, instructions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditProject", reflect.TypeOf((*MockApplication)(nil).EditProject), userID, projectID, title, subtitle, descr, imageLink, instructions)
+}
+
+// ReopenProject mocks base method
+func (m *MockApplication) ReopenProject(userID, projectID int, releaseDate time.Time) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenProject", userID, projectID, releaseDate)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenProject indicates an expected call of ReopenProject
+func (mr *MockApplicationMockRecorder) ReopenProject(userID, projectID, releaseDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenProject", reflect.TypeOf((*MockApplication)(nil).ReopenProject), userID, projectID, releaseDate)
+}
+
+// SchedulePublication mocks base method
+func (m *MockApplication) SchedulePublication(userID, projectID int, publishAt time.Time) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePublication", userID, projectID, publishAt)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchedulePublication indicates an expected call of SchedulePublication
+func (mr *MockApplicationMockRecorder) SchedulePublication(userID, projectID, publishAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublication", reflect.TypeOf((*MockApplication)(nil).SchedulePublication), userID, projectID, publishAt)
+}
+
+// GetReviewQueue mocks base method
+func (m *MockApplication) GetReviewQueue(userID int) ([]*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewQueue", userID)
+	ret0, _ := ret[0].([]*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewQueue indicates an expected call of GetReviewQueue
+func (mr *MockApplicationMockRecorder) GetReviewQueue(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewQueue", reflect.TypeOf((*MockApplication)(nil).GetReviewQueue), userID)
+}
+
+// ApproveProject mocks base method
+func (m *MockApplication) ApproveProject(userID, projectID int, comment string) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveProject", userID, projectID, comment)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveProject indicates an expected call of ApproveProject
+func (mr *MockApplicationMockRecorder) ApproveProject(userID, projectID, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveProject", reflect.TypeOf((*MockApplication)(nil).ApproveProject), userID, projectID, comment)
+}
+
+// RejectProject mocks base method
+func (m *MockApplication) RejectProject(userID, projectID int, comment string) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectProject", userID, projectID, comment)
+	ret0, _ := ret[0].(*app.ExtendedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectProject indicates an expected call of RejectProject
+func (mr *MockApplicationMockRecorder) RejectProject(userID, projectID, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectProject", reflect.TypeOf((*MockApplication)(nil).RejectProject), userID, projectID, comment)
+}
+
+// GetProjectAudit mocks base method
+func (m *MockApplication) GetProjectAudit(viewerID, projectID int) ([]models.ProjectAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectAudit", viewerID, projectID)
+	ret0, _ := ret[0].([]models.ProjectAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectAudit indicates an expected call of GetProjectAudit
+func (mr *MockApplicationMockRecorder) GetProjectAudit(viewerID, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAudit", reflect.TypeOf((*MockApplication)(nil).GetProjectAudit), viewerID, projectID)
+}
+
+// GetProjectRevisions mocks base method
+func (m *MockApplication) GetProjectRevisions(viewerID, projectID int) ([]models.ProjectRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectRevisions", viewerID, projectID)
+	ret0, _ := ret[0].([]models.ProjectRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectRevisions indicates an expected call of GetProjectRevisions
+func (mr *MockApplicationMockRecorder) GetProjectRevisions(viewerID, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRevisions", reflect.TypeOf((*MockApplication)(nil).GetProjectRevisions), viewerID, projectID)
+}
+
+// GetRevisionDiff mocks base method
+func (m *MockApplication) GetRevisionDiff(viewerID, projectID, from, to int) (*app.RevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionDiff", viewerID, projectID, from, to)
+	ret0, _ := ret[0].(*app.RevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionDiff indicates an expected call of GetRevisionDiff
+func (mr *MockApplicationMockRecorder) GetRevisionDiff(viewerID, projectID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionDiff", reflect.TypeOf((*MockApplication)(nil).GetRevisionDiff), viewerID, projectID, from, to)
+}
+
+// CloneProject mocks base method
+func (m *MockApplication) CloneProject(userID, projectID int, releaseDate time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloneProject", userID, projectID, releaseDate)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloneProject indicates an expected call of CloneProject
+func (mr *MockApplicationMockRecorder) CloneProject(userID, projectID, releaseDate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneProject", reflect.TypeOf((*MockApplication)(nil).CloneProject), userID, projectID, releaseDate)
+}
+
+// GetTemplates mocks base method
+func (m *MockApplication) GetTemplates(userID int) ([]models.ProjectTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplates", userID)
+	ret0, _ := ret[0].([]models.ProjectTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplates indicates an expected call of GetTemplates
+func (mr *MockApplicationMockRecorder) GetTemplates(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplates", reflect.TypeOf((*MockApplication)(nil).GetTemplates), userID)
+}
+
+// CreateTemplate mocks base method
+func (m *MockApplication) CreateTemplate(userID int, t *models.ProjectTemplate) (*models.ProjectTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", userID, t)
+	ret0, _ := ret[0].(*models.ProjectTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate
+func (mr *MockApplicationMockRecorder) CreateTemplate(userID, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockApplication)(nil).CreateTemplate), userID, t)
+}
+
+// DeleteTemplate mocks base method
+func (m *MockApplication) DeleteTemplate(userID, templateID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", userID, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate
+func (mr *MockApplicationMockRecorder) DeleteTemplate(userID, templateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockApplication)(nil).DeleteTemplate), userID, templateID)
+}
+
+// SetProjectPrivacy mocks base method
+func (m *MockApplication) SetProjectPrivacy(userID, projectID int, privateAmounts bool) (*app.ExtendedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectPrivacy", userID, projectID, privateAmounts)
+	ret0, _ := ret[0].(*app.ExtendedProject)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// SetProjectPrivacy indicates an expected call of SetProjectPrivacy
+func (mr *MockApplicationMockRecorder) SetProjectPrivacy(userID, projectID, privateAmounts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectPrivacy", reflect.TypeOf((*MockApplication)(nil).SetProjectPrivacy), userID, projectID, privateAmounts)
+}
+
+// GetUserDonations mocks base method
+func (m *MockApplication) GetUserDonations(id, cursor, limit int) ([]models.Donation, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserDonations", id, cursor, limit)
+	ret0, _ := ret[0].([]models.Donation)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
 // GetUserDonations indicates an expected call of GetUserDonations
-func (mr *MockApplicationMockRecorder) GetUserDonations(id interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) GetUserDonations(id, cursor, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDonations", reflect.TypeOf((*MockApplication)(nil).GetUserDonations), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDonations", reflect.TypeOf((*MockApplication)(nil).GetUserDonations), id, cursor, limit)
 }
 
 // GetProjectDonations mocks base method
-func (m *MockApplication) GetProjectDonations(id int) ([]app.ShortDonation, error) {
+func (m *MockApplication) GetProjectDonations(id, viewerID, cursor, limit int) ([]app.ShortDonation, int, bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectDonations", id)
+	ret := m.ctrl.Call(m, "GetProjectDonations", id, viewerID, cursor, limit)
 	ret0, _ := ret[0].([]app.ShortDonation)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
 }
 
 // GetProjectDonations indicates an expected call of GetProjectDonations
-func (mr *MockApplicationMockRecorder) GetProjectDonations(id interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) GetProjectDonations(id, viewerID, cursor, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDonations", reflect.TypeOf((*MockApplication)(nil).GetProjectDonations), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectDonations", reflect.TypeOf((*MockApplication)(nil).GetProjectDonations), id, viewerID, cursor, limit)
 }
 
 // CreateDonation mocks base method
-func (m *MockApplication) CreateDonation(userID, projectID, payment int) (*models.Donation, error) {
+func (m *MockApplication) CreateDonation(userID, projectID, tierID int, payment int64, anonymous bool, message string) (*models.Donation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDonation", userID, projectID, payment)
+	ret := m.ctrl.Call(m, "CreateDonation", userID, projectID, tierID, payment, anonymous, message)
 	ret0, _ := ret[0].(*models.Donation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateDonation indicates an expected call of CreateDonation
-func (mr *MockApplicationMockRecorder) CreateDonation(userID, projectID, payment interface{}) *gomock.Call {
+func (mr *MockApplicationMockRecorder) CreateDonation(userID, projectID, tierID, payment, anonymous, message interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDonation", reflect.TypeOf((*MockApplication)(nil).CreateDonation), userID, projectID, payment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDonation", reflect.TypeOf((*MockApplication)(nil).CreateDonation), userID, projectID, tierID, payment, anonymous, message)
 }
 
 // DeleteDonation mocks base method
@@ -246,7 +626,7 @@ func (mr *MockApplicationMockRecorder) DeleteDonation(donationID, userID interfa
 }
 
 // UpdateDonation mocks base method
-func (m *MockApplication) UpdateDonation(donationID, userID, payment int, paid bool) (*models.Donation, error) {
+func (m *MockApplication) UpdateDonation(donationID, userID int, payment int64, paid bool) (*models.Donation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateDonation", donationID, userID, payment, paid)
 	ret0, _ := ret[0].(*models.Donation)
@@ -259,3 +639,669 @@ func (mr *MockApplicationMockRecorder) UpdateDonation(donationID, userID, paymen
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDonation", reflect.TypeOf((*MockApplication)(nil).UpdateDonation), donationID, userID, payment, paid)
 }
+
+// SetDonationAnonymity mocks base method
+func (m *MockApplication) SetDonationAnonymity(donationID, userID int, anonymous bool) (*models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDonationAnonymity", donationID, userID, anonymous)
+	ret0, _ := ret[0].(*models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDonationAnonymity indicates an expected call of SetDonationAnonymity
+func (mr *MockApplicationMockRecorder) SetDonationAnonymity(donationID, userID, anonymous interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDonationAnonymity", reflect.TypeOf((*MockApplication)(nil).SetDonationAnonymity), donationID, userID, anonymous)
+}
+
+// SetDonationMessage mocks base method
+func (m *MockApplication) SetDonationMessage(donationID, userID int, message string) (*models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDonationMessage", donationID, userID, message)
+	ret0, _ := ret[0].(*models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDonationMessage indicates an expected call of SetDonationMessage
+func (mr *MockApplicationMockRecorder) SetDonationMessage(donationID, userID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDonationMessage", reflect.TypeOf((*MockApplication)(nil).SetDonationMessage), donationID, userID, message)
+}
+
+// HideDonationMessage mocks base method
+func (m *MockApplication) HideDonationMessage(donationID, userID int, hidden bool) (*models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideDonationMessage", donationID, userID, hidden)
+	ret0, _ := ret[0].(*models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideDonationMessage indicates an expected call of HideDonationMessage
+func (mr *MockApplicationMockRecorder) HideDonationMessage(donationID, userID, hidden interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideDonationMessage", reflect.TypeOf((*MockApplication)(nil).HideDonationMessage), donationID, userID, hidden)
+}
+
+// DeleteDonationMessage mocks base method
+func (m *MockApplication) DeleteDonationMessage(donationID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDonationMessage", donationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDonationMessage indicates an expected call of DeleteDonationMessage
+func (mr *MockApplicationMockRecorder) DeleteDonationMessage(donationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDonationMessage", reflect.TypeOf((*MockApplication)(nil).DeleteDonationMessage), donationID, userID)
+}
+
+// GetGuestbook mocks base method
+func (m *MockApplication) GetGuestbook(projectID, viewerID, page, pageSize int) ([]app.GuestbookEntry, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGuestbook", projectID, viewerID, page, pageSize)
+	ret0, _ := ret[0].([]app.GuestbookEntry)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetGuestbook indicates an expected call of GetGuestbook
+func (mr *MockApplicationMockRecorder) GetGuestbook(projectID, viewerID, page, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestbook", reflect.TypeOf((*MockApplication)(nil).GetGuestbook), projectID, viewerID, page, pageSize)
+}
+
+// GetProjectComments mocks base method
+func (m *MockApplication) GetProjectComments(projectID, viewerID, cursor, limit int) ([]models.Comment, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectComments", projectID, viewerID, cursor, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetProjectComments indicates an expected call of GetProjectComments
+func (mr *MockApplicationMockRecorder) GetProjectComments(projectID, viewerID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectComments", reflect.TypeOf((*MockApplication)(nil).GetProjectComments), projectID, viewerID, cursor, limit)
+}
+
+// GetCommentReplies mocks base method
+func (m *MockApplication) GetCommentReplies(commentID, viewerID, cursor, limit int) ([]models.Comment, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentReplies", commentID, viewerID, cursor, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetCommentReplies indicates an expected call of GetCommentReplies
+func (mr *MockApplicationMockRecorder) GetCommentReplies(commentID, viewerID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentReplies", reflect.TypeOf((*MockApplication)(nil).GetCommentReplies), commentID, viewerID, cursor, limit)
+}
+
+// CreateComment mocks base method
+func (m *MockApplication) CreateComment(userID, projectID, parentID int, text string) (*models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", userID, projectID, parentID, text)
+	ret0, _ := ret[0].(*models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment
+func (mr *MockApplicationMockRecorder) CreateComment(userID, projectID, parentID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockApplication)(nil).CreateComment), userID, projectID, parentID, text)
+}
+
+// UpdateComment mocks base method
+func (m *MockApplication) UpdateComment(commentID, userID int, text string) (*models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", commentID, userID, text)
+	ret0, _ := ret[0].(*models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateComment indicates an expected call of UpdateComment
+func (mr *MockApplicationMockRecorder) UpdateComment(commentID, userID, text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockApplication)(nil).UpdateComment), commentID, userID, text)
+}
+
+// DeleteComment mocks base method
+func (m *MockApplication) DeleteComment(commentID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", commentID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment
+func (mr *MockApplicationMockRecorder) DeleteComment(commentID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockApplication)(nil).DeleteComment), commentID, userID)
+}
+
+// GetProjectUpdates mocks base method
+func (m *MockApplication) GetProjectUpdates(projectID, viewerID int) ([]models.ProjectUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectUpdates", projectID, viewerID)
+	ret0, _ := ret[0].([]models.ProjectUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectUpdates indicates an expected call of GetProjectUpdates
+func (mr *MockApplicationMockRecorder) GetProjectUpdates(projectID, viewerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectUpdates", reflect.TypeOf((*MockApplication)(nil).GetProjectUpdates), projectID, viewerID)
+}
+
+// CreateProjectUpdate mocks base method
+func (m *MockApplication) CreateProjectUpdate(userID, projectID int, title, text string, participantsOnly bool) (*models.ProjectUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectUpdate", userID, projectID, title, text, participantsOnly)
+	ret0, _ := ret[0].(*models.ProjectUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectUpdate indicates an expected call of CreateProjectUpdate
+func (mr *MockApplicationMockRecorder) CreateProjectUpdate(userID, projectID, title, text, participantsOnly interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectUpdate", reflect.TypeOf((*MockApplication)(nil).CreateProjectUpdate), userID, projectID, title, text, participantsOnly)
+}
+
+// DeleteProjectUpdate mocks base method
+func (m *MockApplication) DeleteProjectUpdate(userID, updateID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectUpdate", userID, updateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProjectUpdate indicates an expected call of DeleteProjectUpdate
+func (mr *MockApplicationMockRecorder) DeleteProjectUpdate(userID, updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectUpdate", reflect.TypeOf((*MockApplication)(nil).DeleteProjectUpdate), userID, updateID)
+}
+
+// GetNotifications mocks base method
+func (m *MockApplication) GetNotifications(userID, cursor, limit int, onlyUnread bool) ([]models.Notification, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", userID, cursor, limit, onlyUnread)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetNotifications indicates an expected call of GetNotifications
+func (mr *MockApplicationMockRecorder) GetNotifications(userID, cursor, limit, onlyUnread interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockApplication)(nil).GetNotifications), userID, cursor, limit, onlyUnread)
+}
+
+// CountUnreadNotifications mocks base method
+func (m *MockApplication) CountUnreadNotifications(userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadNotifications", userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadNotifications indicates an expected call of CountUnreadNotifications
+func (mr *MockApplicationMockRecorder) CountUnreadNotifications(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadNotifications", reflect.TypeOf((*MockApplication)(nil).CountUnreadNotifications), userID)
+}
+
+// MarkNotificationsRead mocks base method
+func (m *MockApplication) MarkNotificationsRead(userID int, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationsRead", userID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkNotificationsRead indicates an expected call of MarkNotificationsRead
+func (mr *MockApplicationMockRecorder) MarkNotificationsRead(userID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationsRead", reflect.TypeOf((*MockApplication)(nil).MarkNotificationsRead), userID, ids)
+}
+
+// MarkAllNotificationsRead mocks base method
+func (m *MockApplication) MarkAllNotificationsRead(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead
+func (mr *MockApplicationMockRecorder) MarkAllNotificationsRead(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockApplication)(nil).MarkAllNotificationsRead), userID)
+}
+
+// GetEmailSettings mocks base method
+func (m *MockApplication) GetEmailSettings(userID int) (*models.EmailSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailSettings", userID)
+	ret0, _ := ret[0].(*models.EmailSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailSettings indicates an expected call of GetEmailSettings
+func (mr *MockApplicationMockRecorder) GetEmailSettings(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailSettings", reflect.TypeOf((*MockApplication)(nil).GetEmailSettings), userID)
+}
+
+// UpdateEmailSettings mocks base method
+func (m *MockApplication) UpdateEmailSettings(userID int, locale string, digest bool, events []string) (*models.EmailSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmailSettings", userID, locale, digest, events)
+	ret0, _ := ret[0].(*models.EmailSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEmailSettings indicates an expected call of UpdateEmailSettings
+func (mr *MockApplicationMockRecorder) UpdateEmailSettings(userID, locale, digest, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmailSettings", reflect.TypeOf((*MockApplication)(nil).UpdateEmailSettings), userID, locale, digest, events)
+}
+
+// GetWebhooks mocks base method
+func (m *MockApplication) GetWebhooks(userID int) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks", userID)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks
+func (mr *MockApplicationMockRecorder) GetWebhooks(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockApplication)(nil).GetWebhooks), userID)
+}
+
+// CreateWebhook mocks base method
+func (m *MockApplication) CreateWebhook(userID int, url string, events []string) (*app.CreatedWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", userID, url, events)
+	ret0, _ := ret[0].(*app.CreatedWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook
+func (mr *MockApplicationMockRecorder) CreateWebhook(userID, url, events interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockApplication)(nil).CreateWebhook), userID, url, events)
+}
+
+// UpdateWebhook mocks base method
+func (m *MockApplication) UpdateWebhook(userID, webhookID int, url string, events []string, active bool) (*models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhook", userID, webhookID, url, events, active)
+	ret0, _ := ret[0].(*models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhook indicates an expected call of UpdateWebhook
+func (mr *MockApplicationMockRecorder) UpdateWebhook(userID, webhookID, url, events, active interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockApplication)(nil).UpdateWebhook), userID, webhookID, url, events, active)
+}
+
+// DeleteWebhook mocks base method
+func (m *MockApplication) DeleteWebhook(userID, webhookID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", userID, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook
+func (mr *MockApplicationMockRecorder) DeleteWebhook(userID, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockApplication)(nil).DeleteWebhook), userID, webhookID)
+}
+
+// GetWebhookDeliveries mocks base method
+func (m *MockApplication) GetWebhookDeliveries(userID, webhookID, cursor, limit int) ([]models.WebhookDelivery, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhookDeliveries", userID, webhookID, cursor, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetWebhookDeliveries indicates an expected call of GetWebhookDeliveries
+func (mr *MockApplicationMockRecorder) GetWebhookDeliveries(userID, webhookID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveries", reflect.TypeOf((*MockApplication)(nil).GetWebhookDeliveries), userID, webhookID, cursor, limit)
+}
+
+// SendTestWebhook mocks base method
+func (m *MockApplication) SendTestWebhook(userID, webhookID int) (*models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTestWebhook", userID, webhookID)
+	ret0, _ := ret[0].(*models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendTestWebhook indicates an expected call of SendTestWebhook
+func (mr *MockApplicationMockRecorder) SendTestWebhook(userID, webhookID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTestWebhook", reflect.TypeOf((*MockApplication)(nil).SendTestWebhook), userID, webhookID)
+}
+
+// SubscribeProject mocks base method
+func (m *MockApplication) SubscribeProject(userID, projectID int) (*app.LiveMessage, <-chan app.LiveMessage, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeProject", userID, projectID)
+	ret0, _ := ret[0].(*app.LiveMessage)
+	ret1, _ := ret[1].(<-chan app.LiveMessage)
+	ret2, _ := ret[2].(func())
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// SubscribeProject indicates an expected call of SubscribeProject
+func (mr *MockApplicationMockRecorder) SubscribeProject(userID, projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeProject", reflect.TypeOf((*MockApplication)(nil).SubscribeProject), userID, projectID)
+}
+
+// CreateChatLinkCode mocks base method
+func (m *MockApplication) CreateChatLinkCode(userID int) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChatLinkCode", userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateChatLinkCode indicates an expected call of CreateChatLinkCode
+func (mr *MockApplicationMockRecorder) CreateChatLinkCode(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChatLinkCode", reflect.TypeOf((*MockApplication)(nil).CreateChatLinkCode), userID)
+}
+
+// LinkChatAccount mocks base method
+func (m *MockApplication) LinkChatAccount(code, chatUserID, chatUsername string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkChatAccount", code, chatUserID, chatUsername)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkChatAccount indicates an expected call of LinkChatAccount
+func (mr *MockApplicationMockRecorder) LinkChatAccount(code, chatUserID, chatUsername interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkChatAccount", reflect.TypeOf((*MockApplication)(nil).LinkChatAccount), code, chatUserID, chatUsername)
+}
+
+// UnlinkChatAccount mocks base method
+func (m *MockApplication) UnlinkChatAccount(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlinkChatAccount", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlinkChatAccount indicates an expected call of UnlinkChatAccount
+func (mr *MockApplicationMockRecorder) UnlinkChatAccount(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlinkChatAccount", reflect.TypeOf((*MockApplication)(nil).UnlinkChatAccount), userID)
+}
+
+// GetUserByChatAccount mocks base method
+func (m *MockApplication) GetUserByChatAccount(chatUserID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByChatAccount", chatUserID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByChatAccount indicates an expected call of GetUserByChatAccount
+func (mr *MockApplicationMockRecorder) GetUserByChatAccount(chatUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByChatAccount", reflect.TypeOf((*MockApplication)(nil).GetUserByChatAccount), chatUserID)
+}
+
+// SettleCredit mocks base method
+func (m *MockApplication) SettleCredit(donationID, userID int) (*models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleCredit", donationID, userID)
+	ret0, _ := ret[0].(*models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SettleCredit indicates an expected call of SettleCredit
+func (mr *MockApplicationMockRecorder) SettleCredit(donationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleCredit", reflect.TypeOf((*MockApplication)(nil).SettleCredit), donationID, userID)
+}
+
+// GetUserAdjustments mocks base method
+func (m *MockApplication) GetUserAdjustments(userID int) ([]models.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAdjustments", userID)
+	ret0, _ := ret[0].([]models.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAdjustments indicates an expected call of GetUserAdjustments
+func (mr *MockApplicationMockRecorder) GetUserAdjustments(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAdjustments", reflect.TypeOf((*MockApplication)(nil).GetUserAdjustments), userID)
+}
+
+// SearchTags mocks base method
+func (m *MockApplication) SearchTags(prefix string, limit int) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchTags", prefix, limit)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchTags indicates an expected call of SearchTags
+func (mr *MockApplicationMockRecorder) SearchTags(prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTags", reflect.TypeOf((*MockApplication)(nil).SearchTags), prefix, limit)
+}
+
+// GetTagStats mocks base method
+func (m *MockApplication) GetTagStats() ([]app.TagStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagStats")
+	ret0, _ := ret[0].([]app.TagStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagStats indicates an expected call of GetTagStats
+func (mr *MockApplicationMockRecorder) GetTagStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagStats", reflect.TypeOf((*MockApplication)(nil).GetTagStats))
+}
+
+// SetProjectTags mocks base method
+func (m *MockApplication) SetProjectTags(userID, projectID int, names []string) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectTags", userID, projectID, names)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectTags indicates an expected call of SetProjectTags
+func (mr *MockApplicationMockRecorder) SetProjectTags(userID, projectID, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectTags", reflect.TypeOf((*MockApplication)(nil).SetProjectTags), userID, projectID, names)
+}
+
+// CreateTag mocks base method
+func (m *MockApplication) CreateTag(userID int, name string) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", userID, name)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag
+func (mr *MockApplicationMockRecorder) CreateTag(userID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockApplication)(nil).CreateTag), userID, name)
+}
+
+// MergeTags mocks base method
+func (m *MockApplication) MergeTags(userID, sourceID, targetID int) (*models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeTags", userID, sourceID, targetID)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MergeTags indicates an expected call of MergeTags
+func (mr *MockApplicationMockRecorder) MergeTags(userID, sourceID, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeTags", reflect.TypeOf((*MockApplication)(nil).MergeTags), userID, sourceID, targetID)
+}
+
+// GetFollows mocks base method
+func (m *MockApplication) GetFollows(userID int) ([]models.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollows", userID)
+	ret0, _ := ret[0].([]models.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollows indicates an expected call of GetFollows
+func (mr *MockApplicationMockRecorder) GetFollows(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollows", reflect.TypeOf((*MockApplication)(nil).GetFollows), userID)
+}
+
+// Follow mocks base method
+func (m *MockApplication) Follow(userID int, targetType string, targetID int) (*models.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", userID, targetType, targetID)
+	ret0, _ := ret[0].(*models.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Follow indicates an expected call of Follow
+func (mr *MockApplicationMockRecorder) Follow(userID, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockApplication)(nil).Follow), userID, targetType, targetID)
+}
+
+// Unfollow mocks base method
+func (m *MockApplication) Unfollow(userID int, targetType string, targetID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", userID, targetType, targetID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow
+func (mr *MockApplicationMockRecorder) Unfollow(userID, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockApplication)(nil).Unfollow), userID, targetType, targetID)
+}
+
+// GetFeed mocks base method
+func (m *MockApplication) GetFeed(userID, cursor, limit int) ([]models.Activity, int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", userID, cursor, limit)
+	ret0, _ := ret[0].([]models.Activity)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(bool)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetFeed indicates an expected call of GetFeed
+func (mr *MockApplicationMockRecorder) GetFeed(userID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockApplication)(nil).GetFeed), userID, cursor, limit)
+}
+
+// GetProjectTiers mocks base method
+func (m *MockApplication) GetProjectTiers(projectID int) ([]models.Tier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectTiers", projectID)
+	ret0, _ := ret[0].([]models.Tier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectTiers indicates an expected call of GetProjectTiers
+func (mr *MockApplicationMockRecorder) GetProjectTiers(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectTiers", reflect.TypeOf((*MockApplication)(nil).GetProjectTiers), projectID)
+}
+
+// CreateTier mocks base method
+func (m *MockApplication) CreateTier(userID, projectID int, amount int64, quantity int, title, descr string) (*models.Tier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTier", userID, projectID, amount, quantity, title, descr)
+	ret0, _ := ret[0].(*models.Tier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTier indicates an expected call of CreateTier
+func (mr *MockApplicationMockRecorder) CreateTier(userID, projectID, amount, quantity, title, descr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTier", reflect.TypeOf((*MockApplication)(nil).CreateTier), userID, projectID, amount, quantity, title, descr)
+}
+
+// DeleteTier mocks base method
+func (m *MockApplication) DeleteTier(userID, tierID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTier", userID, tierID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTier indicates an expected call of DeleteTier
+func (mr *MockApplicationMockRecorder) DeleteTier(userID, tierID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTier", reflect.TypeOf((*MockApplication)(nil).DeleteTier), userID, tierID)
+}
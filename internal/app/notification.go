@@ -0,0 +1,69 @@
+package app
+
+import "github.com/FreakyGranny/launchpad-api/internal/models"
+
+// GetNotifications returns page of user notifications, newest first.
+func (a *App) GetNotifications(userID, cursor, limit int, onlyUnread bool) ([]models.Notification, int, bool, error) {
+	notifications, err := a.notificationModel.GetAllByUser(userID, cursor, pageFetchLimit(limit), onlyUnread)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	n, next, hasNext := cutPage(len(notifications), limit, func(i int) int { return notifications[i].ID })
+
+	return notifications[:n], next, hasNext, nil
+}
+
+// CountUnreadNotifications returns count of unread user notifications.
+func (a *App) CountUnreadNotifications(userID int) (int, error) {
+	return a.notificationModel.CountUnread(userID)
+}
+
+// MarkNotificationsRead marks given user notifications as read.
+func (a *App) MarkNotificationsRead(userID int, ids []int) error {
+	return a.notificationModel.MarkRead(userID, ids)
+}
+
+// MarkAllNotificationsRead marks all user notifications as read.
+func (a *App) MarkAllNotificationsRead(userID int) error {
+	return a.notificationModel.MarkAllRead(userID)
+}
+
+// GetEmailSettings returns email settings of user, all emails are disabled by default.
+func (a *App) GetEmailSettings(userID int) (*models.EmailSettings, error) {
+	settings, ok := a.emailSettingsModel.Get(userID)
+	if !ok {
+		return &models.EmailSettings{
+			UserID: userID,
+			Locale: defaultEmailLocale,
+			Events: []string{},
+		}, nil
+	}
+
+	return settings, nil
+}
+
+// UpdateEmailSettings replaces email settings of user.
+func (a *App) UpdateEmailSettings(userID int, locale string, digest bool, events []string) (*models.EmailSettings, error) {
+	if locale == "" {
+		locale = defaultEmailLocale
+	}
+	if events == nil {
+		events = []string{}
+	}
+	err := validateEmailSettings(locale, events)
+	if err != nil {
+		return nil, err
+	}
+	settings := &models.EmailSettings{
+		UserID: userID,
+		Locale: locale,
+		Digest: digest,
+		Events: events,
+	}
+	err = a.emailSettingsModel.Save(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
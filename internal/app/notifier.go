@@ -0,0 +1,76 @@
+package app
+
+import (
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/jonboulle/clockwork"
+	"github.com/labstack/gommon/log"
+)
+
+// Notifier delivers project events to users.
+type Notifier interface {
+	Notify(e Event)
+}
+
+// LogNotifier writes notifications to log.
+type LogNotifier struct{}
+
+// NewLogNotifier returns new log notifier.
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+// Notify writes event to log.
+func (n *LogNotifier) Notify(e Event) {
+	log.Infof("event %s of project %d for users %v", e.Type, e.ProjectID, e.UserIDs)
+}
+
+// StoreNotifier saves notifications for in-app notification center.
+type StoreNotifier struct {
+	notificationModel models.NotificationImpl
+	clock             clockwork.Clock
+}
+
+// NewStoreNotifier returns new store notifier.
+func NewStoreNotifier(mn models.NotificationImpl, clock clockwork.Clock) *StoreNotifier {
+	return &StoreNotifier{
+		notificationModel: mn,
+		clock:             clock,
+	}
+}
+
+// Notify saves notification for every addressee of event.
+func (n *StoreNotifier) Notify(e Event) {
+	if len(e.UserIDs) == 0 {
+		return
+	}
+	now := n.clock.Now()
+	notifications := make([]models.Notification, 0, len(e.UserIDs))
+	for _, userID := range e.UserIDs {
+		notifications = append(notifications, models.Notification{
+			UserID:    userID,
+			Type:      string(e.Type),
+			ProjectID: e.ProjectID,
+			Data:      e.Data,
+			CreatedAt: now,
+		})
+	}
+	err := n.notificationModel.Create(notifications)
+	if err != nil {
+		log.Errorf("unable to save %s notifications of project %d: %s", e.Type, e.ProjectID, err)
+	}
+}
+
+// MultiNotifier passes events to several notifiers.
+type MultiNotifier []Notifier
+
+// NewMultiNotifier returns notifier which passes events to all given notifiers.
+func NewMultiNotifier(notifiers ...Notifier) MultiNotifier {
+	return notifiers
+}
+
+// Notify passes event to all notifiers.
+func (n MultiNotifier) Notify(e Event) {
+	for _, notifier := range n {
+		notifier.Notify(e)
+	}
+}
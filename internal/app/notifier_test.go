@@ -0,0 +1,91 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type fakeNotifier struct {
+	events []Event
+}
+
+func (n *fakeNotifier) Notify(e Event) {
+	n.events = append(n.events, e)
+}
+
+type NotifierSuite struct {
+	suite.Suite
+	mockNotificationCtl *gomock.Controller
+	mockNotification    *mocks.MockNotificationImpl
+	clock               clockwork.FakeClock
+	notifier            *StoreNotifier
+}
+
+func (s *NotifierSuite) SetupTest() {
+	s.mockNotificationCtl = gomock.NewController(s.T())
+	s.mockNotification = mocks.NewMockNotificationImpl(s.mockNotificationCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.notifier = NewStoreNotifier(s.mockNotification, s.clock)
+}
+
+func (s *NotifierSuite) TearDownTest() {
+	s.mockNotificationCtl.Finish()
+}
+
+func (s *NotifierSuite) TestStoreNotify() {
+	data := map[string]interface{}{"title": "Pizza"}
+	s.mockNotification.EXPECT().Create([]models.Notification{
+		{UserID: 5, Type: "project_locked", ProjectID: 10, Data: data, CreatedAt: s.clock.Now()},
+		{UserID: 6, Type: "project_locked", ProjectID: 10, Data: data, CreatedAt: s.clock.Now()},
+	}).Return(nil)
+
+	s.notifier.Notify(Event{Type: EventProjectLocked, ProjectID: 10, UserIDs: []int{5, 6}, Data: data})
+}
+
+func (s *NotifierSuite) TestStoreNotifyNoUsers() {
+	s.notifier.Notify(Event{Type: EventProjectLocked, ProjectID: 10})
+}
+
+func (s *NotifierSuite) TestMultiNotify() {
+	first := &fakeNotifier{}
+	second := &fakeNotifier{}
+	e := Event{Type: EventShareChanged, ProjectID: 10, UserIDs: []int{5}}
+
+	NewMultiNotifier(first, second).Notify(e)
+	s.Require().Equal([]Event{e}, first.events)
+	s.Require().Equal([]Event{e}, second.events)
+}
+
+func (s *NotifierSuite) TestGetNotificationsPage() {
+	a := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockNotification, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+	s.mockNotification.EXPECT().GetAllByUser(5, 0, 3, false).Return([]models.Notification{
+		{ID: 9}, {ID: 8}, {ID: 7},
+	}, nil)
+
+	notifications, next, hasNext, err := a.GetNotifications(5, 0, 2, false)
+	s.Require().NoError(err)
+	s.Require().Equal([]models.Notification{{ID: 9}, {ID: 8}}, notifications)
+	s.Require().Equal(8, next)
+	s.Require().True(hasNext)
+}
+
+func (s *NotifierSuite) TestGetNotificationsLastPage() {
+	a := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockNotification, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+	s.mockNotification.EXPECT().GetAllByUser(5, 8, 3, true).Return([]models.Notification{{ID: 7}}, nil)
+
+	notifications, next, hasNext, err := a.GetNotifications(5, 8, 2, true)
+	s.Require().NoError(err)
+	s.Require().Equal([]models.Notification{{ID: 7}}, notifications)
+	s.Require().Equal(0, next)
+	s.Require().False(hasNext)
+}
+
+func TestNotifierSuite(t *testing.T) {
+	suite.Run(t, new(NotifierSuite))
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrUpdateNotFound project update with given id not found.
+	ErrUpdateNotFound = errors.New("update not found")
+	// ErrUpdateModifyNotAllowed project update modifying not allowed.
+	ErrUpdateModifyNotAllowed = errors.New("modifying forbidden")
+)
+
+const (
+	maxUpdateTitleLength = 200
+	maxUpdateTextLength  = 5000
+)
+
+// validateProjectUpdate checks title and text of project update.
+func validateProjectUpdate(title, text string) error {
+	verr := &ValidationError{}
+	if title == "" {
+		verr.add("title", CodeRequired, "title is required")
+	}
+	if utf8.RuneCountInString(title) > maxUpdateTitleLength {
+		verr.add("title", CodeMax, "title is too long")
+	}
+	if text == "" {
+		verr.add("text", CodeRequired, "text is required")
+	}
+	if utf8.RuneCountInString(text) > maxUpdateTextLength {
+		verr.add("text", CodeMax, "text is too long")
+	}
+
+	return verr.errOrNil()
+}
+
+// participants returns ids of users donated to project.
+func (a *App) participants(projectID int) ([]int, error) {
+	donations, err := a.donationModel.GetAllByProject(projectID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(donations))
+	for _, d := range donations {
+		ids = append(ids, d.UserID)
+	}
+
+	return ids, nil
+}
+
+// isParticipant checks user is owner, collaborator or participant of project.
+func (a *App) isParticipant(p *models.Project, userID int) (bool, error) {
+	if a.projectRole(p, userID) != "" {
+		return true, nil
+	}
+	ids, err := a.participants(p.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, id := range ids {
+		if id == userID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// GetProjectUpdates returns updates of project visible to viewer.
+// Updates for participants are visible only to owner, collaborators and participants.
+func (a *App) GetProjectUpdates(projectID, viewerID int) ([]models.ProjectUpdate, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, viewerID) {
+		return nil, ErrProjectNotFound
+	}
+	withPrivate, err := a.isParticipant(project, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.projectUpdateModel.GetAllByProject(projectID, withPrivate)
+}
+
+// CreateProjectUpdate posts update of published project and notifies participants.
+func (a *App) CreateProjectUpdate(userID, projectID int, title, text string, participantsOnly bool) (*models.ProjectUpdate, error) {
+	err := validateProjectUpdate(title, text)
+	if err != nil {
+		return nil, err
+	}
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if !project.Published || !a.can(project, userID, permEdit) {
+		return nil, ErrUpdateModifyNotAllowed
+	}
+	update := &models.ProjectUpdate{
+		ProjectID:        projectID,
+		Title:            title,
+		Text:             text,
+		ParticipantsOnly: participantsOnly,
+		CreatedAt:        a.clock.Now(),
+	}
+	err = a.projectUpdateModel.Create(update)
+	if err != nil {
+		return nil, err
+	}
+	recipients, err := a.participants(projectID)
+	if err != nil {
+		return nil, err
+	}
+	a.notifier.Notify(Event{
+		Type:      EventUpdatePosted,
+		ProjectID: projectID,
+		UserIDs:   recipients,
+		Data: map[string]interface{}{
+			"update":            update.ID,
+			"title":             update.Title,
+			"participants_only": update.ParticipantsOnly,
+		},
+	})
+
+	return update, nil
+}
+
+// DeleteProjectUpdate deletes update by project owner or co-owner.
+func (a *App) DeleteProjectUpdate(userID, updateID int) error {
+	update, ok := a.projectUpdateModel.Get(updateID)
+	if !ok {
+		return ErrUpdateNotFound
+	}
+	project, ok := a.projectModel.Get(update.ProjectID)
+	if !ok || !a.can(project, userID, permEdit) {
+		return ErrUpdateModifyNotAllowed
+	}
+
+	return a.projectUpdateModel.Delete(update)
+}
@@ -0,0 +1,290 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/gommon/log"
+)
+
+var (
+	// ErrInvalidRankingWindow trending window is not set while rankings are enabled.
+	ErrInvalidRankingWindow = errors.New("trending window must be positive")
+)
+
+const (
+	// weights of trending score parts
+	velocityWeight = 1.0
+	growthWeight   = 2.0
+	deadlineWeight = 1.0
+	// deadlineScale days to deadline which halve deadline part of score
+	deadlineScale = 7.0
+
+	// weights of recommendation score parts
+	typeAffinityWeight     = 1.0
+	categoryAffinityWeight = 1.0
+	trendingBonusWeight    = 0.5
+)
+
+// RankingPolicy settings of trending and recommended projects.
+type RankingPolicy struct {
+	// Window period of donations counted as recent in trending score.
+	Window time.Duration
+	// Interval how often rankings are recalculated, zero disables them.
+	Interval time.Duration
+	// Limit how many projects are recommended to every user.
+	Limit int
+}
+
+// Validate checks policy settings.
+func (p RankingPolicy) Validate() error {
+	if p.Interval > 0 && p.Window <= 0 {
+		return ErrInvalidRankingWindow
+	}
+
+	return nil
+}
+
+// trendScore weighs donation velocity, percent growth over window and time to deadline.
+// Projects without recent donations are not trending.
+func trendScore(project *models.Project, recent models.RecentDonations, window time.Duration, now time.Time) float64 {
+	if recent.Cnt == 0 {
+		return 0
+	}
+	// donations per day
+	velocity := float64(recent.Cnt) / (window.Hours() / 24)
+	// goal percent gained during window
+	var growth float64
+	switch {
+	case project.ProjectType.GoalByAmount && project.GoalAmount > 0:
+		growth = float64(recent.Amount) / float64(project.GoalAmount)
+	case project.GoalPeople > 0:
+		growth = float64(recent.Cnt) / float64(project.GoalPeople)
+	}
+	growth = math.Min(growth, 1)
+	var deadline float64
+	if !project.ReleaseDate.IsZero() && project.ReleaseDate.After(now) {
+		deadline = 1 / (1 + project.ReleaseDate.Sub(now).Hours()/24/deadlineScale)
+	}
+
+	return velocityWeight*math.Log1p(velocity) + growthWeight*growth + deadlineWeight*deadline
+}
+
+// affinity shares of user's participation by project type and category.
+type affinity struct {
+	types      map[int]float64
+	categories map[int]float64
+}
+
+// newAffinities returns shares of donations by project type and category for every donor.
+func newAffinities(rows []models.DonorAffinity) map[int]*affinity {
+	totals := make(map[int]int)
+	for _, r := range rows {
+		totals[r.UserID] += r.Cnt
+	}
+	affinities := make(map[int]*affinity, len(totals))
+	for _, r := range rows {
+		a, ok := affinities[r.UserID]
+		if !ok {
+			a = &affinity{
+				types:      make(map[int]float64),
+				categories: make(map[int]float64),
+			}
+			affinities[r.UserID] = a
+		}
+		share := float64(r.Cnt) / float64(totals[r.UserID])
+		a.types[r.ProjectTypeID] += share
+		a.categories[r.CategoryID] += share
+	}
+
+	return affinities
+}
+
+// score returns how project suits user, zero if user hasn't participated in similar projects.
+// Trending projects get bonus, trend is normalized by the highest score.
+func (a *affinity) score(project *models.Project, trend float64) float64 {
+	score := typeAffinityWeight*a.types[project.ProjectTypeID] + categoryAffinityWeight*a.categories[project.CategoryID]
+	if score == 0 {
+		return 0
+	}
+
+	return score + trendingBonusWeight*trend
+}
+
+// rankedProject project with score.
+type rankedProject struct {
+	projectID int
+	score     float64
+}
+
+// sortRanked sorts projects by score descending, newer projects go first on tie.
+func sortRanked(ranked []rankedProject) {
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+		return ranked[i].projectID > ranked[j].projectID
+	})
+}
+
+// searchProjects returns projects on search stage.
+func (b *Background) searchProjects() ([]models.Project, error) {
+	projects, err := b.projectModel.GetActiveProjects()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]models.Project, 0, len(*projects))
+	for _, p := range *projects {
+		if !p.Locked {
+			result = append(result, p)
+		}
+	}
+
+	return result, nil
+}
+
+// UpdateRankings recalculates rankings periodically until context is done.
+func (b *Background) UpdateRankings(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	if b.rankings.Interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(b.rankings.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case t := <-ticker.C:
+			b.updateRankings(t)
+		case <-ctx.Done():
+			log.Info("stop rankings")
+			return
+		}
+	}
+}
+
+// updateRankings recalculates trending projects and recommendations of every participant.
+func (b *Background) updateRankings(now time.Time) {
+	projects, err := b.searchProjects()
+	if err != nil {
+		log.Errorf("unable to get projects for rankings: %s", err)
+		return
+	}
+	trends, err := b.updateTrending(projects, now)
+	if err != nil {
+		log.Errorf("unable to update trending projects: %s", err)
+		return
+	}
+	rows, err := b.rankingModel.GetDonorAffinity()
+	if err != nil {
+		log.Errorf("unable to get participation for recommendations: %s", err)
+		return
+	}
+	joinedProjects, err := b.rankingModel.GetJoinedProjects()
+	if err != nil {
+		log.Errorf("unable to get joined projects for recommendations: %s", err)
+		return
+	}
+	joined := make(map[int]map[int]bool)
+	for _, j := range joinedProjects {
+		if joined[j.UserID] == nil {
+			joined[j.UserID] = make(map[int]bool)
+		}
+		joined[j.UserID][j.ProjectID] = true
+	}
+	affinities := newAffinities(rows)
+	for userID, a := range affinities {
+		err = b.updateRecommended(userID, a, joined[userID], projects, trends, now)
+		if err != nil {
+			log.Errorf("unable to update recommendations of user %d: %s", userID, err)
+		}
+	}
+	log.Infof("rankings of %d projects updated for %d users", len(projects), len(affinities))
+}
+
+// updateTrending saves trending projects and returns their scores normalized by the highest one.
+func (b *Background) updateTrending(projects []models.Project, now time.Time) (map[int]float64, error) {
+	recent, err := b.rankingModel.GetRecentDonations(now.Add(-b.rankings.Window))
+	if err != nil {
+		return nil, err
+	}
+	recentByProject := make(map[int]models.RecentDonations, len(recent))
+	for _, r := range recent {
+		recentByProject[r.ProjectID] = r
+	}
+	ranked := make([]rankedProject, 0, len(recent))
+	for i := range projects {
+		score := trendScore(&projects[i], recentByProject[projects[i].ID], b.rankings.Window, now)
+		if score > 0 {
+			ranked = append(ranked, rankedProject{projectID: projects[i].ID, score: score})
+		}
+	}
+	sortRanked(ranked)
+	trending := make([]models.TrendingProject, 0, len(ranked))
+	trends := make(map[int]float64, len(ranked))
+	for _, r := range ranked {
+		trending = append(trending, models.TrendingProject{ProjectID: r.projectID, Score: r.score, UpdatedAt: now})
+		trends[r.projectID] = r.score / ranked[0].score
+	}
+
+	return trends, b.rankingModel.SaveTrending(trending)
+}
+
+// updateRecommended saves projects on search stage similar to ones user participated in.
+// Own projects and projects user already participates in are not recommended.
+func (b *Background) updateRecommended(userID int, a *affinity, joined map[int]bool, projects []models.Project, trends map[int]float64, now time.Time) error {
+	ranked := make([]rankedProject, 0)
+	for i := range projects {
+		project := &projects[i]
+		if project.OwnerID == userID || joined[project.ID] {
+			continue
+		}
+		score := a.score(project, trends[project.ID])
+		if score > 0 {
+			ranked = append(ranked, rankedProject{projectID: project.ID, score: score})
+		}
+	}
+	sortRanked(ranked)
+	if len(ranked) > b.rankings.Limit {
+		ranked = ranked[:b.rankings.Limit]
+	}
+	recommended := make([]models.RecommendedProject, 0, len(ranked))
+	for _, r := range ranked {
+		recommended = append(recommended, models.RecommendedProject{
+			UserID:    userID,
+			ProjectID: r.projectID,
+			Score:     r.score,
+			UpdatedAt: now,
+		})
+	}
+
+	return b.rankingModel.SaveRecommended(userID, recommended)
+}
+
+// GetTrendingProjects returns projects on search stage which gain donations the fastest.
+func (a *App) GetTrendingProjects(limit int) ([]*ExtendedProject, error) {
+	projects, err := a.rankingModel.GetTrending(limit)
+	if err != nil {
+		return nil, ErrProjectRetrieve
+	}
+
+	return a.extendProjectList(projects)
+}
+
+// GetRecommendedProjects returns projects recommended to user,
+// trending projects are returned if there is nothing to recommend.
+func (a *App) GetRecommendedProjects(userID, limit int) ([]*ExtendedProject, error) {
+	projects, err := a.rankingModel.GetRecommended(userID, limit)
+	if err != nil {
+		return nil, ErrProjectRetrieve
+	}
+	if len(*projects) == 0 {
+		return a.GetTrendingProjects(limit)
+	}
+
+	return a.extendProjectList(projects)
+}
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type RankingSuite struct {
+	suite.Suite
+	mockProjectCtl  *gomock.Controller
+	mockProject     *mocks.MockProjectImpl
+	mockDonationCtl *gomock.Controller
+	mockDonation    *mocks.MockDonationImpl
+	mockUserCtl     *gomock.Controller
+	mockUser        *mocks.MockUserImpl
+	mockRankingCtl  *gomock.Controller
+	mockRanking     *mocks.MockRankingImpl
+	now             time.Time
+	policy          RankingPolicy
+}
+
+func (s *RankingSuite) SetupTest() {
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockDonationCtl = gomock.NewController(s.T())
+	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockRankingCtl = gomock.NewController(s.T())
+	s.mockRanking = mocks.NewMockRankingImpl(s.mockRankingCtl)
+	s.now = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	s.policy = RankingPolicy{
+		Window:   7 * 24 * time.Hour,
+		Interval: time.Hour,
+		Limit:    1,
+	}
+}
+
+func (s *RankingSuite) TearDownTest() {
+	s.mockProjectCtl.Finish()
+	s.mockDonationCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockRankingCtl.Finish()
+}
+
+func (s *RankingSuite) background() *Background {
+	return NewBackground(nil, s.mockProject, s.mockUser, s.mockDonation, nil, s.mockRanking, &fakeNotifier{}, nil, nil, 0, ReminderPolicy{}, s.policy, clockwork.NewFakeClock())
+}
+
+func (s *RankingSuite) moneyProject(id, category, owner int, release time.Time) models.Project {
+	return models.Project{
+		ID:            id,
+		OwnerID:       owner,
+		CategoryID:    category,
+		ProjectTypeID: 1,
+		ProjectType:   models.ProjectType{ID: 1, GoalByAmount: true, EndByGoalGain: true},
+		GoalAmount:    1000,
+		ReleaseDate:   release,
+		Published:     true,
+	}
+}
+
+func (s *RankingSuite) peopleProject(id, category, owner int, release time.Time) models.Project {
+	return models.Project{
+		ID:            id,
+		OwnerID:       owner,
+		CategoryID:    category,
+		ProjectTypeID: 2,
+		ProjectType:   models.ProjectType{ID: 2, GoalByPeople: true, EndByGoalGain: true},
+		GoalPeople:    10,
+		ReleaseDate:   release,
+		Published:     true,
+	}
+}
+
+func (s *RankingSuite) TestTrendScore() {
+	window := 7 * 24 * time.Hour
+	soon := s.moneyProject(1, 1, 10, s.now.Add(3*24*time.Hour))
+	late := s.moneyProject(2, 1, 10, s.now.Add(60*24*time.Hour))
+	recent := models.RecentDonations{Cnt: 7, Amount: 500}
+
+	s.Require().Zero(trendScore(&soon, models.RecentDonations{}, window, s.now))
+	s.Require().Greater(trendScore(&soon, recent, window, s.now), trendScore(&late, recent, window, s.now))
+	s.Require().Greater(
+		trendScore(&late, models.RecentDonations{Cnt: 7, Amount: 900}, window, s.now),
+		trendScore(&late, recent, window, s.now),
+	)
+	// growth can't exceed whole goal
+	s.Require().Equal(
+		trendScore(&late, models.RecentDonations{Cnt: 7, Amount: 1000}, window, s.now),
+		trendScore(&late, models.RecentDonations{Cnt: 7, Amount: 5000}, window, s.now),
+	)
+}
+
+func (s *RankingSuite) TestNewAffinities() {
+	affinities := newAffinities([]models.DonorAffinity{
+		{UserID: 5, ProjectTypeID: 1, CategoryID: 1, Cnt: 1},
+		{UserID: 5, ProjectTypeID: 1, CategoryID: 2, Cnt: 2},
+		{UserID: 5, ProjectTypeID: 2, CategoryID: 2, Cnt: 1},
+		{UserID: 7, ProjectTypeID: 2, CategoryID: 1, Cnt: 3},
+	})
+	s.Require().Len(affinities, 2)
+	s.Require().Equal(map[int]float64{1: 0.75, 2: 0.25}, affinities[5].types)
+	s.Require().Equal(map[int]float64{1: 0.25, 2: 0.75}, affinities[5].categories)
+	s.Require().Equal(map[int]float64{2: 1}, affinities[7].types)
+	s.Require().Equal(map[int]float64{1: 1}, affinities[7].categories)
+}
+
+func (s *RankingSuite) TestUpdateRankings() {
+	locked := s.moneyProject(3, 1, 10, s.now.Add(24*time.Hour))
+	locked.Locked = true
+	projects := []models.Project{
+		s.moneyProject(1, 1, 10, s.now.Add(3*24*time.Hour)),
+		s.peopleProject(2, 2, 11, s.now.Add(30*24*time.Hour)),
+		locked,
+		s.moneyProject(4, 2, 5, s.now.Add(24*time.Hour)),
+		s.peopleProject(5, 1, 11, s.now.Add(24*time.Hour)),
+	}
+	s.mockProject.EXPECT().GetActiveProjects().Return(&projects, nil)
+	s.mockRanking.EXPECT().GetRecentDonations(s.now.Add(-s.policy.Window)).Return([]models.RecentDonations{
+		{ProjectID: 1, Cnt: 7, Amount: 500},
+		{ProjectID: 2, Cnt: 1, Amount: 0},
+		{ProjectID: 3, Cnt: 5, Amount: 900},
+	}, nil)
+	s.mockRanking.EXPECT().SaveTrending(gomock.Any()).DoAndReturn(func(rankings []models.TrendingProject) error {
+		s.Require().Len(rankings, 2)
+		s.Require().Equal(1, rankings[0].ProjectID)
+		s.Require().Equal(2, rankings[1].ProjectID)
+		s.Require().Greater(rankings[0].Score, rankings[1].Score)
+		return nil
+	})
+	s.mockRanking.EXPECT().GetDonorAffinity().Return([]models.DonorAffinity{
+		{UserID: 5, ProjectTypeID: 1, CategoryID: 1, Cnt: 1},
+		{UserID: 5, ProjectTypeID: 2, CategoryID: 1, Cnt: 1},
+	}, nil)
+	s.mockRanking.EXPECT().GetJoinedProjects().Return([]models.JoinedProject{{UserID: 5, ProjectID: 2}}, nil)
+	// own and joined projects are skipped, trending project wins the tie by type and category
+	s.mockRanking.EXPECT().SaveRecommended(5, []models.RecommendedProject{
+		{UserID: 5, ProjectID: 1, Score: 2, UpdatedAt: s.now},
+	}).Return(nil)
+
+	s.background().updateRankings(s.now)
+}
+
+func (s *RankingSuite) TestRecommendedFallback() {
+	a := New(nil, nil, s.mockProject, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockRanking, nil, nil, nil, nil, nil, nil, nil, nil, "", nil)
+	trending := []models.Project{s.moneyProject(1, 1, 10, s.now)}
+	s.mockRanking.EXPECT().GetRecommended(5, 10).Return(&[]models.Project{}, nil)
+	s.mockRanking.EXPECT().GetTrending(10).Return(&trending, nil)
+
+	projects, err := a.GetRecommendedProjects(5, 10)
+	s.Require().NoError(err)
+	s.Require().Len(projects, 1)
+	s.Require().Equal(1, projects[0].ID)
+}
+
+func (s *RankingSuite) TestRankingPolicyValidate() {
+	s.Require().NoError(s.policy.Validate())
+	s.Require().NoError(RankingPolicy{}.Validate())
+	s.Require().Equal(ErrInvalidRankingWindow, RankingPolicy{Interval: time.Hour}.Validate())
+}
+
+func TestRankingSuite(t *testing.T) {
+	suite.Run(t, new(RankingSuite))
+}
@@ -0,0 +1,199 @@
+package app
+
+import (
+	"errors"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/gommon/log"
+)
+
+var (
+	// ErrUnknownGraceAction action for overdue harvest is not supported.
+	ErrUnknownGraceAction = errors.New("unknown grace action")
+)
+
+const (
+	// GraceActionFail overdue project is closed as failed.
+	GraceActionFail = "fail"
+	// GraceActionEscalate owner and admins are notified about overdue project.
+	GraceActionEscalate = "escalate"
+)
+
+// ReminderPolicy cadence of payment reminders and deadline of harvest stage.
+type ReminderPolicy struct {
+	// Delays since project lock when unpaid participants are reminded.
+	Delays []time.Duration
+	// CheckInterval how often harvest projects are checked, zero disables periodic checks.
+	CheckInterval time.Duration
+	// Grace how long project may stay on harvest stage, zero disables deadline.
+	Grace time.Duration
+	// GraceAction what to do with overdue project.
+	GraceAction string
+}
+
+// Validate checks policy settings.
+func (p ReminderPolicy) Validate() error {
+	switch p.GraceAction {
+	case GraceActionFail, GraceActionEscalate:
+		return nil
+	default:
+		return ErrUnknownGraceAction
+	}
+}
+
+// level returns number of reminders which should be sent by now.
+func (p ReminderPolicy) level(lockedAt, now time.Time) int {
+	level := 0
+	for _, d := range p.Delays {
+		if !now.Before(lockedAt.Add(d)) {
+			level++
+		}
+	}
+
+	return level
+}
+
+// deadline returns end of grace period or zero time if deadline is disabled.
+func (p ReminderPolicy) deadline(lockedAt time.Time) time.Time {
+	if p.Grace == 0 {
+		return time.Time{}
+	}
+
+	return lockedAt.Add(p.Grace)
+}
+
+// remindPayments reminds unpaid participants of harvest project on policy cadence
+// and sends summary to owner. Returns true if project is failed after grace period.
+func (b *Background) remindPayments(strategy Strategy, project *models.Project, now time.Time) bool {
+	if !project.Locked || project.Closed || project.LockedAt.IsZero() {
+		return false
+	}
+	donations, err := b.donationModel.GetAllByProject(project.ID)
+	if err != nil {
+		log.Errorf("unable to get donations of project %d", project.ID)
+		return false
+	}
+	unpaid := make([]*models.Donation, 0, len(donations))
+	paid := 0
+	var outstanding int64
+	for i := range donations {
+		d := &donations[i]
+		// owner's own share is neither reminded nor counted in summary
+		if d.UserID == project.OwnerID {
+			continue
+		}
+		if d.Paid || d.Payment == 0 {
+			paid++
+			continue
+		}
+		unpaid = append(unpaid, d)
+		outstanding += d.Payment - d.PaidAmount
+	}
+	if len(unpaid) == 0 {
+		return false
+	}
+	deadline := b.reminders.deadline(project.LockedAt)
+	if !deadline.IsZero() && !now.Before(deadline) {
+		return b.harvestOverdue(strategy, project, len(unpaid), outstanding, now)
+	}
+
+	level := b.reminders.level(project.LockedAt, now)
+	reminded := 0
+	for _, d := range unpaid {
+		if level <= d.ReminderCount {
+			continue
+		}
+		d.ReminderCount = level
+		d.RemindedAt = now
+		err = b.donationModel.SetReminded(d)
+		if err != nil {
+			log.Errorf("unable to save reminder of donation %d: %s", d.ID, err)
+			continue
+		}
+		data := map[string]interface{}{
+			"title":    project.Title,
+			"amount":   d.Payment - d.PaidAmount,
+			"currency": project.Currency,
+			"reminder": level,
+			"final":    level == len(b.reminders.Delays),
+		}
+		if !deadline.IsZero() {
+			data["deadline"] = deadline.Format(DateTimeLayout)
+		}
+		b.notifier.Notify(Event{
+			Type:      EventPaymentDue,
+			ProjectID: project.ID,
+			UserIDs:   []int{d.UserID},
+			Data:      data,
+		})
+		reminded++
+	}
+	if reminded > 0 {
+		b.notifier.Notify(Event{
+			Type:      EventPaymentSummary,
+			ProjectID: project.ID,
+			UserIDs:   []int{project.OwnerID},
+			Data: map[string]interface{}{
+				"title":    project.Title,
+				"paid":     paid,
+				"unpaid":   len(unpaid),
+				"reminded": reminded,
+				"amount":   outstanding,
+				"currency": project.Currency,
+			},
+		})
+	}
+
+	return false
+}
+
+// harvestOverdue applies grace action to project which stays on harvest stage too long.
+// Returns true if project is failed.
+func (b *Background) harvestOverdue(strategy Strategy, project *models.Project, unpaid int, outstanding int64, now time.Time) bool {
+	if b.reminders.GraceAction == GraceActionFail {
+		err := b.projectModel.Fail(project)
+		if err != nil {
+			log.Errorf("unable to fail overdue project %d: %s", project.ID, err)
+			return false
+		}
+		log.Infof("overdue project %d is failed", project.ID)
+		b.notifier.Notify(progressEvent(strategy, project))
+		b.notifyProject(EventProjectFailed, project)
+
+		return true
+	}
+	if !project.EscalatedAt.IsZero() {
+		return false
+	}
+	admins, err := b.userModel.GetAdmins()
+	if err != nil {
+		log.Errorf("unable to get admins: %s", err)
+		return false
+	}
+	err = b.projectModel.Escalate(project, now)
+	if err != nil {
+		log.Errorf("unable to escalate overdue project %d: %s", project.ID, err)
+		return false
+	}
+	userIDs := make([]int, 0, len(admins)+1)
+	userIDs = append(userIDs, project.OwnerID)
+	for _, admin := range admins {
+		if admin.ID != project.OwnerID {
+			userIDs = append(userIDs, admin.ID)
+		}
+	}
+	b.notifier.Notify(Event{
+		Type:      EventHarvestOverdue,
+		ProjectID: project.ID,
+		UserIDs:   userIDs,
+		Data: map[string]interface{}{
+			"title":    project.Title,
+			"unpaid":   unpaid,
+			"amount":   outstanding,
+			"currency": project.Currency,
+		},
+	})
+
+	return false
+}
@@ -0,0 +1,216 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ReminderSuite struct {
+	suite.Suite
+	mockProjectCtl  *gomock.Controller
+	mockProject     *mocks.MockProjectImpl
+	mockDonationCtl *gomock.Controller
+	mockDonation    *mocks.MockDonationImpl
+	mockUserCtl     *gomock.Controller
+	mockUser        *mocks.MockUserImpl
+	notifier        *fakeNotifier
+	clock           clockwork.FakeClock
+	lockedAt        time.Time
+	policy          ReminderPolicy
+}
+
+func (s *ReminderSuite) SetupTest() {
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockDonationCtl = gomock.NewController(s.T())
+	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.notifier = &fakeNotifier{}
+	s.lockedAt = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	s.clock = clockwork.NewFakeClockAt(s.lockedAt.Add(25 * time.Hour))
+	s.policy = ReminderPolicy{
+		Delays:      []time.Duration{24 * time.Hour, 72 * time.Hour},
+		Grace:       168 * time.Hour,
+		GraceAction: GraceActionEscalate,
+	}
+}
+
+func (s *ReminderSuite) TearDownTest() {
+	s.mockProjectCtl.Finish()
+	s.mockDonationCtl.Finish()
+	s.mockUserCtl.Finish()
+}
+
+func (s *ReminderSuite) background() *Background {
+	return NewBackground(nil, s.mockProject, s.mockUser, s.mockDonation, nil, nil, s.notifier, nil, nil, 0, s.policy, RankingPolicy{}, s.clock)
+}
+
+func (s *ReminderSuite) project() *models.Project {
+	return &models.Project{
+		ID:        1,
+		Title:     "Pizza",
+		OwnerID:   10,
+		Currency:  "RUB",
+		Published: true,
+		Locked:    true,
+		LockedAt:  s.lockedAt,
+	}
+}
+
+func (s *ReminderSuite) TestValidate() {
+	s.Require().NoError(s.policy.Validate())
+	s.policy.GraceAction = "ignore"
+	s.Require().Equal(ErrUnknownGraceAction, s.policy.Validate())
+}
+
+func (s *ReminderSuite) TestLevel() {
+	s.Require().Equal(0, s.policy.level(s.lockedAt, s.lockedAt.Add(time.Hour)))
+	s.Require().Equal(1, s.policy.level(s.lockedAt, s.lockedAt.Add(24*time.Hour)))
+	s.Require().Equal(2, s.policy.level(s.lockedAt, s.lockedAt.Add(100*time.Hour)))
+}
+
+func (s *ReminderSuite) TestRemind() {
+	project := s.project()
+	donations := []models.Donation{
+		{ID: 1, UserID: 11, Payment: 1000, Paid: true},
+		{ID: 2, UserID: 12, Payment: 1000, PaidAmount: 400},
+		{ID: 3, UserID: 13, Payment: 1000, ReminderCount: 1},
+	}
+	now := s.lockedAt.Add(25 * time.Hour)
+	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil)
+	s.mockDonation.EXPECT().SetReminded(&models.Donation{ID: 2, UserID: 12, Payment: 1000, PaidAmount: 400, ReminderCount: 1, RemindedAt: now}).Return(nil)
+
+	s.Require().False(s.background().remindPayments(NewMoneyStrategy(s.mockProject), project, now))
+	s.Require().Equal([]Event{
+		{
+			Type:      EventPaymentDue,
+			ProjectID: 1,
+			UserIDs:   []int{12},
+			Data: map[string]interface{}{
+				"title":    "Pizza",
+				"amount":   int64(600),
+				"currency": "RUB",
+				"reminder": 1,
+				"final":    false,
+				"deadline": "2020-10-08 12:00:00",
+			},
+		},
+		{
+			Type:      EventPaymentSummary,
+			ProjectID: 1,
+			UserIDs:   []int{10},
+			Data: map[string]interface{}{
+				"title":    "Pizza",
+				"paid":     1,
+				"unpaid":   2,
+				"reminded": 1,
+				"amount":   int64(1600),
+				"currency": "RUB",
+			},
+		},
+	}, s.notifier.events)
+}
+
+func (s *ReminderSuite) TestRemindSkipsOwner() {
+	project := s.project()
+	donations := []models.Donation{
+		{ID: 1, UserID: 10, Payment: 1000},
+		{ID: 2, UserID: 12, Payment: 1000},
+	}
+	now := s.lockedAt.Add(25 * time.Hour)
+	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil)
+	s.mockDonation.EXPECT().SetReminded(&models.Donation{ID: 2, UserID: 12, Payment: 1000, ReminderCount: 1, RemindedAt: now}).Return(nil)
+
+	s.Require().False(s.background().remindPayments(NewMoneyStrategy(s.mockProject), project, now))
+	s.Require().Len(s.notifier.events, 2)
+	s.Require().Equal([]int{12}, s.notifier.events[0].UserIDs)
+	s.Require().Equal(0, s.notifier.events[1].Data["paid"])
+	s.Require().Equal(1, s.notifier.events[1].Data["unpaid"])
+	s.Require().Equal(int64(1000), s.notifier.events[1].Data["amount"])
+}
+
+func (s *ReminderSuite) TestHarvestCheckUsesClock() {
+	project := s.project()
+	project.ProjectType = models.ProjectType{GoalByAmount: true, EndByGoalGain: true}
+	s.mockProject.EXPECT().CheckForPaid(1).Return(false, nil)
+	s.mockDonation.EXPECT().GetAllByProject(1).Return([]models.Donation{{ID: 2, UserID: 12, Payment: 1000}}, nil)
+	s.mockDonation.EXPECT().SetReminded(&models.Donation{ID: 2, UserID: 12, Payment: 1000, ReminderCount: 1, RemindedAt: s.clock.Now()}).Return(nil)
+
+	b := s.background()
+	b.harverstChan <- project
+	close(b.harverstChan)
+	b.wg.Add(1)
+	b.HarvestCheck(b.wg)
+	s.Require().Len(s.notifier.events, 2)
+}
+
+func (s *ReminderSuite) TestRemindNotDue() {
+	project := s.project()
+	donations := []models.Donation{{ID: 2, UserID: 12, Payment: 1000}}
+	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil)
+
+	s.Require().False(s.background().remindPayments(NewMoneyStrategy(s.mockProject), project, s.lockedAt.Add(time.Hour)))
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *ReminderSuite) TestEscalate() {
+	project := s.project()
+	donations := []models.Donation{{ID: 2, UserID: 12, Payment: 1000}}
+	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil)
+	s.mockUser.EXPECT().GetAdmins().Return([]models.User{{ID: 10}, {ID: 20}}, nil)
+	s.mockProject.EXPECT().Escalate(project, s.lockedAt.Add(200*time.Hour)).Return(nil)
+
+	s.Require().False(s.background().remindPayments(NewMoneyStrategy(s.mockProject), project, s.lockedAt.Add(200*time.Hour)))
+	s.Require().Equal([]Event{{
+		Type:      EventHarvestOverdue,
+		ProjectID: 1,
+		UserIDs:   []int{10, 20},
+		Data: map[string]interface{}{
+			"title":    "Pizza",
+			"unpaid":   1,
+			"amount":   int64(1000),
+			"currency": "RUB",
+		},
+	}}, s.notifier.events)
+}
+
+func (s *ReminderSuite) TestEscalateOnce() {
+	project := s.project()
+	project.EscalatedAt = s.lockedAt.Add(170 * time.Hour)
+	donations := []models.Donation{{ID: 2, UserID: 12, Payment: 1000}}
+	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil)
+
+	s.Require().False(s.background().remindPayments(NewMoneyStrategy(s.mockProject), project, s.lockedAt.Add(200*time.Hour)))
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *ReminderSuite) TestFail() {
+	s.policy.GraceAction = GraceActionFail
+	project := s.project()
+	donations := []models.Donation{{ID: 2, UserID: 12, Payment: 1000}}
+	s.mockDonation.EXPECT().GetAllByProject(1).Return(donations, nil).Times(2)
+	s.mockProject.EXPECT().Fail(project).DoAndReturn(func(p *models.Project) error {
+		p.Locked = false
+		p.Closed = true
+		return nil
+	})
+
+	s.Require().True(s.background().remindPayments(NewMoneyStrategy(s.mockProject), project, s.lockedAt.Add(200*time.Hour)))
+	s.Require().Len(s.notifier.events, 2)
+	s.Require().Equal(EventProjectProgress, s.notifier.events[0].Type)
+	s.Require().Equal(models.StatusFail, s.notifier.events[0].Data["status"])
+	s.Require().Equal(EventProjectFailed, s.notifier.events[1].Type)
+	s.Require().Equal([]int{10, 12}, s.notifier.events[1].UserIDs)
+}
+
+func TestReminderSuite(t *testing.T) {
+	suite.Run(t, new(ReminderSuite))
+}
@@ -0,0 +1,232 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	// maxReviewCommentLength limit of moderator comment length in characters.
+	maxReviewCommentLength = 1000
+	// publishCheckInterval how often scheduled drafts are published.
+	publishCheckInterval = time.Minute
+)
+
+// requiresReview checks project in category must be approved before publication.
+func requiresReview(p *models.Project, moderated bool) bool {
+	return moderated && p.ReviewStatus != models.ReviewApproved
+}
+
+// submitForReview puts project to moderation queue and notifies admins.
+func submitForReview(pm models.ProjectImpl, um models.UserImpl, n Notifier, p *models.Project) error {
+	p.ReviewStatus = models.ReviewPending
+	p.ReviewComment = ""
+	p.ReviewedAt = time.Time{}
+	err := pm.UpdateReview(p)
+	if err != nil {
+		return err
+	}
+	admins, err := um.GetAdmins()
+	if err != nil {
+		return err
+	}
+	userIDs := make([]int, 0, len(admins))
+	for _, admin := range admins {
+		userIDs = append(userIDs, admin.ID)
+	}
+	n.Notify(Event{
+		Type:      EventReviewRequested,
+		ProjectID: p.ID,
+		UserIDs:   userIDs,
+		Data:      map[string]interface{}{"title": p.Title},
+	})
+
+	return nil
+}
+
+// publishProject makes draft public and announces it.
+func publishProject(pm models.ProjectImpl, n Notifier, p *models.Project) error {
+	err := pm.Publish(p)
+	if err != nil {
+		return err
+	}
+	n.Notify(Event{
+		Type:      EventProjectPublished,
+		ProjectID: p.ID,
+		Data:      map[string]interface{}{"title": p.Title},
+	})
+
+	return nil
+}
+
+// isModeratedCategory checks projects of category need approval.
+// Category relation of project is used when category isn't changed.
+func (a *App) isModeratedCategory(p *models.Project, categoryID int) bool {
+	if categoryID == 0 || categoryID == p.CategoryID {
+		return p.Category.Moderated
+	}
+	category, ok := a.categoryModel.Get(categoryID)
+
+	return ok && category.Moderated
+}
+
+// SchedulePublication sets time when draft is published by background worker, empty time cancels schedule.
+// Drafts of moderated categories are submitted to review right away.
+func (a *App) SchedulePublication(userID, projectID int, publishAt time.Time) (*ExtendedProject, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if !a.can(project, userID, permEdit) {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	if project.Published {
+		return nil, ErrProjectWrongStatus
+	}
+	if !publishAt.IsZero() && !publishAt.After(a.clock.Now()) {
+		verr := &ValidationError{}
+		verr.add("publish_at", CodeMin, "publication time must be in the future")
+		return nil, verr.errOrNil()
+	}
+	project.PublishAt = publishAt
+	err := a.projectModel.SchedulePublication(project)
+	if err != nil {
+		return nil, err
+	}
+	if !publishAt.IsZero() && requiresReview(project, project.Category.Moderated) && project.ReviewStatus != models.ReviewPending {
+		err = submitForReview(a.projectModel, a.userModel, a.notifier, project)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.extendProject(project)
+}
+
+// GetReviewQueue returns drafts waiting for approval, only admins moderate projects.
+func (a *App) GetReviewQueue(userID int) ([]*ExtendedProject, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	projects, err := a.projectModel.GetPendingReview()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.extendProjectList(projects)
+}
+
+// reviewProject checks moderator and comment and returns project waiting for review.
+func (a *App) reviewProject(userID, projectID int, comment string, commentRequired bool) (*models.Project, string, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, "", err
+	}
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, "", ErrProjectNotFound
+	}
+	if project.Published || project.ReviewStatus != models.ReviewPending {
+		return nil, "", ErrProjectWrongStatus
+	}
+	comment = strings.TrimSpace(comment)
+	verr := &ValidationError{}
+	if commentRequired && comment == "" {
+		verr.add("comment", CodeRequired, "comment is required")
+	}
+	if utf8.RuneCountInString(comment) > maxReviewCommentLength {
+		verr.add("comment", CodeMax, fmt.Sprintf("comment must be at most %d characters", maxReviewCommentLength))
+	}
+
+	return project, comment, verr.errOrNil()
+}
+
+// ApproveProject approves draft waiting for review.
+// Project is published right away unless its publication is scheduled later.
+func (a *App) ApproveProject(userID, projectID int, comment string) (*ExtendedProject, error) {
+	project, comment, err := a.reviewProject(userID, projectID, comment, false)
+	if err != nil {
+		return nil, err
+	}
+	now := a.clock.Now()
+	project.ReviewStatus = models.ReviewApproved
+	project.ReviewComment = comment
+	project.ReviewedAt = now
+	err = a.projectModel.UpdateReview(project)
+	if err != nil {
+		return nil, err
+	}
+	a.notifier.Notify(Event{
+		Type:      EventProjectApproved,
+		ProjectID: projectID,
+		UserIDs:   []int{project.OwnerID},
+		Data: map[string]interface{}{
+			"title":   project.Title,
+			"comment": comment,
+		},
+	})
+	if project.PublishAt.IsZero() || !project.PublishAt.After(now) {
+		err = publishProject(a.projectModel, a.notifier, project)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.extendProject(project)
+}
+
+// RejectProject returns draft waiting for review to owner with comment.
+func (a *App) RejectProject(userID, projectID int, comment string) (*ExtendedProject, error) {
+	project, comment, err := a.reviewProject(userID, projectID, comment, true)
+	if err != nil {
+		return nil, err
+	}
+	project.ReviewStatus = models.ReviewRejected
+	project.ReviewComment = comment
+	project.ReviewedAt = a.clock.Now()
+	err = a.projectModel.UpdateReview(project)
+	if err != nil {
+		return nil, err
+	}
+	a.notifier.Notify(Event{
+		Type:      EventProjectRejected,
+		ProjectID: projectID,
+		UserIDs:   []int{project.OwnerID},
+		Data: map[string]interface{}{
+			"title":   project.Title,
+			"comment": comment,
+		},
+	})
+
+	return a.extendProject(project)
+}
+
+// publishScheduled publishes drafts due to publication,
+// drafts of moderated categories without approval are submitted to review instead.
+func (b *Background) publishScheduled(now time.Time) {
+	projects, err := b.projectModel.GetScheduledProjects(now)
+	if err != nil {
+		log.Errorf("unable to get scheduled projects: %s", err)
+		return
+	}
+	for i := range *projects {
+		project := &(*projects)[i]
+		if requiresReview(project, project.Category.Moderated) {
+			err = submitForReview(b.projectModel, b.userModel, b.notifier, project)
+			if err != nil {
+				log.Errorf("unable to submit project %d to review: %s", project.ID, err)
+			}
+			continue
+		}
+		err = publishProject(b.projectModel, b.notifier, project)
+		if err != nil {
+			log.Errorf("unable to publish project %d: %s", project.ID, err)
+		}
+	}
+}
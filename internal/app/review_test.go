@@ -0,0 +1,280 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ReviewSuite struct {
+	suite.Suite
+	mockCategoryCtl     *gomock.Controller
+	mockCategory        *mocks.MockCategoryImpl
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockRevisionCtl     *gomock.Controller
+	mockRevision        *mocks.MockRevisionImpl
+	mockUserCtl         *gomock.Controller
+	mockUser            *mocks.MockUserImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	notifier            *fakeNotifier
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *ReviewSuite) SetupTest() {
+	s.mockCategoryCtl = gomock.NewController(s.T())
+	s.mockCategory = mocks.NewMockCategoryImpl(s.mockCategoryCtl)
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockRevisionCtl = gomock.NewController(s.T())
+	s.mockRevision = mocks.NewMockRevisionImpl(s.mockRevisionCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.notifier = &fakeNotifier{}
+	s.clock = clockwork.NewFakeClockAt(time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC))
+	s.app = New(s.mockCategory, s.mockUser, s.mockProject, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, s.mockRevision, nil, nil, s.notifier, s.clock, "", nil)
+}
+
+func (s *ReviewSuite) TearDownTest() {
+	s.mockCategoryCtl.Finish()
+	s.mockCollaboratorCtl.Finish()
+	s.mockRevisionCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockProjectCtl.Finish()
+}
+
+func (s *ReviewSuite) draft() *models.Project {
+	return &models.Project{
+		ID:         10,
+		OwnerID:    42,
+		Title:      "Pizza",
+		CategoryID: 3,
+		Category:   models.Category{ID: 3, Moderated: true},
+		ProjectType: models.ProjectType{
+			GoalByAmount:  true,
+			EndByGoalGain: true,
+		},
+	}
+}
+
+func (s *ReviewSuite) expectAdmin(id int) {
+	s.mockUser.EXPECT().Get(id).Return(&models.User{ID: id, IsAdmin: true}, true)
+}
+
+func (s *ReviewSuite) TestPublishModeratedProject() {
+	project := s.draft()
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().Update(project).Return(nil)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+	s.mockUser.EXPECT().GetAdmins().Return([]models.User{{ID: 1}}, nil)
+
+	expectFirstRevision(s.mockRevision)
+	result, err := s.app.UpdateProject(10, 42, 0, 0, 0, 0, "", "Pizza", "", "", "", "", time.Time{}, time.Time{}, nil, true, false)
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusDraft, result.Status)
+	s.Require().Equal(models.ReviewPending, result.ReviewStatus)
+	s.Require().Equal([]Event{{
+		Type:      EventReviewRequested,
+		ProjectID: 10,
+		UserIDs:   []int{1},
+		Data:      map[string]interface{}{"title": "Pizza"},
+	}}, s.notifier.events)
+}
+
+func (s *ReviewSuite) TestPublishToModeratedCategory() {
+	project := s.draft()
+	project.CategoryID = 2
+	project.Category = models.Category{ID: 2}
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockCategory.EXPECT().Get(3).Return(&models.Category{ID: 3, Moderated: true}, true)
+	s.mockProject.EXPECT().Update(project).Return(nil)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+	s.mockUser.EXPECT().GetAdmins().Return(nil, nil)
+
+	expectFirstRevision(s.mockRevision)
+	result, err := s.app.UpdateProject(10, 42, 0, 0, 3, 0, "", "Pizza", "", "", "", "", time.Time{}, time.Time{}, nil, true, false)
+	s.Require().NoError(err)
+	s.Require().False(project.Published)
+	s.Require().Equal(models.ReviewPending, result.ReviewStatus)
+}
+
+func (s *ReviewSuite) TestEditApprovedDraft() {
+	project := s.draft()
+	project.ReviewStatus = models.ReviewApproved
+	project.PublishAt = time.Date(2020, 10, 9, 0, 0, 0, 0, time.UTC)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().Update(project).Return(nil)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+
+	expectFirstRevision(s.mockRevision)
+	result, err := s.app.UpdateProject(10, 42, 0, 0, 0, 0, "", "Big pizza", "", "", "", "", time.Time{}, time.Time{}, nil, false, false)
+	s.Require().NoError(err)
+	s.Require().Equal("", result.ReviewStatus)
+}
+
+func (s *ReviewSuite) TestSchedulePublication() {
+	project := s.draft()
+	project.Category.Moderated = false
+	publishAt := time.Date(2020, 10, 9, 10, 0, 0, 0, time.UTC)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().SchedulePublication(project).Return(nil)
+
+	result, err := s.app.SchedulePublication(42, 10, publishAt)
+	s.Require().NoError(err)
+	s.Require().Equal("2020-10-09 10:00:00", *result.PublishAt)
+	s.Require().Empty(s.notifier.events)
+}
+
+func (s *ReviewSuite) TestScheduleModeratedPublication() {
+	project := s.draft()
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().SchedulePublication(project).Return(nil)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+	s.mockUser.EXPECT().GetAdmins().Return([]models.User{{ID: 1}}, nil)
+
+	result, err := s.app.SchedulePublication(42, 10, time.Date(2020, 10, 9, 10, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Equal(models.ReviewPending, result.ReviewStatus)
+}
+
+func (s *ReviewSuite) TestSchedulePublicationInPast() {
+	s.mockProject.EXPECT().Get(10).Return(s.draft(), true)
+
+	_, err := s.app.SchedulePublication(42, 10, time.Date(2020, 10, 1, 10, 0, 0, 0, time.UTC))
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "publish_at", Code: CodeMin, Message: "publication time must be in the future"},
+	}, vErr.Fields)
+}
+
+func (s *ReviewSuite) TestSchedulePublishedProject() {
+	project := s.draft()
+	project.Published = true
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	_, err := s.app.SchedulePublication(42, 10, time.Date(2020, 10, 9, 10, 0, 0, 0, time.UTC))
+	s.Require().Equal(ErrProjectWrongStatus, err)
+}
+
+func (s *ReviewSuite) TestApproveProject() {
+	project := s.draft()
+	project.ReviewStatus = models.ReviewPending
+	s.expectAdmin(1)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+	s.mockProject.EXPECT().Publish(project).DoAndReturn(func(p *models.Project) error {
+		p.Published = true
+		return nil
+	})
+
+	result, err := s.app.ApproveProject(1, 10, " ")
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusSearch, result.Status)
+	s.Require().Equal(s.clock.Now(), project.ReviewedAt)
+	s.Require().Len(s.notifier.events, 2)
+	s.Require().Equal(EventProjectApproved, s.notifier.events[0].Type)
+	s.Require().Equal([]int{42}, s.notifier.events[0].UserIDs)
+	s.Require().Equal(EventProjectPublished, s.notifier.events[1].Type)
+}
+
+func (s *ReviewSuite) TestApproveScheduledProject() {
+	project := s.draft()
+	project.ReviewStatus = models.ReviewPending
+	project.PublishAt = time.Date(2020, 10, 9, 10, 0, 0, 0, time.UTC)
+	s.expectAdmin(1)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+
+	result, err := s.app.ApproveProject(1, 10, "")
+	s.Require().NoError(err)
+	s.Require().Equal(models.StatusDraft, result.Status)
+	s.Require().Equal(models.ReviewApproved, result.ReviewStatus)
+}
+
+func (s *ReviewSuite) TestApproveNotPending() {
+	s.expectAdmin(1)
+	s.mockProject.EXPECT().Get(10).Return(s.draft(), true)
+
+	_, err := s.app.ApproveProject(1, 10, "")
+	s.Require().Equal(ErrProjectWrongStatus, err)
+}
+
+func (s *ReviewSuite) TestApproveNotAdmin() {
+	s.mockUser.EXPECT().Get(42).Return(&models.User{ID: 42}, true)
+
+	_, err := s.app.ApproveProject(42, 10, "")
+	s.Require().Equal(ErrAdminRequired, err)
+}
+
+func (s *ReviewSuite) TestRejectProject() {
+	project := s.draft()
+	project.ReviewStatus = models.ReviewPending
+	s.expectAdmin(1)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockProject.EXPECT().UpdateReview(project).Return(nil)
+
+	result, err := s.app.RejectProject(1, 10, "Add instructions")
+	s.Require().NoError(err)
+	s.Require().Equal(models.ReviewRejected, result.ReviewStatus)
+	s.Require().Equal("Add instructions", result.ReviewComment)
+	s.Require().Equal([]Event{{
+		Type:      EventProjectRejected,
+		ProjectID: 10,
+		UserIDs:   []int{42},
+		Data: map[string]interface{}{
+			"title":   "Pizza",
+			"comment": "Add instructions",
+		},
+	}}, s.notifier.events)
+}
+
+func (s *ReviewSuite) TestRejectWithoutComment() {
+	project := s.draft()
+	project.ReviewStatus = models.ReviewPending
+	s.expectAdmin(1)
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+
+	_, err := s.app.RejectProject(1, 10, "")
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "comment", Code: CodeRequired, Message: "comment is required"},
+	}, vErr.Fields)
+}
+
+func (s *ReviewSuite) TestPublishScheduled() {
+	now := s.clock.Now()
+	scheduled := &[]models.Project{
+		{ID: 10, OwnerID: 42, Title: "Pizza", Category: models.Category{ID: 2}},
+		{ID: 11, OwnerID: 42, Title: "Burger", Category: models.Category{ID: 3, Moderated: true}},
+		{ID: 12, OwnerID: 42, Title: "Sushi", Category: models.Category{ID: 3, Moderated: true}, ReviewStatus: models.ReviewApproved},
+	}
+	s.mockProject.EXPECT().GetScheduledProjects(now).Return(scheduled, nil)
+	s.mockProject.EXPECT().Publish(&(*scheduled)[0]).Return(nil)
+	s.mockProject.EXPECT().UpdateReview(&(*scheduled)[1]).Return(nil)
+	s.mockUser.EXPECT().GetAdmins().Return([]models.User{{ID: 1}}, nil)
+	s.mockProject.EXPECT().Publish(&(*scheduled)[2]).Return(nil)
+
+	b := NewBackground(nil, s.mockProject, s.mockUser, nil, nil, nil, s.notifier, nil, nil, 0, ReminderPolicy{}, RankingPolicy{}, clockwork.NewFakeClock())
+	b.publishScheduled(now)
+	s.Require().Equal(models.ReviewPending, (*scheduled)[1].ReviewStatus)
+	s.Require().Len(s.notifier.events, 3)
+	s.Require().Equal(EventProjectPublished, s.notifier.events[0].Type)
+	s.Require().Equal(EventReviewRequested, s.notifier.events[1].Type)
+	s.Require().Equal(EventProjectPublished, s.notifier.events[2].Type)
+}
+
+func TestReviewSuite(t *testing.T) {
+	suite.Run(t, new(ReviewSuite))
+}
@@ -0,0 +1,192 @@
+package app
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrRevisionNotFound project revision with given number not found.
+	ErrRevisionNotFound = errors.New("revision not found")
+)
+
+// financialFields fields of project affecting what participants pay and when.
+var financialFields = map[string]bool{
+	"project_type":     true,
+	"goal_people":      true,
+	"goal_amount":      true,
+	"currency":         true,
+	"release_date":     true,
+	"min_pledge":       true,
+	"max_pledge":       true,
+	"pledge_step":      true,
+	"deny_overfunding": true,
+}
+
+// RevisionChange field changed between two revisions.
+// AfterJoin is set for financial field changed while project had participants.
+type RevisionChange struct {
+	Field     string `json:"field"`
+	Old       string `json:"old"`
+	New       string `json:"new"`
+	Financial bool   `json:"financial"`
+	AfterJoin bool   `json:"after_join"`
+}
+
+// RevisionDiff changes of project fields between two revisions.
+type RevisionDiff struct {
+	From    int              `json:"from"`
+	To      int              `json:"to"`
+	Changes []RevisionChange `json:"changes"`
+}
+
+// projectFields returns versioned fields of project as strings.
+func projectFields(p *models.Project) map[string]string {
+	fields := map[string]string{
+		"title":            p.Title,
+		"subtitle":         p.SubTitle,
+		"description":      p.Description,
+		"image_link":       p.ImageLink,
+		"instructions":     p.Instructions,
+		"category":         strconv.Itoa(p.CategoryID),
+		"project_type":     strconv.Itoa(p.ProjectTypeID),
+		"goal_people":      strconv.Itoa(p.GoalPeople),
+		"goal_amount":      strconv.FormatInt(p.GoalAmount, 10),
+		"currency":         p.Currency,
+		"release_date":     p.ReleaseDate.Format(DateLayout),
+		"event_date":       "",
+		"min_pledge":       strconv.FormatInt(p.MinPledge, 10),
+		"max_pledge":       strconv.FormatInt(p.MaxPledge, 10),
+		"pledge_step":      strconv.FormatInt(p.PledgeStep, 10),
+		"deny_overfunding": strconv.FormatBool(p.DenyOverfunding),
+	}
+	if !p.EventDate.IsZero() {
+		fields["event_date"] = p.EventDate.Format(DateTimeLayout)
+	}
+
+	return fields
+}
+
+// changedFields returns names of fields differing between revisions, sorted.
+func changedFields(before, after map[string]string) []string {
+	changed := make([]string, 0)
+	for name, value := range after {
+		if before[name] != value {
+			changed = append(changed, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// saveRevision stores current state of project if it differs from latest revision.
+// Financial fields changed while project has participants are flagged.
+func (a *App) saveRevision(p *models.Project, userID int) error {
+	fields := projectFields(p)
+	number := 1
+	flagged := make([]string, 0)
+	if last, ok := a.revisionModel.GetLast(p.ID); ok {
+		changed := changedFields(last.Fields, fields)
+		if len(changed) == 0 {
+			return nil
+		}
+		number = last.Number + 1
+		financial := make([]string, 0)
+		for _, name := range changed {
+			if financialFields[name] {
+				financial = append(financial, name)
+			}
+		}
+		if len(financial) > 0 {
+			ids, err := a.participants(p.ID)
+			if err != nil {
+				return err
+			}
+			if len(ids) > 0 {
+				flagged = financial
+			}
+		}
+	}
+
+	return a.revisionModel.Create(&models.ProjectRevision{
+		ProjectID: p.ID,
+		Number:    number,
+		UserID:    userID,
+		Fields:    fields,
+		Flagged:   flagged,
+		CreatedAt: a.clock.Now(),
+	})
+}
+
+// GetProjectRevisions returns saved revisions of project visible to viewer, oldest first.
+func (a *App) GetProjectRevisions(viewerID, projectID int) ([]models.ProjectRevision, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok || !a.canViewProject(project, viewerID) {
+		return nil, ErrProjectNotFound
+	}
+
+	return a.revisionModel.GetAllByProject(projectID)
+}
+
+// GetRevisionDiff returns field changes between two revisions of project.
+// Zero to means latest revision, zero from means revision before to,
+// revision 0 is empty project, so diff to first revision contains all fields.
+func (a *App) GetRevisionDiff(viewerID, projectID, from, to int) (*RevisionDiff, error) {
+	revisions, err := a.GetProjectRevisions(viewerID, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if to == 0 {
+		if len(revisions) == 0 {
+			return nil, ErrRevisionNotFound
+		}
+		to = revisions[len(revisions)-1].Number
+	}
+	if from == 0 {
+		from = to - 1
+	}
+	if from >= to {
+		verr := &ValidationError{}
+		verr.add("from", CodeMax, "revision must be before compared one")
+		return nil, verr.errOrNil()
+	}
+	var before, after map[string]string
+	flagged := make(map[string]bool)
+	for _, r := range revisions {
+		switch {
+		case r.Number == from:
+			before = r.Fields
+		case r.Number == to:
+			after = r.Fields
+		}
+		if r.Number > from && r.Number <= to {
+			for _, name := range r.Flagged {
+				flagged[name] = true
+			}
+		}
+	}
+	if (before == nil && from != 0) || after == nil {
+		return nil, ErrRevisionNotFound
+	}
+	changes := make([]RevisionChange, 0)
+	for _, name := range changedFields(before, after) {
+		changes = append(changes, RevisionChange{
+			Field:     name,
+			Old:       before[name],
+			New:       after[name],
+			Financial: financialFields[name],
+			AfterJoin: flagged[name],
+		})
+	}
+
+	return &RevisionDiff{From: from, To: to, Changes: changes}, nil
+}
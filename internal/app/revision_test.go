@@ -0,0 +1,218 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+// expectFirstRevision expects project without revisions to get its first one.
+func expectFirstRevision(m *mocks.MockRevisionImpl) {
+	m.EXPECT().GetLast(gomock.Any()).Return(nil, false)
+	m.EXPECT().Create(gomock.Any()).Return(nil)
+}
+
+type RevisionSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockDonationCtl     *gomock.Controller
+	mockDonation        *mocks.MockDonationImpl
+	mockRevisionCtl     *gomock.Controller
+	mockRevision        *mocks.MockRevisionImpl
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *RevisionSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockDonationCtl = gomock.NewController(s.T())
+	s.mockDonation = mocks.NewMockDonationImpl(s.mockDonationCtl)
+	s.mockRevisionCtl = gomock.NewController(s.T())
+	s.mockRevision = mocks.NewMockRevisionImpl(s.mockRevisionCtl)
+	s.clock = clockwork.NewFakeClockAt(time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC))
+	s.app = New(nil, nil, s.mockProject, nil, s.mockDonation, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockCollaborator, nil, s.mockRevision, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *RevisionSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockDonationCtl.Finish()
+	s.mockRevisionCtl.Finish()
+}
+
+func (s *RevisionSuite) project() *models.Project {
+	return &models.Project{
+		ID:            10,
+		OwnerID:       42,
+		Title:         "Pizza",
+		GoalAmount:    3000,
+		Currency:      "RUB",
+		CategoryID:    2,
+		ProjectTypeID: 1,
+		Published:     true,
+		ReleaseDate:   time.Date(2020, 10, 10, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func (s *RevisionSuite) revisions() []models.ProjectRevision {
+	first := projectFields(s.project())
+	second := projectFields(s.project())
+	second["goal_amount"] = "5000"
+	third := projectFields(s.project())
+	third["goal_amount"] = "5000"
+	third["title"] = "Big pizza"
+
+	return []models.ProjectRevision{
+		{ProjectID: 10, Number: 1, UserID: 42, Fields: first, Flagged: []string{}},
+		{ProjectID: 10, Number: 2, UserID: 42, Fields: second, Flagged: []string{"goal_amount"}},
+		{ProjectID: 10, Number: 3, UserID: 42, Fields: third, Flagged: []string{}},
+	}
+}
+
+func (s *RevisionSuite) TestSaveFirstRevision() {
+	project := s.project()
+	s.mockRevision.EXPECT().GetLast(10).Return(nil, false)
+	s.mockRevision.EXPECT().Create(&models.ProjectRevision{
+		ProjectID: 10,
+		Number:    1,
+		UserID:    42,
+		Fields:    projectFields(project),
+		Flagged:   []string{},
+		CreatedAt: s.clock.Now(),
+	}).Return(nil)
+
+	s.Require().NoError(s.app.saveRevision(project, 42))
+}
+
+func (s *RevisionSuite) TestSaveUnchangedRevision() {
+	project := s.project()
+	s.mockRevision.EXPECT().GetLast(10).Return(&models.ProjectRevision{
+		ProjectID: 10,
+		Number:    1,
+		Fields:    projectFields(project),
+	}, true)
+
+	s.Require().NoError(s.app.saveRevision(project, 42))
+}
+
+func (s *RevisionSuite) TestSaveRevisionFlagsFinancialChange() {
+	project := s.project()
+	last := &models.ProjectRevision{ProjectID: 10, Number: 2, Fields: projectFields(project)}
+	project.Title = "Big pizza"
+	project.GoalAmount = 5000
+	project.ReleaseDate = time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC)
+	s.mockRevision.EXPECT().GetLast(10).Return(last, true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return([]models.Donation{{UserID: 111}}, nil)
+	s.mockRevision.EXPECT().Create(&models.ProjectRevision{
+		ProjectID: 10,
+		Number:    3,
+		UserID:    7,
+		Fields:    projectFields(project),
+		Flagged:   []string{"goal_amount", "release_date"},
+		CreatedAt: s.clock.Now(),
+	}).Return(nil)
+
+	s.Require().NoError(s.app.saveRevision(project, 7))
+}
+
+func (s *RevisionSuite) TestSaveRevisionWithoutParticipants() {
+	project := s.project()
+	last := &models.ProjectRevision{ProjectID: 10, Number: 1, Fields: projectFields(project)}
+	project.GoalAmount = 5000
+	s.mockRevision.EXPECT().GetLast(10).Return(last, true)
+	s.mockDonation.EXPECT().GetAllByProject(10).Return([]models.Donation{}, nil)
+	s.mockRevision.EXPECT().Create(&models.ProjectRevision{
+		ProjectID: 10,
+		Number:    2,
+		UserID:    42,
+		Fields:    projectFields(project),
+		Flagged:   []string{},
+		CreatedAt: s.clock.Now(),
+	}).Return(nil)
+
+	s.Require().NoError(s.app.saveRevision(project, 42))
+}
+
+func (s *RevisionSuite) TestGetLatestRevisionDiff() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockRevision.EXPECT().GetAllByProject(10).Return(s.revisions(), nil)
+
+	diff, err := s.app.GetRevisionDiff(111, 10, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Equal(&RevisionDiff{
+		From: 2,
+		To:   3,
+		Changes: []RevisionChange{
+			{Field: "title", Old: "Pizza", New: "Big pizza"},
+		},
+	}, diff)
+}
+
+func (s *RevisionSuite) TestGetRevisionDiff() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockRevision.EXPECT().GetAllByProject(10).Return(s.revisions(), nil)
+
+	diff, err := s.app.GetRevisionDiff(111, 10, 1, 3)
+	s.Require().NoError(err)
+	s.Require().Equal([]RevisionChange{
+		{Field: "goal_amount", Old: "3000", New: "5000", Financial: true, AfterJoin: true},
+		{Field: "title", Old: "Pizza", New: "Big pizza"},
+	}, diff.Changes)
+}
+
+func (s *RevisionSuite) TestGetFirstRevisionDiff() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockRevision.EXPECT().GetAllByProject(10).Return(s.revisions(), nil)
+
+	diff, err := s.app.GetRevisionDiff(111, 10, 0, 1)
+	s.Require().NoError(err)
+	s.Require().Equal(0, diff.From)
+	s.Require().Len(diff.Changes, 11)
+	s.Require().Equal(RevisionChange{Field: "category", New: "2"}, diff.Changes[0])
+}
+
+func (s *RevisionSuite) TestGetRevisionDiffWrongOrder() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockRevision.EXPECT().GetAllByProject(10).Return(s.revisions(), nil)
+
+	_, err := s.app.GetRevisionDiff(111, 10, 3, 2)
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "from", Code: CodeMax, Message: "revision must be before compared one"},
+	}, vErr.Fields)
+}
+
+func (s *RevisionSuite) TestGetRevisionDiffNotFound() {
+	s.mockProject.EXPECT().Get(10).Return(s.project(), true)
+	s.mockRevision.EXPECT().GetAllByProject(10).Return(s.revisions(), nil)
+
+	_, err := s.app.GetRevisionDiff(111, 10, 1, 5)
+	s.Require().Equal(ErrRevisionNotFound, err)
+}
+
+func (s *RevisionSuite) TestGetRevisionsOfDraft() {
+	project := s.project()
+	project.Published = false
+	s.mockProject.EXPECT().Get(10).Return(project, true)
+	s.mockCollaborator.EXPECT().Get(10, 111).Return(nil, false)
+
+	_, err := s.app.GetProjectRevisions(111, 10)
+	s.Require().Equal(ErrProjectNotFound, err)
+}
+
+func TestRevisionSuite(t *testing.T) {
+	suite.Run(t, new(RevisionSuite))
+}
@@ -10,9 +10,12 @@ import (
 type Strategy interface {
 	Percent(p *models.Project) int
 	Recalc(p *models.Project) error
-	CheckSearch(p *models.Project) (bool, error)
+	CheckSearch(p *models.Project, now time.Time) (bool, error)
 	CheckHarvest(p *models.Project) (bool, error)
 	CloseOutdated(p *models.Project) (bool, error)
+	JoinAfterLock() bool
+	PaymentByUser() bool
+	Resplit(p *models.Project) ([]models.Adjustment, error)
 }
 
 // MoneyStrategy simple money type
@@ -40,9 +43,9 @@ func (s *MoneyStrategy) Recalc(p *models.Project) error {
 }
 
 // CheckSearch check project for search stage ending
-func (s *MoneyStrategy) CheckSearch(p *models.Project) (bool, error) {
+func (s *MoneyStrategy) CheckSearch(p *models.Project, now time.Time) (bool, error) {
 	if s.Percent(p) >= 100 {
-		return true, s.projectModel.Lock(p)
+		return true, s.projectModel.Lock(p, now)
 	}
 
 	return false, nil
@@ -72,6 +75,21 @@ func (s *MoneyStrategy) CloseOutdated(p *models.Project) (bool, error) {
 	return false, nil
 }
 
+// JoinAfterLock checks participants can join locked project
+func (s *MoneyStrategy) JoinAfterLock() bool {
+	return false
+}
+
+// PaymentByUser checks participant chooses payment amount
+func (s *MoneyStrategy) PaymentByUser() bool {
+	return true
+}
+
+// Resplit recalculate shares of locked project
+func (s *MoneyStrategy) Resplit(p *models.Project) ([]models.Adjustment, error) {
+	return nil, nil
+}
+
 // EventStrategy simple event type
 type EventStrategy struct {
 	projectModel models.ProjectImpl
@@ -97,9 +115,9 @@ func (s *EventStrategy) Recalc(p *models.Project) error {
 }
 
 // CheckSearch check project for search stage ending
-func (s *EventStrategy) CheckSearch(p *models.Project) (bool, error) {
+func (s *EventStrategy) CheckSearch(p *models.Project, now time.Time) (bool, error) {
 	if s.Percent(p) >= 100 {
-		return true, s.projectModel.Lock(p)
+		return true, s.projectModel.Lock(p, now)
 	}
 
 	return false, nil
@@ -121,6 +139,21 @@ func (s *EventStrategy) CloseOutdated(p *models.Project) (bool, error) {
 	return false, nil
 }
 
+// JoinAfterLock checks participants can join locked project
+func (s *EventStrategy) JoinAfterLock() bool {
+	return false
+}
+
+// PaymentByUser checks participant chooses payment amount
+func (s *EventStrategy) PaymentByUser() bool {
+	return false
+}
+
+// Resplit recalculate shares of locked project
+func (s *EventStrategy) Resplit(p *models.Project) ([]models.Adjustment, error) {
+	return nil, nil
+}
+
 // EventDateStrategy event type with date
 type EventDateStrategy struct {
 	baseStrategy *EventStrategy
@@ -144,11 +177,10 @@ func (s *EventDateStrategy) Recalc(p *models.Project) error {
 }
 
 // CheckSearch check project for search stage ending
-func (s *EventDateStrategy) CheckSearch(p *models.Project) (bool, error) {
-	n := time.Now()
+func (s *EventDateStrategy) CheckSearch(p *models.Project, now time.Time) (bool, error) {
 	d := p.ReleaseDate
-	if n.Year() == d.Year() && n.Month() == d.Month() && n.Day() == d.Day() {
-		return s.baseStrategy.CheckSearch(p)
+	if now.Year() == d.Year() && now.Month() == d.Month() && now.Day() == d.Day() {
+		return s.baseStrategy.CheckSearch(p, now)
 	}
 
 	return false, nil
@@ -164,6 +196,21 @@ func (s *EventDateStrategy) CloseOutdated(p *models.Project) (bool, error) {
 	return s.baseStrategy.CloseOutdated(p)
 }
 
+// JoinAfterLock checks participants can join locked project
+func (s *EventDateStrategy) JoinAfterLock() bool {
+	return s.baseStrategy.JoinAfterLock()
+}
+
+// PaymentByUser checks participant chooses payment amount
+func (s *EventDateStrategy) PaymentByUser() bool {
+	return s.baseStrategy.PaymentByUser()
+}
+
+// Resplit recalculate shares of locked project
+func (s *EventDateStrategy) Resplit(p *models.Project) ([]models.Adjustment, error) {
+	return s.baseStrategy.Resplit(p)
+}
+
 // MoneyEqualStrategy money type with equal part splitting
 type MoneyEqualStrategy struct {
 	moneyStrategy *MoneyStrategy
@@ -189,8 +236,8 @@ func (s *MoneyEqualStrategy) Recalc(p *models.Project) error {
 }
 
 // CheckSearch check project for search stage ending
-func (s *MoneyEqualStrategy) CheckSearch(p *models.Project) (bool, error) {
-	evolved, err := s.eventStrategy.CheckSearch(p)
+func (s *MoneyEqualStrategy) CheckSearch(p *models.Project, now time.Time) (bool, error) {
+	evolved, err := s.eventStrategy.CheckSearch(p, now)
 	if err != nil {
 		return false, err
 	}
@@ -212,6 +259,31 @@ func (s *MoneyEqualStrategy) CloseOutdated(p *models.Project) (bool, error) {
 	return s.moneyStrategy.CloseOutdated(p)
 }
 
+// JoinAfterLock checks participants can join locked project
+func (s *MoneyEqualStrategy) JoinAfterLock() bool {
+	return true
+}
+
+// PaymentByUser checks participant chooses payment amount
+func (s *MoneyEqualStrategy) PaymentByUser() bool {
+	return false
+}
+
+// Resplit recalculate shares of locked project when participants join or leave.
+// Total keeps count of participants shares were split between, shares are kept while it isn't changed.
+func (s *MoneyEqualStrategy) Resplit(p *models.Project) ([]models.Adjustment, error) {
+	participants := p.Total
+	err := s.eventStrategy.Recalc(p)
+	if err != nil {
+		return nil, err
+	}
+	if p.Total == participants {
+		return nil, nil
+	}
+
+	return s.moneyStrategy.projectModel.ResplitDonations(p)
+}
+
 // GetStrategy returns project strategy based on project type
 func GetStrategy(pt *models.ProjectType, r models.ProjectImpl) (Strategy, error) {
 	if pt.GoalByAmount && !pt.GoalByPeople {
@@ -2,6 +2,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	"github.com/FreakyGranny/launchpad-api/internal/mocks"
 	"github.com/FreakyGranny/launchpad-api/internal/models"
@@ -150,6 +151,79 @@ func (s *StrategySuite) TestMoneyEqualPercent() {
 	s.Require().Equal(28, st.Percent(proj))
 }
 
+func (s *StrategySuite) TestMoneyCheckSearch() {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	proj := &models.Project{GoalAmount: 1000, Total: 1000}
+	s.mockProject.EXPECT().Lock(proj, now).Return(nil)
+
+	locked, err := NewMoneyStrategy(s.mockProject).CheckSearch(proj, now)
+	s.Require().NoError(err)
+	s.Require().True(locked)
+}
+
+func (s *StrategySuite) TestEventDateCheckSearch() {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	proj := &models.Project{GoalPeople: 2, Total: 2, ReleaseDate: time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)}
+	s.mockProject.EXPECT().Lock(proj, now).Return(nil)
+
+	locked, err := NewEventDateStrategy(s.mockProject).CheckSearch(proj, now)
+	s.Require().NoError(err)
+	s.Require().True(locked)
+
+	locked, err = NewEventDateStrategy(s.mockProject).CheckSearch(proj, now.AddDate(0, 0, 1))
+	s.Require().NoError(err)
+	s.Require().False(locked)
+}
+
+func (s *StrategySuite) TestJoinAfterLock() {
+	s.Require().False(NewMoneyStrategy(s.mockProject).JoinAfterLock())
+	s.Require().False(NewEventStrategy(s.mockProject).JoinAfterLock())
+	s.Require().False(NewEventDateStrategy(s.mockProject).JoinAfterLock())
+	s.Require().True(NewMoneyEqualStrategy(s.mockProject).JoinAfterLock())
+}
+
+func (s *StrategySuite) TestMoneyEqualResplit() {
+	st := NewMoneyEqualStrategy(s.mockProject)
+	proj := &models.Project{
+		ID:         1,
+		GoalPeople: 2,
+		GoalAmount: 1000,
+		Locked:     true,
+	}
+	adjustments := []models.Adjustment{{DonationID: 1, OldPayment: 500, NewPayment: 334}}
+	s.mockProject.EXPECT().UpdateTotalByCount(proj).DoAndReturn(func(p *models.Project) error {
+		p.Total = 3
+		return nil
+	})
+	s.mockProject.EXPECT().ResplitDonations(proj).Return(adjustments, nil)
+
+	result, err := st.Resplit(proj)
+	s.Require().NoError(err)
+	s.Require().Equal(adjustments, result)
+}
+
+func (s *StrategySuite) TestMoneyEqualResplitSameParticipants() {
+	st := NewMoneyEqualStrategy(s.mockProject)
+	proj := &models.Project{
+		ID:         1,
+		GoalPeople: 2,
+		GoalAmount: 1000,
+		Total:      2,
+		Locked:     true,
+	}
+	s.mockProject.EXPECT().UpdateTotalByCount(proj).Return(nil)
+
+	result, err := st.Resplit(proj)
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+}
+
+func (s *StrategySuite) TestMoneyResplit() {
+	result, err := NewMoneyStrategy(s.mockProject).Resplit(&models.Project{})
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+}
+
 func TestStrategySuite(t *testing.T) {
 	suite.Run(t, new(StrategySuite))
 }
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrTagNotFound tag with given id not found.
+	ErrTagNotFound = errors.New("tag not found")
+)
+
+const (
+	maxProjectTags = 10
+	minTagLength   = 2
+	maxTagLength   = 32
+)
+
+// TagStats count of published projects with tag by status.
+type TagStats struct {
+	Tag    models.Tag     `json:"tag"`
+	Counts map[string]int `json:"counts"`
+	Total  int            `json:"total"`
+}
+
+// isTagSeparator checks rune separates words of tag.
+func isTagSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == '-' || r == '_'
+}
+
+// tagAlias returns canonical form of tag name, "For  Kids" and "for-kids" are the same tag.
+func tagAlias(name string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(name), isTagSeparator), "-")
+}
+
+// validateTagName checks tag name consists of letters and digits of allowed length.
+func validateTagName(verr *ValidationError, name string) bool {
+	length := utf8.RuneCountInString(tagAlias(name))
+	if length < minTagLength {
+		verr.add("tags", CodeMin, fmt.Sprintf("tag %q is shorter than %d characters", name, minTagLength))
+		return false
+	}
+	if length > maxTagLength {
+		verr.add("tags", CodeMax, fmt.Sprintf("tag %q is longer than %d characters", name, maxTagLength))
+		return false
+	}
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !isTagSeparator(r) {
+			verr.add("tags", CodeInvalid, fmt.Sprintf("tag %q may contain only letters and digits", name))
+			return false
+		}
+	}
+
+	return true
+}
+
+// newTags validates names and returns unique tags.
+func (a *App) newTags(names []string) ([]models.Tag, error) {
+	verr := &ValidationError{}
+	tags := make([]models.Tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.Join(strings.Fields(name), " ")
+		if !validateTagName(verr, name) {
+			continue
+		}
+		alias := tagAlias(name)
+		if seen[alias] {
+			continue
+		}
+		seen[alias] = true
+		tags = append(tags, models.Tag{Alias: alias, Name: name, CreatedAt: a.clock.Now()})
+	}
+	if len(tags) > maxProjectTags {
+		verr.add("tags", CodeMax, fmt.Sprintf("project can't have more than %d tags", maxProjectTags))
+	}
+
+	return tags, verr.errOrNil()
+}
+
+// SearchTags returns tags starting with prefix for autocomplete.
+func (a *App) SearchTags(prefix string, limit int) ([]models.Tag, error) {
+	return a.tagModel.Search(tagAlias(prefix), limit)
+}
+
+// GetTagStats returns tags with count of published projects by status.
+func (a *App) GetTagStats() ([]TagStats, error) {
+	counts, err := a.tagModel.CountByStatus()
+	if err != nil {
+		return nil, err
+	}
+	stats := make([]TagStats, 0)
+	for _, c := range counts {
+		if len(stats) == 0 || stats[len(stats)-1].Tag.ID != c.TagID {
+			stats = append(stats, TagStats{
+				Tag:    models.Tag{ID: c.TagID, Alias: c.Alias, Name: c.Name, Curated: c.Curated},
+				Counts: make(map[string]int, len(models.ListedStatuses)),
+			})
+		}
+		last := &stats[len(stats)-1]
+		last.Counts[c.Status] = c.Count
+		last.Total += c.Count
+	}
+
+	return stats, nil
+}
+
+// SetProjectTags replaces tags of project, only owner and co-owners can change them.
+func (a *App) SetProjectTags(userID, projectID int, names []string) ([]models.Tag, error) {
+	project, ok := a.projectModel.Get(projectID)
+	if !ok {
+		return nil, ErrProjectNotFound
+	}
+	if !a.can(project, userID, permEdit) {
+		return nil, ErrProjectModifyNotAllowed
+	}
+	tags, err := a.newTags(names)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.tagModel.SetProjectTags(projectID, tags)
+}
+
+// CreateTag creates curated tag or marks existing one as curated, admin only.
+func (a *App) CreateTag(userID int, name string) (*models.Tag, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := a.newTags([]string{name})
+	if err != nil {
+		return nil, err
+	}
+	tag := &tags[0]
+	tag.Curated = true
+	err = a.tagModel.Save(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// MergeTags moves projects of source tag to target tag and deletes source, admin only.
+func (a *App) MergeTags(userID, sourceID, targetID int) (*models.Tag, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	if sourceID == targetID {
+		verr := &ValidationError{}
+		verr.add("into", CodeNotAllowed, "tag can't be merged into itself")
+		return nil, verr
+	}
+	source, ok := a.tagModel.Get(sourceID)
+	if !ok {
+		return nil, ErrTagNotFound
+	}
+	target, ok := a.tagModel.Get(targetID)
+	if !ok {
+		return nil, ErrTagNotFound
+	}
+	target.Curated = target.Curated || source.Curated
+	err = a.tagModel.Merge(source, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return target, nil
+}
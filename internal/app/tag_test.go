@@ -0,0 +1,168 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type TagSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockUserCtl         *gomock.Controller
+	mockUser            *mocks.MockUserImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockTagCtl          *gomock.Controller
+	mockTag             *mocks.MockTagImpl
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *TagSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockTagCtl = gomock.NewController(s.T())
+	s.mockTag = mocks.NewMockTagImpl(s.mockTagCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.app = New(nil, s.mockUser, s.mockProject, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockTag, nil, nil, nil, nil, s.mockCollaborator, nil, nil, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *TagSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockTagCtl.Finish()
+}
+
+func (s *TagSuite) TestTagAlias() {
+	s.Require().Equal("for-kids", tagAlias("  For   Kids "))
+	s.Require().Equal("for-kids", tagAlias("for_kids"))
+	s.Require().Equal("настолки", tagAlias("Настолки"))
+}
+
+func (s *TagSuite) TestSearchTags() {
+	tags := []models.Tag{{ID: 1, Alias: "for-kids", Name: "For kids", Curated: true}}
+	s.mockTag.EXPECT().Search("for-k", 10).Return(tags, nil)
+
+	result, err := s.app.SearchTags("For K", 10)
+	s.Require().NoError(err)
+	s.Require().Equal(tags, result)
+}
+
+func (s *TagSuite) TestGetTagStats() {
+	s.mockTag.EXPECT().CountByStatus().Return([]models.TagStatusCount{
+		{TagID: 2, Alias: "for-kids", Name: "For kids", Curated: true, Status: "harvest", Count: 1},
+		{TagID: 2, Alias: "for-kids", Name: "For kids", Curated: true, Status: "search", Count: 3},
+		{TagID: 5, Alias: "weekend", Name: "Weekend", Status: "success", Count: 2},
+	}, nil)
+
+	stats, err := s.app.GetTagStats()
+	s.Require().NoError(err)
+	s.Require().Equal([]TagStats{
+		{
+			Tag:    models.Tag{ID: 2, Alias: "for-kids", Name: "For kids", Curated: true},
+			Counts: map[string]int{"harvest": 1, "search": 3},
+			Total:  4,
+		},
+		{
+			Tag:    models.Tag{ID: 5, Alias: "weekend", Name: "Weekend"},
+			Counts: map[string]int{"success": 2},
+			Total:  2,
+		},
+	}, stats)
+}
+
+func (s *TagSuite) TestSetProjectTags() {
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 7}, true)
+	expected := []models.Tag{
+		{Alias: "for-kids", Name: "For kids", CreatedAt: s.clock.Now()},
+		{Alias: "weekend", Name: "weekend", CreatedAt: s.clock.Now()},
+	}
+	saved := []models.Tag{{ID: 3, Alias: "for-kids", Name: "For kids", Curated: true}, {ID: 9, Alias: "weekend", Name: "weekend"}}
+	s.mockTag.EXPECT().SetProjectTags(1, expected).Return(saved, nil)
+
+	tags, err := s.app.SetProjectTags(7, 1, []string{"For  kids", "weekend", "for-kids"})
+	s.Require().NoError(err)
+	s.Require().Equal(saved, tags)
+}
+
+func (s *TagSuite) TestSetProjectTagsInvalid() {
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 7}, true)
+
+	_, err := s.app.SetProjectTags(7, 1, []string{"a", "board#games"})
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "tags", Code: CodeMin, Message: `tag "a" is shorter than 2 characters`},
+		{Field: "tags", Code: CodeInvalid, Message: `tag "board#games" may contain only letters and digits`},
+	}, vErr.Fields)
+}
+
+func (s *TagSuite) TestSetProjectTagsNotOwner() {
+	s.mockCollaborator.EXPECT().Get(1, 8).Return(nil, false)
+	s.mockProject.EXPECT().Get(1).Return(&models.Project{ID: 1, OwnerID: 7}, true)
+
+	_, err := s.app.SetProjectTags(8, 1, []string{"weekend"})
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+}
+
+func (s *TagSuite) TestCreateTag() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockTag.EXPECT().Save(&models.Tag{Alias: "for-kids", Name: "For kids", Curated: true, CreatedAt: s.clock.Now()}).Return(nil)
+
+	tag, err := s.app.CreateTag(1, "For kids")
+	s.Require().NoError(err)
+	s.Require().True(tag.Curated)
+}
+
+func (s *TagSuite) TestCreateTagNotAdmin() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1}, true)
+
+	_, err := s.app.CreateTag(1, "For kids")
+	s.Require().Equal(ErrAdminRequired, err)
+}
+
+func (s *TagSuite) TestMergeTags() {
+	source := &models.Tag{ID: 2, Alias: "kids", Name: "kids", Curated: true}
+	target := &models.Tag{ID: 3, Alias: "for-kids", Name: "For kids"}
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockTag.EXPECT().Get(2).Return(source, true)
+	s.mockTag.EXPECT().Get(3).Return(target, true)
+	s.mockTag.EXPECT().Merge(source, target).Return(nil)
+
+	tag, err := s.app.MergeTags(1, 2, 3)
+	s.Require().NoError(err)
+	s.Require().Equal(3, tag.ID)
+	s.Require().True(tag.Curated)
+}
+
+func (s *TagSuite) TestMergeTagsNotFound() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockTag.EXPECT().Get(2).Return(nil, false)
+
+	_, err := s.app.MergeTags(1, 2, 3)
+	s.Require().Equal(ErrTagNotFound, err)
+}
+
+func (s *TagSuite) TestMergeTagsItself() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+
+	_, err := s.app.MergeTags(1, 2, 2)
+	_, ok := err.(*ValidationError)
+	s.Require().True(ok)
+}
+
+func TestTagSuite(t *testing.T) {
+	suite.Run(t, new(TagSuite))
+}
@@ -0,0 +1,105 @@
+package app
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+var (
+	// ErrTemplateNotFound template with given id not found or not available to user.
+	ErrTemplateNotFound = errors.New("template not found")
+	// ErrTemplateModifyNotAllowed template modifying not allowed.
+	ErrTemplateModifyNotAllowed = errors.New("modifying forbidden")
+)
+
+// validateTemplate checks template has name and goals are not negative.
+func validateTemplate(t *models.ProjectTemplate) error {
+	verr := &ValidationError{}
+	if t.Name == "" {
+		verr.add("name", CodeRequired, "name is required")
+	}
+	if t.GoalPeople < 0 {
+		verr.add("goal_people", CodeMin, "goal can't be negative")
+	}
+	if t.GoalAmount < 0 {
+		verr.add("goal_amount", CodeMin, "goal can't be negative")
+	}
+
+	return verr.errOrNil()
+}
+
+// applyTemplate fills empty fields of project from template.
+func applyTemplate(p *models.Project, t *models.ProjectTemplate) {
+	if p.Title == "" {
+		p.Title = t.Title
+	}
+	if p.Description == "" {
+		p.Description = t.Description
+	}
+	if p.Instructions == "" {
+		p.Instructions = t.Instructions
+	}
+	if p.ProjectTypeID == 0 {
+		p.ProjectTypeID = t.ProjectTypeID
+	}
+	if p.GoalPeople == 0 {
+		p.GoalPeople = t.GoalPeople
+	}
+	if p.GoalAmount == 0 {
+		p.GoalAmount = t.GoalAmount
+	}
+}
+
+// getTemplate returns template available to user.
+func (a *App) getTemplate(userID, templateID int) (*models.ProjectTemplate, error) {
+	template, ok := a.templateModel.Get(templateID)
+	if !ok || (!template.Shared && template.OwnerID != userID) {
+		return nil, ErrTemplateNotFound
+	}
+
+	return template, nil
+}
+
+// GetTemplates returns shared templates and templates of user.
+func (a *App) GetTemplates(userID int) ([]models.ProjectTemplate, error) {
+	return a.templateModel.GetAvailable(userID)
+}
+
+// CreateTemplate creates template of user, only admins can share templates.
+func (a *App) CreateTemplate(userID int, t *models.ProjectTemplate) (*models.ProjectTemplate, error) {
+	t.Name = strings.TrimSpace(t.Name)
+	err := validateTemplate(t)
+	if err != nil {
+		return nil, err
+	}
+	if t.Shared {
+		err = a.requireAdmin(userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	t.ID = 0
+	t.OwnerID = userID
+	t.CreatedAt = a.clock.Now()
+	err = a.templateModel.Create(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// DeleteTemplate deletes template, shared templates can be deleted by admins too.
+func (a *App) DeleteTemplate(userID, templateID int) error {
+	template, err := a.getTemplate(userID, templateID)
+	if err != nil {
+		return err
+	}
+	if template.OwnerID != userID && a.requireAdmin(userID) != nil {
+		return ErrTemplateModifyNotAllowed
+	}
+
+	return a.templateModel.Delete(template)
+}
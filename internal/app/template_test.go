@@ -0,0 +1,194 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type TemplateSuite struct {
+	suite.Suite
+	mockCollaboratorCtl *gomock.Controller
+	mockCollaborator    *mocks.MockCollaboratorImpl
+	mockRevisionCtl     *gomock.Controller
+	mockRevision        *mocks.MockRevisionImpl
+	mockUserCtl         *gomock.Controller
+	mockUser            *mocks.MockUserImpl
+	mockProjectCtl      *gomock.Controller
+	mockProject         *mocks.MockProjectImpl
+	mockTemplateCtl     *gomock.Controller
+	mockTemplate        *mocks.MockTemplateImpl
+	clock               clockwork.FakeClock
+	app                 *App
+}
+
+func (s *TemplateSuite) SetupTest() {
+	s.mockCollaboratorCtl = gomock.NewController(s.T())
+	s.mockCollaborator = mocks.NewMockCollaboratorImpl(s.mockCollaboratorCtl)
+	s.mockRevisionCtl = gomock.NewController(s.T())
+	s.mockRevision = mocks.NewMockRevisionImpl(s.mockRevisionCtl)
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockProjectCtl = gomock.NewController(s.T())
+	s.mockProject = mocks.NewMockProjectImpl(s.mockProjectCtl)
+	s.mockTemplateCtl = gomock.NewController(s.T())
+	s.mockTemplate = mocks.NewMockTemplateImpl(s.mockTemplateCtl)
+	s.clock = clockwork.NewFakeClockAt(time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC))
+	s.app = New(nil, s.mockUser, s.mockProject, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockTemplate, s.mockCollaborator, nil, s.mockRevision, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *TemplateSuite) TearDownTest() {
+	s.mockCollaboratorCtl.Finish()
+	s.mockRevisionCtl.Finish()
+	s.mockUserCtl.Finish()
+	s.mockProjectCtl.Finish()
+	s.mockTemplateCtl.Finish()
+}
+
+func (s *TemplateSuite) TestCreateTemplate() {
+	template := &models.ProjectTemplate{Name: " Pizza ", Title: "Pizza order", GoalAmount: 3000, ProjectTypeID: 1}
+	s.mockTemplate.EXPECT().Create(&models.ProjectTemplate{
+		Name:          "Pizza",
+		OwnerID:       5,
+		Title:         "Pizza order",
+		GoalAmount:    3000,
+		ProjectTypeID: 1,
+		CreatedAt:     s.clock.Now(),
+	}).Return(nil)
+
+	result, err := s.app.CreateTemplate(5, template)
+	s.Require().NoError(err)
+	s.Require().Equal(5, result.OwnerID)
+}
+
+func (s *TemplateSuite) TestCreateTemplateInvalid() {
+	_, err := s.app.CreateTemplate(5, &models.ProjectTemplate{Name: " ", GoalPeople: -1})
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "name", Code: CodeRequired, Message: "name is required"},
+		{Field: "goal_people", Code: CodeMin, Message: "goal can't be negative"},
+	}, vErr.Fields)
+}
+
+func (s *TemplateSuite) TestCreateSharedTemplateNotAdmin() {
+	s.mockUser.EXPECT().Get(5).Return(&models.User{ID: 5}, true)
+
+	_, err := s.app.CreateTemplate(5, &models.ProjectTemplate{Name: "Pizza", Shared: true})
+	s.Require().Equal(ErrAdminRequired, err)
+}
+
+func (s *TemplateSuite) TestCreateProjectFromTemplate() {
+	s.mockTemplate.EXPECT().Get(3).Return(&models.ProjectTemplate{
+		ID:            3,
+		OwnerID:       1,
+		Shared:        true,
+		Title:         "Pizza order",
+		Description:   "Friday pizza",
+		Instructions:  "Pay to the card",
+		ProjectTypeID: 1,
+		GoalAmount:    3000,
+	}, true)
+	release := time.Date(2020, 10, 9, 0, 0, 0, 0, time.UTC)
+	s.mockProject.EXPECT().Create(&models.Project{
+		OwnerID:        5,
+		Title:          "Big pizza",
+		ReleaseDate:    release,
+		GoalAmount:     3000,
+		Currency:       "RUB",
+		Description:    "Friday pizza",
+		Instructions:   "Pay to the card",
+		CategoryID:     2,
+		ProjectTypeID:  1,
+		PrivateAmounts: true,
+	}).Return(nil)
+
+	expectFirstRevision(s.mockRevision)
+	_, err := s.app.CreateProject(5, 3, 0, 0, 2, 0, "", "Big pizza", "", "", "", "", release, time.Time{}, models.PledgeRules{})
+	s.Require().NoError(err)
+}
+
+func (s *TemplateSuite) TestCreateProjectFromPrivateTemplate() {
+	s.mockTemplate.EXPECT().Get(3).Return(&models.ProjectTemplate{ID: 3, OwnerID: 1}, true)
+
+	_, err := s.app.CreateProject(5, 3, 0, 0, 2, 0, "", "Big pizza", "", "", "", "", time.Time{}, time.Time{}, models.PledgeRules{})
+	s.Require().Equal(ErrTemplateNotFound, err)
+}
+
+func (s *TemplateSuite) TestDeleteSharedTemplate() {
+	template := &models.ProjectTemplate{ID: 3, OwnerID: 1, Shared: true}
+	s.mockTemplate.EXPECT().Get(3).Return(template, true).Times(2)
+	s.mockUser.EXPECT().Get(5).Return(&models.User{ID: 5}, true)
+	s.mockUser.EXPECT().Get(2).Return(&models.User{ID: 2, IsAdmin: true}, true)
+	s.mockTemplate.EXPECT().Delete(template).Return(nil)
+
+	s.Require().Equal(ErrTemplateModifyNotAllowed, s.app.DeleteTemplate(5, 3))
+	s.Require().NoError(s.app.DeleteTemplate(2, 3))
+}
+
+func (s *TemplateSuite) TestCloneShift() {
+	now := s.clock.Now()
+	past := time.Date(2020, 9, 25, 0, 0, 0, 0, time.UTC)
+	s.Require().Equal(2*week, cloneShift(past, time.Time{}, now))
+	s.Require().Equal(time.Duration(0), cloneShift(now.Add(time.Hour), time.Time{}, now))
+	s.Require().Equal(24*time.Hour, cloneShift(past, past.Add(24*time.Hour), now))
+	s.Require().Equal(time.Duration(0), cloneShift(time.Time{}, past, now))
+}
+
+func (s *TemplateSuite) TestCloneProject() {
+	release := time.Date(2020, 10, 2, 0, 0, 0, 0, time.UTC)
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{
+		ID:            10,
+		OwnerID:       5,
+		Title:         "Pizza",
+		ReleaseDate:   release,
+		EventDate:     release.Add(19 * time.Hour),
+		GoalAmount:    3000,
+		Total:         3500,
+		Currency:      "RUB",
+		CategoryID:    2,
+		ProjectTypeID: 1,
+		Published:     true,
+		Locked:        true,
+		Closed:        true,
+		LockedAt:      release,
+		PledgeRules:   models.PledgeRules{MinPledge: 100},
+	}, true)
+	s.mockProject.EXPECT().Clone(10, &models.Project{
+		OwnerID:       5,
+		Title:         "Pizza",
+		ReleaseDate:   release.Add(week),
+		EventDate:     release.Add(week + 19*time.Hour),
+		GoalAmount:    3000,
+		Currency:      "RUB",
+		CategoryID:    2,
+		ProjectTypeID: 1,
+		PledgeRules:   models.PledgeRules{MinPledge: 100},
+	}).DoAndReturn(func(sourceID int, p *models.Project) error {
+		p.ID = 11
+		return nil
+	})
+
+	expectFirstRevision(s.mockRevision)
+	id, err := s.app.CloneProject(5, 10, time.Time{})
+	s.Require().NoError(err)
+	s.Require().Equal(11, id)
+}
+
+func (s *TemplateSuite) TestCloneForeignProject() {
+	s.mockCollaborator.EXPECT().Get(10, 5).Return(nil, false)
+	s.mockProject.EXPECT().Get(10).Return(&models.Project{ID: 10, OwnerID: 1}, true)
+
+	_, err := s.app.CloneProject(5, 10, time.Time{})
+	s.Require().Equal(ErrProjectModifyNotAllowed, err)
+}
+
+func TestTemplateSuite(t *testing.T) {
+	suite.Run(t, new(TemplateSuite))
+}
@@ -4,3 +4,23 @@ const (
 	DateLayout     = "2006-01-02"
 	DateTimeLayout = "2006-01-02 15:04:05"
 )
+
+// pageFetchLimit returns count of entries to fetch for page, one extra entry shows there is the next page.
+// Zero limit means no limit.
+func pageFetchLimit(limit int) int {
+	if limit == 0 {
+		return 0
+	}
+
+	return limit + 1
+}
+
+// cutPage returns count of fetched entries which belong to page and cursor of the next page,
+// zero for the last page. Id returns id of fetched entry by index.
+func cutPage(fetched, limit int, id func(i int) int) (int, int, bool) {
+	if limit == 0 || fetched <= limit {
+		return fetched, 0, false
+	}
+
+	return limit, id(limit - 1), true
+}
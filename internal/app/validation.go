@@ -0,0 +1,62 @@
+package app
+
+import "strings"
+
+const (
+	// CodeNotAllowed value is not allowed for this project.
+	CodeNotAllowed = "not_allowed"
+	// CodeRequired value must be set.
+	CodeRequired = "required"
+	// CodeMin value is less than minimum.
+	CodeMin = "min"
+	// CodeMax value is greater than maximum.
+	CodeMax = "max"
+	// CodeStep value is not a multiple of step.
+	CodeStep = "step"
+	// CodeRemaining value is greater than remaining amount.
+	CodeRemaining = "remaining"
+	// CodeInvalid value has wrong format.
+	CodeInvalid = "invalid"
+)
+
+// FieldError describes single invalid request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationError list of invalid request fields.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		fields = append(fields, f.Field+": "+f.Message)
+	}
+
+	return "validation failed: " + strings.Join(fields, "; ")
+}
+
+func (e *ValidationError) add(field, code, message string) {
+	e.Fields = append(e.Fields, FieldError{Field: field, Code: code, Message: message})
+}
+
+// errOrNil returns nil when there are no invalid fields.
+func (e *ValidationError) errOrNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// invalidCursorError returns validation error of cursor which doesn't match list.
+func invalidCursorError() error {
+	verr := &ValidationError{}
+	verr.add("cursor", CodeInvalid, "cursor doesn't match list ordering")
+
+	return verr
+}
@@ -0,0 +1,368 @@
+package app
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/jonboulle/clockwork"
+	"github.com/labstack/gommon/log"
+)
+
+var (
+	// ErrWebhookNotFound webhook with given id not found.
+	ErrWebhookNotFound = errors.New("webhook not found")
+)
+
+const (
+	webhookMaxAttempts   = 5
+	webhookBackoff       = 2 * time.Second
+	webhookTimeout       = 10 * time.Second
+	webhookTestEvent     = "ping"
+	webhookRetryInterval = time.Second
+	webhookRetryBatch    = 100
+)
+
+// webhookEvents public names of events available for webhooks.
+var webhookEvents = map[EventType]string{
+	EventProjectPublished:  "project.published",
+	EventProjectLocked:     "project.locked",
+	EventProjectSucceeded:  "project.closed",
+	EventProjectFailed:     "project.closed",
+	EventProjectCancelled:  "project.closed",
+	EventParticipantJoined: "donation.created",
+	EventPaymentConfirmed:  "donation.paid",
+}
+
+func isWebhookEvent(event string) bool {
+	for _, e := range webhookEvents {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateWebhook checks url and event types of webhook.
+func validateWebhook(rawURL string, events []string) error {
+	verr := &ValidationError{}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.add("url", CodeNotAllowed, "url must be absolute http or https address")
+	}
+	if len(events) == 0 {
+		verr.add("events", CodeRequired, "at least one event is required")
+	}
+	for _, e := range events {
+		if !isWebhookEvent(e) {
+			verr.add("events", CodeNotAllowed, fmt.Sprintf("unknown event %q", e))
+		}
+	}
+
+	return verr.errOrNil()
+}
+
+// HTTPClient sends http requests.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// WebhookPayload body of webhook request.
+type WebhookPayload struct {
+	Event     string                 `json:"event"`
+	ProjectID int                    `json:"project,omitempty"`
+	Data      map[string]interface{} `json:"data"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// newWebhookPayload converts event to payload.
+func newWebhookPayload(name string, e Event, now time.Time) WebhookPayload {
+	data := publicEventData(e)
+	switch e.Type {
+	case EventProjectSucceeded:
+		data["success"] = true
+	case EventProjectFailed:
+		data["success"] = false
+	}
+
+	return WebhookPayload{
+		Event:     name,
+		ProjectID: e.ProjectID,
+		Data:      data,
+		CreatedAt: now,
+	}
+}
+
+// signWebhook returns hex encoded HMAC-SHA256 of body.
+func signWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// postWebhook makes single delivery attempt and writes its result to delivery.
+func postWebhook(client HTTPClient, w *models.Webhook, d *models.WebhookDelivery) bool {
+	d.Attempts++
+	d.StatusCode = 0
+	d.Error = ""
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewBufferString(d.Payload))
+	if err != nil {
+		d.Error = err.Error()
+		return false
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Launchpad-Webhook")
+	req.Header.Set("X-Launchpad-Event", d.Event)
+	req.Header.Set("X-Launchpad-Delivery", fmt.Sprint(d.ID))
+	req.Header.Set("X-Launchpad-Signature", "sha256="+signWebhook(w.Secret, []byte(d.Payload)))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		d.Error = err.Error()
+		return false
+	}
+	defer resp.Body.Close()
+	d.StatusCode = resp.StatusCode
+	d.Delivered = resp.StatusCode >= 200 && resp.StatusCode < 300
+	if !d.Delivered {
+		d.Error = resp.Status
+	}
+
+	return d.Delivered
+}
+
+// newDelivery saves delivery of payload to webhook.
+func newDelivery(md models.WebhookDeliveryImpl, w *models.Webhook, payload WebhookPayload) (*models.WebhookDelivery, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	d := &models.WebhookDelivery{
+		WebhookID: w.ID,
+		Event:     payload.Event,
+		Payload:   string(body),
+		CreatedAt: payload.CreatedAt,
+		UpdatedAt: payload.CreatedAt,
+	}
+	err = md.Create(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// WebhookNotifier delivers events to subscribed webhooks.
+type WebhookNotifier struct {
+	webhookModel         models.WebhookImpl
+	webhookDeliveryModel models.WebhookDeliveryImpl
+	client               HTTPClient
+	clock                clockwork.Clock
+}
+
+// NewWebhookNotifier returns new webhook notifier.
+func NewWebhookNotifier(mw models.WebhookImpl, mwd models.WebhookDeliveryImpl, clock clockwork.Clock) *WebhookNotifier {
+	return &WebhookNotifier{
+		webhookModel:         mw,
+		webhookDeliveryModel: mwd,
+		client:               &http.Client{Timeout: webhookTimeout},
+		clock:                clock,
+	}
+}
+
+// Notify delivers event to webhooks in background.
+func (n *WebhookNotifier) Notify(e Event) {
+	name, ok := webhookEvents[e.Type]
+	if !ok {
+		return
+	}
+	go n.dispatch(name, e)
+}
+
+// dispatch creates delivery for every subscribed webhook and makes first attempt.
+func (n *WebhookNotifier) dispatch(name string, e Event) {
+	webhooks, err := n.webhookModel.GetActiveByEvent(name)
+	if err != nil {
+		log.Errorf("unable to get webhooks for %s: %s", name, err)
+		return
+	}
+	payload := newWebhookPayload(name, e, n.clock.Now())
+	for i := range webhooks {
+		w := &webhooks[i]
+		d, err := newDelivery(n.webhookDeliveryModel, w, payload)
+		if err != nil {
+			log.Errorf("unable to save delivery for webhook %d: %s", w.ID, err)
+			continue
+		}
+		go n.attempt(w, d)
+	}
+}
+
+// attempt sends delivery once and schedules next attempt with exponential backoff until attempts are over.
+func (n *WebhookNotifier) attempt(w *models.Webhook, d *models.WebhookDelivery) {
+	ok := postWebhook(n.client, w, d)
+	d.UpdatedAt = n.clock.Now()
+	d.NextAttemptAt = nil
+	if !ok && d.Attempts < webhookMaxAttempts {
+		next := d.UpdatedAt.Add(webhookBackoff << (d.Attempts - 1))
+		d.NextAttemptAt = &next
+	}
+	err := n.webhookDeliveryModel.Update(d)
+	if err != nil {
+		log.Errorf("unable to update delivery %d: %s", d.ID, err)
+	}
+}
+
+// RetryPending makes next attempt of failed deliveries which are due.
+func (n *WebhookNotifier) RetryPending() {
+	deliveries, err := n.webhookDeliveryModel.GetPending(n.clock.Now(), webhookRetryBatch)
+	if err != nil {
+		log.Errorf("unable to get pending deliveries: %s", err)
+		return
+	}
+	for i := range deliveries {
+		d := &deliveries[i]
+		n.attempt(&d.Webhook, d)
+	}
+}
+
+func (a *App) getWebhook(userID, webhookID int) (*models.Webhook, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	webhook, ok := a.webhookModel.Get(webhookID)
+	if !ok {
+		return nil, ErrWebhookNotFound
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooks returns all webhooks.
+func (a *App) GetWebhooks(userID int) ([]models.Webhook, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.webhookModel.GetAll()
+}
+
+// CreatedWebhook webhook with its secret, which is shown only once.
+type CreatedWebhook struct {
+	models.Webhook
+	Secret string `json:"secret"`
+}
+
+// CreateWebhook creates webhook with generated secret.
+func (a *App) CreateWebhook(userID int, url string, events []string) (*CreatedWebhook, error) {
+	err := a.requireAdmin(userID)
+	if err != nil {
+		return nil, err
+	}
+	err = validateWebhook(url, events)
+	if err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+	webhook := &models.Webhook{
+		URL:       url,
+		Secret:    hex.EncodeToString(secret),
+		Events:    events,
+		Active:    true,
+		CreatedAt: a.clock.Now(),
+	}
+	err = a.webhookModel.Create(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatedWebhook{Webhook: *webhook, Secret: webhook.Secret}, nil
+}
+
+// UpdateWebhook updates url, events and activity of webhook.
+func (a *App) UpdateWebhook(userID, webhookID int, url string, events []string, active bool) (*models.Webhook, error) {
+	webhook, err := a.getWebhook(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	err = validateWebhook(url, events)
+	if err != nil {
+		return nil, err
+	}
+	webhook.URL = url
+	webhook.Events = events
+	webhook.Active = active
+	err = a.webhookModel.Update(webhook)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhook, nil
+}
+
+// DeleteWebhook deletes webhook.
+func (a *App) DeleteWebhook(userID, webhookID int) error {
+	webhook, err := a.getWebhook(userID, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return a.webhookModel.Delete(webhook)
+}
+
+// GetWebhookDeliveries returns page of webhook delivery log, newest first.
+func (a *App) GetWebhookDeliveries(userID, webhookID, cursor, limit int) ([]models.WebhookDelivery, int, bool, error) {
+	_, err := a.getWebhook(userID, webhookID)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	deliveries, err := a.webhookDeliveryModel.GetAllByWebhook(webhookID, cursor, pageFetchLimit(limit))
+	if err != nil {
+		return nil, 0, false, err
+	}
+	n, next, hasNext := cutPage(len(deliveries), limit, func(i int) int { return deliveries[i].ID })
+
+	return deliveries[:n], next, hasNext, nil
+}
+
+// SendTestWebhook sends test event to webhook once and returns delivery result.
+func (a *App) SendTestWebhook(userID, webhookID int) (*models.WebhookDelivery, error) {
+	webhook, err := a.getWebhook(userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	payload := WebhookPayload{
+		Event:     webhookTestEvent,
+		Data:      map[string]interface{}{"webhook": webhook.ID},
+		CreatedAt: a.clock.Now(),
+	}
+	d, err := newDelivery(a.webhookDeliveryModel, webhook, payload)
+	if err != nil {
+		return nil, err
+	}
+	postWebhook(a.webhookClient, webhook, d)
+	d.UpdatedAt = a.clock.Now()
+	err = a.webhookDeliveryModel.Update(d)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
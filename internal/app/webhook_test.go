@@ -0,0 +1,213 @@
+package app
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type WebhookSuite struct {
+	suite.Suite
+	mockUserCtl            *gomock.Controller
+	mockUser               *mocks.MockUserImpl
+	mockWebhookCtl         *gomock.Controller
+	mockWebhook            *mocks.MockWebhookImpl
+	mockWebhookDeliveryCtl *gomock.Controller
+	mockWebhookDelivery    *mocks.MockWebhookDeliveryImpl
+	clock                  clockwork.FakeClock
+	app                    *App
+}
+
+func (s *WebhookSuite) SetupTest() {
+	s.mockUserCtl = gomock.NewController(s.T())
+	s.mockUser = mocks.NewMockUserImpl(s.mockUserCtl)
+	s.mockWebhookCtl = gomock.NewController(s.T())
+	s.mockWebhook = mocks.NewMockWebhookImpl(s.mockWebhookCtl)
+	s.mockWebhookDeliveryCtl = gomock.NewController(s.T())
+	s.mockWebhookDelivery = mocks.NewMockWebhookDeliveryImpl(s.mockWebhookDeliveryCtl)
+	s.clock = clockwork.NewFakeClock()
+	s.app = New(nil, s.mockUser, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.mockWebhook, s.mockWebhookDelivery, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, s.clock, "", nil)
+}
+
+func (s *WebhookSuite) TearDownTest() {
+	s.mockUserCtl.Finish()
+	s.mockWebhookCtl.Finish()
+	s.mockWebhookDeliveryCtl.Finish()
+}
+
+func (s *WebhookSuite) TestAttemptSchedulesRetry() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	n := NewWebhookNotifier(s.mockWebhook, s.mockWebhookDelivery, s.clock)
+	n.client = server.Client()
+	delivery := &models.WebhookDelivery{ID: 3, Attempts: 1, Payload: `{}`}
+	s.mockWebhookDelivery.EXPECT().Update(delivery).Return(nil)
+
+	n.attempt(&models.Webhook{URL: server.URL}, delivery)
+
+	s.Require().Equal(2, delivery.Attempts)
+	s.Require().False(delivery.Delivered)
+	s.Require().Equal("500 Internal Server Error", delivery.Error)
+	s.Require().Equal(s.clock.Now().Add(2*webhookBackoff), *delivery.NextAttemptAt)
+}
+
+func (s *WebhookSuite) TestAttemptGiveUp() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	n := NewWebhookNotifier(s.mockWebhook, s.mockWebhookDelivery, s.clock)
+	n.client = server.Client()
+	now := s.clock.Now()
+	delivery := &models.WebhookDelivery{
+		ID:            3,
+		Attempts:      webhookMaxAttempts - 1,
+		Payload:       `{}`,
+		NextAttemptAt: &now,
+	}
+	s.mockWebhookDelivery.EXPECT().Update(delivery).Return(nil)
+
+	n.attempt(&models.Webhook{URL: server.URL}, delivery)
+
+	s.Require().Equal(webhookMaxAttempts, delivery.Attempts)
+	s.Require().False(delivery.Delivered)
+	s.Require().Equal("502 Bad Gateway", delivery.Error)
+	s.Require().Nil(delivery.NextAttemptAt)
+}
+
+func (s *WebhookSuite) TestRetryPending() {
+	var signature, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		signature = r.Header.Get("X-Launchpad-Signature")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	}))
+	defer server.Close()
+
+	n := NewWebhookNotifier(s.mockWebhook, s.mockWebhookDelivery, s.clock)
+	n.client = server.Client()
+	now := s.clock.Now()
+	s.mockWebhookDelivery.EXPECT().GetPending(s.clock.Now(), webhookRetryBatch).Return([]models.WebhookDelivery{{
+		ID:            3,
+		Webhook:       models.Webhook{ID: 1, URL: server.URL, Secret: "secret"},
+		WebhookID:     1,
+		Event:         "project.locked",
+		Payload:       `{"event":"project.locked"}`,
+		Attempts:      1,
+		NextAttemptAt: &now,
+	}}, nil)
+	var updated *models.WebhookDelivery
+	s.mockWebhookDelivery.EXPECT().Update(gomock.Any()).DoAndReturn(func(d *models.WebhookDelivery) error {
+		updated = d
+		return nil
+	})
+
+	n.RetryPending()
+
+	s.Require().Equal(2, updated.Attempts)
+	s.Require().True(updated.Delivered)
+	s.Require().Equal(http.StatusOK, updated.StatusCode)
+	s.Require().Empty(updated.Error)
+	s.Require().Nil(updated.NextAttemptAt)
+	s.Require().Equal(`{"event":"project.locked"}`, body)
+	s.Require().Equal("sha256="+signWebhook("secret", []byte(body)), signature)
+}
+
+func (s *WebhookSuite) TestNewWebhookPayload() {
+	now := time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC)
+	e := Event{
+		Type:      EventParticipantJoined,
+		ProjectID: 10,
+		UserIDs:   []int{42},
+		Data:      map[string]interface{}{"donation": 3, "user": 5, "anonymous": true},
+	}
+	payload := newWebhookPayload("donation.created", e, now)
+	s.Require().Equal(WebhookPayload{
+		Event:     "donation.created",
+		ProjectID: 10,
+		Data:      map[string]interface{}{"donation": 3, "anonymous": true},
+		CreatedAt: now,
+	}, payload)
+	s.Require().Contains(e.Data, "user")
+
+	payload = newWebhookPayload("project.closed", Event{Type: EventProjectFailed, ProjectID: 10}, now)
+	s.Require().Equal(map[string]interface{}{"success": false}, payload.Data)
+}
+
+func (s *WebhookSuite) TestCreateWebhookNotAdmin() {
+	s.mockUser.EXPECT().Get(5).Return(&models.User{ID: 5}, true)
+
+	webhook, err := s.app.CreateWebhook(5, "https://chat.example.com/hook", []string{"project.closed"})
+	s.Require().Equal(ErrAdminRequired, err)
+	s.Require().Nil(webhook)
+}
+
+func (s *WebhookSuite) TestCreateWebhook() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockWebhook.EXPECT().Create(gomock.Any()).Return(nil)
+
+	webhook, err := s.app.CreateWebhook(1, "https://chat.example.com/hook", []string{"project.closed"})
+	s.Require().NoError(err)
+	s.Require().Equal("https://chat.example.com/hook", webhook.URL)
+	s.Require().Equal([]string{"project.closed"}, webhook.Events)
+	s.Require().True(webhook.Active)
+	s.Require().Len(webhook.Secret, 64)
+}
+
+func (s *WebhookSuite) TestCreateWebhookInvalid() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+
+	_, err := s.app.CreateWebhook(1, "ftp://example.com", []string{"project.deleted"})
+	vErr, ok := err.(*ValidationError)
+	s.Require().True(ok)
+	s.Require().Equal([]FieldError{
+		{Field: "url", Code: CodeNotAllowed, Message: "url must be absolute http or https address"},
+		{Field: "events", Code: CodeNotAllowed, Message: `unknown event "project.deleted"`},
+	}, vErr.Fields)
+}
+
+func (s *WebhookSuite) TestSendTestWebhook() {
+	var event string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event = r.Header.Get("X-Launchpad-Event")
+	}))
+	defer server.Close()
+	s.app.webhookClient = server.Client()
+
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockWebhook.EXPECT().Get(2).Return(&models.Webhook{ID: 2, URL: server.URL, Secret: "secret"}, true)
+	s.mockWebhookDelivery.EXPECT().Create(gomock.Any()).Return(nil)
+	s.mockWebhookDelivery.EXPECT().Update(gomock.Any()).Return(nil)
+
+	delivery, err := s.app.SendTestWebhook(1, 2)
+	s.Require().NoError(err)
+	s.Require().Equal("ping", event)
+	s.Require().Equal(2, delivery.WebhookID)
+	s.Require().Equal(1, delivery.Attempts)
+	s.Require().True(delivery.Delivered)
+}
+
+func (s *WebhookSuite) TestSendTestWebhookNotFound() {
+	s.mockUser.EXPECT().Get(1).Return(&models.User{ID: 1, IsAdmin: true}, true)
+	s.mockWebhook.EXPECT().Get(2).Return(nil, false)
+
+	_, err := s.app.SendTestWebhook(1, 2)
+	s.Require().Equal(ErrWebhookNotFound, err)
+}
+
+func TestWebhookSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSuite))
+}
@@ -0,0 +1,91 @@
+package chat
+
+import (
+	"fmt"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/FreakyGranny/launchpad-api/internal/money"
+	"github.com/labstack/gommon/log"
+)
+
+// Announcer posts published projects to group chat and sends personal payment reminders
+// and news of followed projects.
+type Announcer struct {
+	transport        Transport
+	chatAccountModel models.ChatAccountImpl
+	groupID          string
+}
+
+// NewAnnouncer returns new chat announcer, empty group id disables announcements.
+func NewAnnouncer(t Transport, mca models.ChatAccountImpl, groupID string) *Announcer {
+	return &Announcer{
+		transport:        t,
+		chatAccountModel: mca,
+		groupID:          groupID,
+	}
+}
+
+// Notify sends event to chat in background.
+func (n *Announcer) Notify(e app.Event) {
+	switch e.Type {
+	case app.EventProjectPublished:
+		if n.groupID != "" {
+			go n.announce(e)
+		}
+	case app.EventPaymentDue:
+		go n.remind(e)
+	case app.EventFollowedActivity:
+		go n.sendPersonal(e, followedText(e))
+	}
+}
+
+func (n *Announcer) announce(e app.Event) {
+	title, _ := e.Data["title"].(string)
+	text := fmt.Sprintf("New project %q is published! Join it with /join %d", title, e.ProjectID)
+	err := n.transport.Send(n.groupID, text)
+	if err != nil {
+		log.Errorf("unable to announce project %d: %s", e.ProjectID, err)
+	}
+}
+
+func (n *Announcer) remind(e app.Event) {
+	title, _ := e.Data["title"].(string)
+	amount, _ := e.Data["amount"].(int64)
+	currency, _ := e.Data["currency"].(string)
+	n.sendPersonal(e, fmt.Sprintf("Please pay %s for project %q.", money.New(amount, currency), title))
+}
+
+// followedText returns message about activity of followed project.
+func followedText(e app.Event) string {
+	title, _ := e.Data["title"].(string)
+	projectTitle, _ := e.Data["project_title"].(string)
+	activity, _ := e.Data["activity"].(string)
+	switch app.EventType(activity) {
+	case app.EventProjectPublished:
+		return fmt.Sprintf("New project %q is published! Join it with /join %d", projectTitle, e.ProjectID)
+	case app.EventUpdatePosted:
+		return fmt.Sprintf("Project %q posted update %q.", projectTitle, title)
+	case app.EventProjectLocked:
+		return fmt.Sprintf("Project %q reached its goal.", projectTitle)
+	case app.EventProjectSucceeded:
+		return fmt.Sprintf("Project %q is successfully finished.", projectTitle)
+	default:
+		return fmt.Sprintf("Project %q is closed without reaching its goal.", projectTitle)
+	}
+}
+
+// sendPersonal sends text to linked chat accounts of event addressees.
+func (n *Announcer) sendPersonal(e app.Event, text string) {
+	accounts, err := n.chatAccountModel.GetByUsers(e.UserIDs)
+	if err != nil {
+		log.Errorf("unable to get chat accounts: %s", err)
+		return
+	}
+	for _, account := range accounts {
+		err = n.transport.Send(account.ChatUserID, text)
+		if err != nil {
+			log.Errorf("unable to send %s message to user %d: %s", e.Type, account.UserID, err)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/mocks"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type AnnouncerSuite struct {
+	suite.Suite
+	mockChatAccountCtl *gomock.Controller
+	mockChatAccount    *mocks.MockChatAccountImpl
+	transport          *fakeTransport
+}
+
+func (s *AnnouncerSuite) SetupTest() {
+	s.mockChatAccountCtl = gomock.NewController(s.T())
+	s.mockChatAccount = mocks.NewMockChatAccountImpl(s.mockChatAccountCtl)
+	s.transport = &fakeTransport{}
+}
+
+func (s *AnnouncerSuite) TearDownTest() {
+	s.mockChatAccountCtl.Finish()
+}
+
+func (s *AnnouncerSuite) TestAnnounce() {
+	n := NewAnnouncer(s.transport, s.mockChatAccount, "-1")
+	n.announce(app.Event{Type: app.EventProjectPublished, ProjectID: 5, Data: map[string]interface{}{"title": "Pizza"}})
+
+	s.Require().Equal([]sentMessage{{ChatID: "-1", Text: `New project "Pizza" is published! Join it with /join 5`}}, s.transport.sent)
+}
+
+func (s *AnnouncerSuite) TestRemind() {
+	n := NewAnnouncer(s.transport, s.mockChatAccount, "")
+	s.mockChatAccount.EXPECT().GetByUsers([]int{1, 2}).Return([]models.ChatAccount{{UserID: 1, ChatUserID: "100"}}, nil)
+	n.remind(app.Event{
+		Type:      app.EventPaymentDue,
+		ProjectID: 5,
+		UserIDs:   []int{1, 2},
+		Data:      map[string]interface{}{"title": "Pizza", "amount": int64(1050), "currency": "RUB"},
+	})
+
+	s.Require().Equal([]sentMessage{{ChatID: "100", Text: `Please pay 10.50 RUB for project "Pizza".`}}, s.transport.sent)
+}
+
+func (s *AnnouncerSuite) TestFollowedActivity() {
+	n := NewAnnouncer(s.transport, s.mockChatAccount, "")
+	s.mockChatAccount.EXPECT().GetByUsers([]int{3}).Return([]models.ChatAccount{{UserID: 3, ChatUserID: "300"}}, nil)
+	e := app.Event{
+		Type:      app.EventFollowedActivity,
+		ProjectID: 5,
+		UserIDs:   []int{3},
+		Data:      map[string]interface{}{"title": "Dough is ready", "project_title": "Pizza", "activity": "update_posted"},
+	}
+	n.sendPersonal(e, followedText(e))
+
+	s.Require().Equal([]sentMessage{{ChatID: "300", Text: `Project "Pizza" posted update "Dough is ready".`}}, s.transport.sent)
+}
+
+func TestAnnouncerSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncerSuite))
+}
@@ -0,0 +1,178 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/FreakyGranny/launchpad-api/internal/money"
+	"github.com/labstack/gommon/log"
+)
+
+const helpText = `Commands:
+/join <project id> [amount] - join project
+/leave <project id> - leave project
+/link <code> - link chat account to launchpad, code is shown in launchpad profile`
+
+// Bot handles chat commands.
+type Bot struct {
+	transport Transport
+	app       app.Application
+}
+
+// NewBot returns new chat bot.
+func NewBot(t Transport, a app.Application) *Bot {
+	return &Bot{
+		transport: t,
+		app:       a,
+	}
+}
+
+// Run handles incoming messages until context is done.
+func (b *Bot) Run(ctx context.Context) {
+	for u := range b.transport.Updates(ctx) {
+		reply := b.handle(u)
+		if reply == "" {
+			continue
+		}
+		err := b.transport.Send(u.ChatID, reply)
+		if err != nil {
+			log.Errorf("unable to reply to chat %s: %s", u.ChatID, err)
+		}
+	}
+	log.Info("stop chat bot")
+}
+
+// handle returns reply to message, messages without command are ignored.
+func (b *Bot) handle(u Update) string {
+	args := strings.Fields(u.Text)
+	if len(args) == 0 || !strings.HasPrefix(args[0], "/") {
+		return ""
+	}
+	// commands in group chats could be addressed as /join@bot_name
+	command := strings.SplitN(args[0], "@", 2)[0]
+	args = args[1:]
+
+	switch command {
+	case "/start", "/help":
+		return helpText
+	case "/link":
+		return b.link(u, args)
+	case "/join":
+		return b.join(u, args)
+	case "/leave":
+		return b.leave(u, args)
+	default:
+		return ""
+	}
+}
+
+func (b *Bot) link(u Update, args []string) string {
+	if !u.Private {
+		return "Send /link to me in private chat."
+	}
+	if len(args) != 1 {
+		return "Usage: /link <code>"
+	}
+	_, err := b.app.LinkChatAccount(strings.ToUpper(args[0]), u.UserID, u.Username)
+	if err != nil {
+		return errorReply(err)
+	}
+
+	return "Chat account is linked, now you can join projects from chat."
+}
+
+func (b *Bot) join(u Update, args []string) string {
+	if len(args) < 1 || len(args) > 2 {
+		return "Usage: /join <project id> [amount]"
+	}
+	projectID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errorReply(app.ErrProjectNotFound)
+	}
+	userID, err := b.app.GetUserByChatAccount(u.UserID)
+	if err != nil {
+		return errorReply(err)
+	}
+	project, err := b.app.GetProject(projectID)
+	if err != nil {
+		return errorReply(app.ErrProjectNotFound)
+	}
+	var payment int64
+	if len(args) == 2 {
+		m, err := money.Parse(args[1], project.Currency)
+		if err != nil {
+			return errorReply(err)
+		}
+		payment = m.Amount
+	}
+	_, err = b.app.CreateDonation(userID, projectID, 0, payment, false, "")
+	if err != nil {
+		return errorReply(err)
+	}
+
+	return fmt.Sprintf("You joined project %q.", project.Title)
+}
+
+func (b *Bot) leave(u Update, args []string) string {
+	if len(args) != 1 {
+		return "Usage: /leave <project id>"
+	}
+	projectID, err := strconv.Atoi(args[0])
+	if err != nil {
+		return errorReply(app.ErrProjectNotFound)
+	}
+	userID, err := b.app.GetUserByChatAccount(u.UserID)
+	if err != nil {
+		return errorReply(err)
+	}
+	donations, _, _, err := b.app.GetUserDonations(userID, 0, 0)
+	if err != nil {
+		return errorReply(err)
+	}
+	for _, d := range donations {
+		if d.ProjectID != projectID {
+			continue
+		}
+		err = b.app.DeleteDonation(d.ID, userID)
+		if err != nil {
+			return errorReply(err)
+		}
+		return "You left the project."
+	}
+
+	return "You are not a participant of this project."
+}
+
+// errorReply returns human readable reply for error.
+func errorReply(err error) string {
+	if vErr, ok := err.(*app.ValidationError); ok {
+		messages := make([]string, 0, len(vErr.Fields))
+		for _, f := range vErr.Fields {
+			messages = append(messages, f.Message)
+		}
+		return strings.Join(messages, "; ")
+	}
+	switch err {
+	case app.ErrChatAccountNotLinked:
+		return "Link your account first: get a code in launchpad profile and send /link <code> to me in private chat."
+	case app.ErrChatLinkCodeInvalid:
+		return "Link code is invalid or expired."
+	case app.ErrProjectNotFound:
+		return "Project not found."
+	case models.ErrDonationAlreadyExist:
+		return "You have already joined this project."
+	case models.ErrDonationForbidden:
+		return "Joining this project is not allowed."
+	case app.ErrDonationModifyNotAllowed:
+		return "You can't leave this project anymore."
+	case money.ErrWrongAmount:
+		return "Wrong amount."
+	default:
+		log.Errorf("chat command failed: %s", err)
+		return "Something went wrong, try again later."
+	}
+}
@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type BotSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+	transport  *fakeTransport
+	bot        *Bot
+}
+
+func (s *BotSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+	s.transport = &fakeTransport{}
+	s.bot = NewBot(s.transport, s.mockApp)
+}
+
+func (s *BotSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *BotSuite) TestIgnoreText() {
+	s.Require().Empty(s.bot.handle(Update{Text: "hello"}))
+}
+
+func (s *BotSuite) TestLink() {
+	s.mockApp.EXPECT().LinkChatAccount("ABCD2345", "100", "user").Return(1, nil)
+
+	reply := s.bot.handle(Update{ChatID: "100", UserID: "100", Username: "user", Text: "/link abcd2345", Private: true})
+	s.Require().Equal("Chat account is linked, now you can join projects from chat.", reply)
+}
+
+func (s *BotSuite) TestLinkInGroup() {
+	reply := s.bot.handle(Update{ChatID: "-1", UserID: "100", Text: "/link ABCD2345"})
+	s.Require().Equal("Send /link to me in private chat.", reply)
+}
+
+func (s *BotSuite) TestJoin() {
+	project := &app.ExtendedProject{ID: 5, Title: "Pizza", Currency: "RUB"}
+	s.mockApp.EXPECT().GetUserByChatAccount("100").Return(1, nil)
+	s.mockApp.EXPECT().GetProject(5).Return(project, nil)
+	s.mockApp.EXPECT().CreateDonation(1, 5, 0, int64(1050), false, "").Return(&models.Donation{}, nil)
+
+	reply := s.bot.handle(Update{ChatID: "-1", UserID: "100", Text: "/join@launchpad_bot 5 10.50"})
+	s.Require().Equal(`You joined project "Pizza".`, reply)
+}
+
+func (s *BotSuite) TestJoinNotLinked() {
+	s.mockApp.EXPECT().GetUserByChatAccount("100").Return(0, app.ErrChatAccountNotLinked)
+
+	reply := s.bot.handle(Update{ChatID: "-1", UserID: "100", Text: "/join 5"})
+	s.Require().Equal(errorReply(app.ErrChatAccountNotLinked), reply)
+}
+
+func (s *BotSuite) TestJoinValidation() {
+	project := &app.ExtendedProject{ID: 5, Title: "Pizza", Currency: "RUB"}
+	vErr := &app.ValidationError{Fields: []app.FieldError{{Field: "payment", Code: app.CodeMin, Message: "payment must be at least 100.00 RUB"}}}
+	s.mockApp.EXPECT().GetUserByChatAccount("100").Return(1, nil)
+	s.mockApp.EXPECT().GetProject(5).Return(project, nil)
+	s.mockApp.EXPECT().CreateDonation(1, 5, 0, int64(1000), false, "").Return(nil, vErr)
+
+	reply := s.bot.handle(Update{ChatID: "-1", UserID: "100", Text: "/join 5 10"})
+	s.Require().Equal("payment must be at least 100.00 RUB", reply)
+}
+
+func (s *BotSuite) TestLeave() {
+	s.mockApp.EXPECT().GetUserByChatAccount("100").Return(1, nil)
+	s.mockApp.EXPECT().GetUserDonations(1, 0, 0).Return([]models.Donation{{ID: 3, ProjectID: 4}, {ID: 7, ProjectID: 5}}, 0, false, nil)
+	s.mockApp.EXPECT().DeleteDonation(7, 1).Return(nil)
+
+	reply := s.bot.handle(Update{ChatID: "-1", UserID: "100", Text: "/leave 5"})
+	s.Require().Equal("You left the project.", reply)
+}
+
+func (s *BotSuite) TestRun() {
+	s.transport.updates = []Update{
+		{ChatID: "100", UserID: "100", Text: "/help", Private: true},
+		{ChatID: "-1", UserID: "100", Text: "hi all"},
+	}
+
+	s.bot.Run(context.Background())
+	s.Require().Equal([]sentMessage{{ChatID: "100", Text: helpText}}, s.transport.sent)
+}
+
+func TestBotSuite(t *testing.T) {
+	suite.Run(t, new(BotSuite))
+}
@@ -0,0 +1,35 @@
+package chat
+
+import (
+	"context"
+	"sync"
+)
+
+type sentMessage struct {
+	ChatID string
+	Text   string
+}
+
+type fakeTransport struct {
+	mu      sync.Mutex
+	updates []Update
+	sent    []sentMessage
+}
+
+func (t *fakeTransport) Updates(ctx context.Context) <-chan Update {
+	out := make(chan Update, len(t.updates))
+	for _, u := range t.updates {
+		out <- u
+	}
+	close(out)
+
+	return out
+}
+
+func (t *fakeTransport) Send(chatID, text string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.sent = append(t.sent, sentMessage{ChatID: chatID, Text: text})
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/config"
+	"github.com/labstack/gommon/log"
+)
+
+const (
+	telegramPollTimeout = 30
+	telegramRetryDelay  = 5 * time.Second
+)
+
+type telegramResponse struct {
+	OK          bool            `json:"ok"`
+	Description string          `json:"description"`
+	Result      json.RawMessage `json:"result"`
+}
+
+type telegramUpdate struct {
+	UpdateID int              `json:"update_id"`
+	Message  *telegramMessage `json:"message"`
+}
+
+type telegramMessage struct {
+	Chat struct {
+		ID   int64  `json:"id"`
+		Type string `json:"type"`
+	} `json:"chat"`
+	From *struct {
+		ID       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"from"`
+	Text string `json:"text"`
+}
+
+// Telegram bot api transport.
+type Telegram struct {
+	client HTTPClient
+	url    string
+}
+
+// NewTelegram returns new telegram transport.
+func NewTelegram(cfg config.Chat) *Telegram {
+	return &Telegram{
+		client: &http.Client{Timeout: (telegramPollTimeout + 10) * time.Second},
+		url:    cfg.APIURL + "/bot" + cfg.Token,
+	}
+}
+
+func (t *Telegram) call(ctx context.Context, req *http.Request, result interface{}) error {
+	resp, err := t.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	tr := &telegramResponse{}
+	err = json.NewDecoder(resp.Body).Decode(tr)
+	if err != nil {
+		return err
+	}
+	if !tr.OK {
+		return errors.New(tr.Description)
+	}
+	if result == nil {
+		return nil
+	}
+
+	return json.Unmarshal(tr.Result, result)
+}
+
+func (t *Telegram) getUpdates(ctx context.Context, offset int) ([]telegramUpdate, error) {
+	q := url.Values{}
+	q.Set("offset", strconv.Itoa(offset))
+	q.Set("timeout", strconv.Itoa(telegramPollTimeout))
+	req, err := http.NewRequest(http.MethodGet, t.url+"/getUpdates?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	updates := make([]telegramUpdate, 0)
+	err = t.call(ctx, req, &updates)
+
+	return updates, err
+}
+
+// Updates polls incoming messages until context is done.
+func (t *Telegram) Updates(ctx context.Context) <-chan Update {
+	out := make(chan Update)
+	go func() {
+		defer close(out)
+		offset := 0
+		for {
+			updates, err := t.getUpdates(ctx, offset)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				log.Errorf("unable to get telegram updates: %s", err)
+				select {
+				case <-time.After(telegramRetryDelay):
+				case <-ctx.Done():
+					return
+				}
+				continue
+			}
+			for _, u := range updates {
+				offset = u.UpdateID + 1
+				if u.Message == nil || u.Message.From == nil || u.Message.Text == "" {
+					continue
+				}
+				update := Update{
+					ChatID:   strconv.FormatInt(u.Message.Chat.ID, 10),
+					UserID:   strconv.FormatInt(u.Message.From.ID, 10),
+					Username: u.Message.From.Username,
+					Text:     u.Message.Text,
+					Private:  u.Message.Chat.Type == "private",
+				}
+				select {
+				case out <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// Send sends text message to chat.
+func (t *Telegram) Send(chatID, text string) error {
+	body, err := json.Marshal(map[string]string{
+		"chat_id": chatID,
+		"text":    text,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, t.url+"/sendMessage", bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	return t.call(context.Background(), req, nil)
+}
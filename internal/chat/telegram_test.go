@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/config"
+)
+
+type TelegramSuite struct {
+	suite.Suite
+}
+
+func (s *TelegramSuite) TestUpdates() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Require().Equal("/bottoken/getUpdates", r.URL.Path)
+		if r.URL.Query().Get("offset") != "0" {
+			w.Write([]byte(`{"ok":true,"result":[]}`))
+			return
+		}
+		w.Write([]byte(`{"ok":true,"result":[
+			{"update_id":10,"message":{"chat":{"id":100,"type":"private"},"from":{"id":100,"username":"user"},"text":"/help"}},
+			{"update_id":11}
+		]}`))
+	}))
+	defer server.Close()
+
+	t := NewTelegram(config.Chat{Token: "token", APIURL: server.URL})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	u := <-t.Updates(ctx)
+	s.Require().Equal(Update{ChatID: "100", UserID: "100", Username: "user", Text: "/help", Private: true}, u)
+}
+
+func (s *TelegramSuite) TestSend() {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.Require().Equal("/bottoken/sendMessage", r.URL.Path)
+		s.Require().NoError(json.NewDecoder(r.Body).Decode(&body))
+		w.Write([]byte(`{"ok":true,"result":{}}`))
+	}))
+	defer server.Close()
+
+	t := NewTelegram(config.Chat{Token: "token", APIURL: server.URL})
+	s.Require().NoError(t.Send("100", "hello"))
+	s.Require().Equal(map[string]string{"chat_id": "100", "text": "hello"}, body)
+}
+
+func (s *TelegramSuite) TestSendError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ok":false,"description":"Bad Request: chat not found"}`))
+	}))
+	defer server.Close()
+
+	t := NewTelegram(config.Chat{Token: "token", APIURL: server.URL})
+	s.Require().EqualError(t.Send("100", "hello"), "Bad Request: chat not found")
+}
+
+func TestTelegramSuite(t *testing.T) {
+	suite.Run(t, new(TelegramSuite))
+}
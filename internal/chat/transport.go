@@ -0,0 +1,27 @@
+package chat
+
+import (
+	"context"
+	"net/http"
+)
+
+// Update incoming chat message.
+type Update struct {
+	ChatID   string
+	UserID   string
+	Username string
+	Text     string
+	Private  bool
+}
+
+// Transport connection to chat messenger.
+// Private chat with user is addressed by user id.
+type Transport interface {
+	Updates(ctx context.Context) <-chan Update
+	Send(chatID, text string) error
+}
+
+// HTTPClient sends http requests.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
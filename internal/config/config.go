@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v6"
 )
 
@@ -21,12 +23,56 @@ type VkAuth struct {
 	RedirectURI  string `env:"VK_REDIRECT_URI,required"`
 }
 
+// SMTP contains variables for email delivery, empty host disables emails
+type SMTP struct {
+	Host     string `env:"SMTP_HOST"`
+	Port     int    `env:"SMTP_PORT" envDefault:"25"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	From     string `env:"SMTP_FROM" envDefault:"launchpad@localhost"`
+}
+
+// Chat contains variables for chat bot, empty token disables bot
+type Chat struct {
+	Token   string `env:"CHAT_BOT_TOKEN"`
+	APIURL  string `env:"CHAT_API_URL" envDefault:"https://api.telegram.org"`
+	GroupID string `env:"CHAT_GROUP_ID"`
+}
+
+// Reminders contains variables for payment reminders on harvest stage
+type Reminders struct {
+	// Delays since project lock when unpaid participants are reminded
+	Delays []time.Duration `env:"PAYMENT_REMINDER_DELAYS" envDefault:"24h,72h,168h" envSeparator:","`
+	// CheckInterval how often harvest projects are checked
+	CheckInterval time.Duration `env:"PAYMENT_REMINDER_CHECK_INTERVAL" envDefault:"1h"`
+	// Grace how long project may stay on harvest stage, zero disables deadline
+	Grace time.Duration `env:"HARVEST_GRACE_PERIOD" envDefault:"336h"`
+	// GraceAction what to do with overdue project: fail or escalate
+	GraceAction string `env:"HARVEST_GRACE_ACTION" envDefault:"escalate"`
+}
+
+// Rankings contains variables for trending and recommended projects
+type Rankings struct {
+	// Window period of donations counted as recent in trending score
+	Window time.Duration `env:"TRENDING_WINDOW" envDefault:"168h"`
+	// Interval how often rankings are recalculated, zero disables them
+	Interval time.Duration `env:"RANKING_INTERVAL" envDefault:"1h"`
+	// Limit how many projects are recommended to every user
+	Limit int `env:"RECOMMENDATION_LIMIT" envDefault:"50"`
+}
+
 // Config all app variables are stored here
 type Config struct {
 	Db        PgConnection
 	Vk        VkAuth
+	SMTP      SMTP
+	Chat      Chat
+	Reminders Reminders
+	Rankings  Rankings
 	DebugMode bool   `env:"DEBUG_MODE" envDefault:"false"`
 	JWTSecret string `env:"JWT_SECRET" envDefault:"secret"`
+	// NotificationRetention how long notifications are kept
+	NotificationRetention time.Duration `env:"NOTIFICATION_RETENTION" envDefault:"2160h"`
 }
 
 // New returns a new Config struct
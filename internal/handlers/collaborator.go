@@ -0,0 +1,235 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+// CollaboratorHandler ...
+type CollaboratorHandler struct {
+	app app.Application
+}
+
+// NewCollaboratorHandler ...
+func NewCollaboratorHandler(a app.Application) *CollaboratorHandler {
+	return &CollaboratorHandler{app: a}
+}
+
+// CollaboratorInviteRequest ...
+type CollaboratorInviteRequest struct {
+	User int    `json:"user"`
+	Role string `json:"role"`
+}
+
+// OwnershipTransferRequest ...
+type OwnershipTransferRequest struct {
+	User int `json:"user"`
+}
+
+// InvitationView pending invitation of user to project.
+type InvitationView struct {
+	ProjectID int       `json:"project"`
+	Title     string    `json:"title"`
+	Role      string    `json:"role"`
+	InvitedBy int       `json:"invited_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// collaboratorErrorResponse maps errors of collaborator management to responses.
+func collaboratorErrorResponse(c echo.Context, err error, msg string) error {
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrCollaboratorNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("collaborator not found"))
+	case app.ErrProjectModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(msg))
+	}
+}
+
+// GetCollaborators godoc
+// @Summary Returns collaborators of project
+// @Description Returns collaborators and pending invitations of project
+// @Tags collaborator
+// @ID get-collaborators
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.Collaborator
+// @Failure 404 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/collaborator [get]
+func (h *CollaboratorHandler) GetCollaborators(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	collaborators, err := h.app.GetCollaborators(userID, projectID)
+	if err != nil {
+		return collaboratorErrorResponse(c, err, "unable to get collaborators")
+	}
+
+	return c.JSON(http.StatusOK, collaborators)
+}
+
+// InviteCollaborator godoc
+// @Summary Invite collaborator to project
+// @Description Invite user as co_owner, treasurer or moderator, only owner can invite
+// @Tags collaborator
+// @ID post-collaborator
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body CollaboratorInviteRequest true "Request body"
+// @Success 201 {object} models.Collaborator
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/collaborator [post]
+func (h *CollaboratorHandler) InviteCollaborator(c echo.Context) error {
+	request := new(CollaboratorInviteRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	collaborator, err := h.app.InviteCollaborator(userID, projectID, request.User, request.Role)
+	if err != nil {
+		return collaboratorErrorResponse(c, err, "unable to invite collaborator")
+	}
+
+	return c.JSON(http.StatusCreated, collaborator)
+}
+
+// RemoveCollaborator godoc
+// @Summary Remove collaborator from project
+// @Description Owner removes collaborator, collaborator leaves project or declines invitation
+// @Tags collaborator
+// @ID delete-collaborator
+// @Param id path int true "Project ID"
+// @Param user path int true "User ID"
+// @Success 204
+// @Failure 403 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/collaborator/{user} [delete]
+func (h *CollaboratorHandler) RemoveCollaborator(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+	collaboratorID, _ := strconv.Atoi(c.Param("user"))
+
+	err = h.app.RemoveCollaborator(userID, projectID, collaboratorID)
+	if err != nil {
+		return collaboratorErrorResponse(c, err, "unable to remove collaborator")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AcceptInvitation godoc
+// @Summary Accept invitation to project
+// @Description Accept pending invitation, role takes effect after acceptance
+// @Tags collaborator
+// @ID accept-invitation
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} models.Collaborator
+// @Failure 404 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/collaborator/accept [post]
+func (h *CollaboratorHandler) AcceptInvitation(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	collaborator, err := h.app.AcceptInvitation(userID, projectID)
+	if err != nil {
+		return collaboratorErrorResponse(c, err, "unable to accept invitation")
+	}
+
+	return c.JSON(http.StatusOK, collaborator)
+}
+
+// TransferOwnership godoc
+// @Summary Transfer ownership of project
+// @Description Make accepted collaborator owner of project, previous owner becomes co_owner
+// @Tags collaborator
+// @ID transfer-ownership
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body OwnershipTransferRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/transfer [post]
+func (h *CollaboratorHandler) TransferOwnership(c echo.Context) error {
+	request := new(OwnershipTransferRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	project, err := h.app.TransferOwnership(userID, projectID, request.User)
+	if err != nil {
+		return collaboratorErrorResponse(c, err, "unable to transfer ownership")
+	}
+
+	return c.JSON(http.StatusOK, project)
+}
+
+// GetInvitations godoc
+// @Summary Returns invitations of user
+// @Description Returns pending invitations of current user to manage projects
+// @Tags collaborator
+// @ID get-invitations
+// @Produce json
+// @Success 200 {array} InvitationView
+// @Security Bearer
+// @Router /invitation [get]
+func (h *CollaboratorHandler) GetInvitations(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	invitations, err := h.app.GetInvitations(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get invitations"))
+	}
+	views := make([]InvitationView, 0, len(invitations))
+	for _, inv := range invitations {
+		views = append(views, InvitationView{
+			ProjectID: inv.ProjectID,
+			Title:     inv.Project.Title,
+			Role:      inv.Role,
+			InvitedBy: inv.InvitedBy,
+			CreatedAt: inv.CreatedAt,
+		})
+	}
+
+	return c.JSON(http.StatusOK, views)
+}
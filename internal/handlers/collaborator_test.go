@@ -0,0 +1,178 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type CollaboratorSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *CollaboratorSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *CollaboratorSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *CollaboratorSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *CollaboratorSuite) TestInviteCollaborator() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"user":7,"role":"treasurer"}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/collaborator")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 42)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	created := time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC)
+	s.mockApp.EXPECT().InviteCollaborator(42, 10, 7, "treasurer").Return(&models.Collaborator{
+		ProjectID: 10,
+		UserID:    7,
+		Role:      "treasurer",
+		InvitedBy: 42,
+		CreatedAt: created,
+	}, nil)
+	s.Require().NoError(h.InviteCollaborator(c))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	s.Require().Contains(rec.Body.String(), `"role":"treasurer","invited_by":42`)
+}
+
+func (s *CollaboratorSuite) TestInviteCollaboratorInvalid() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"user":7,"role":"king"}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/collaborator")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 42)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	verr := &app.ValidationError{}
+	verr.Fields = []app.FieldError{{Field: "role", Code: app.CodeInvalid, Message: `unknown role "king"`}}
+	s.mockApp.EXPECT().InviteCollaborator(42, 10, 7, "king").Return(nil, verr)
+	s.Require().NoError(h.InviteCollaborator(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *CollaboratorSuite) TestInviteCollaboratorNotOwner() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"user":7,"role":"moderator"}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/collaborator")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 8)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	s.mockApp.EXPECT().InviteCollaborator(8, 10, 7, "moderator").Return(nil, app.ErrProjectModifyNotAllowed)
+	s.Require().NoError(h.InviteCollaborator(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *CollaboratorSuite) TestAcceptInvitationNotFound() {
+	req := httptest.NewRequest(echo.POST, "/", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/collaborator/accept")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 7)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	s.mockApp.EXPECT().AcceptInvitation(7, 10).Return(nil, app.ErrCollaboratorNotFound)
+	s.Require().NoError(h.AcceptInvitation(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *CollaboratorSuite) TestRemoveCollaborator() {
+	req := httptest.NewRequest(echo.DELETE, "/", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/collaborator/:user")
+	c.SetParamNames("id", "user")
+	c.SetParamValues("10", "7")
+	s.setUser(c, 42)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	s.mockApp.EXPECT().RemoveCollaborator(42, 10, 7).Return(nil)
+	s.Require().NoError(h.RemoveCollaborator(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *CollaboratorSuite) TestTransferOwnership() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"user":7}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/transfer")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 42)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	s.mockApp.EXPECT().TransferOwnership(42, 10, 7).Return(&app.ExtendedProject{ID: 10, Owner: models.User{ID: 7}}, nil)
+	s.Require().NoError(h.TransferOwnership(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *CollaboratorSuite) TestGetInvitations() {
+	req := httptest.NewRequest(echo.GET, "/invitation", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/invitation")
+	s.setUser(c, 7)
+
+	h := NewCollaboratorHandler(s.mockApp)
+	s.mockApp.EXPECT().GetInvitations(7).Return([]models.Collaborator{{
+		ProjectID: 10,
+		UserID:    7,
+		Project:   models.Project{ID: 10, Title: "Pizza"},
+		Role:      "moderator",
+		InvitedBy: 42,
+		CreatedAt: time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC),
+	}}, nil)
+	s.Require().NoError(h.GetInvitations(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var iJSON = `[{"project":10,"title":"Pizza","role":"moderator","invited_by":42,"created_at":"2020-10-07T12:00:00Z"}]`
+	s.Require().Equal(iJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func TestCollaboratorSuite(t *testing.T) {
+	suite.Run(t, new(CollaboratorSuite))
+}
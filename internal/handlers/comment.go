@@ -0,0 +1,226 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// CommentHandler ...
+type CommentHandler struct {
+	app app.Application
+}
+
+// NewCommentHandler ...
+func NewCommentHandler(a app.Application) *CommentHandler {
+	return &CommentHandler{app: a}
+}
+
+// CommentCreateRequest ...
+type CommentCreateRequest struct {
+	ProjectID int    `json:"project"`
+	ParentID  int    `json:"parent,omitempty"`
+	Text      string `json:"text"`
+}
+
+// CommentUpdateRequest ...
+type CommentUpdateRequest struct {
+	Text string `json:"text"`
+}
+
+// CommentListResponse ...
+type CommentListResponse struct {
+	Results    []models.Comment `json:"results"`
+	NextCursor string           `json:"next_cursor"`
+	HasNext    bool             `json:"has_next"`
+}
+
+// GetProjectComments godoc
+// @Summary Returns project comments
+// @Description Returns top level comments of project with count of replies, oldest first
+// @Tags comment
+// @ID get-project-comments
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} CommentListResponse
+// @Security Bearer
+// @Router /comment/project/{id} [get]
+func (h *CommentHandler) GetProjectComments(c echo.Context) error {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+
+	comments, next, hasNext, err := h.app.GetProjectComments(projectID, userID, cursor, limit)
+	switch err {
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case nil:
+		return c.JSON(http.StatusOK, CommentListResponse{
+			Results:    comments,
+			NextCursor: idCursor(next),
+			HasNext:    hasNext,
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get comments"))
+	}
+}
+
+// GetCommentReplies godoc
+// @Summary Returns comment replies
+// @Description Returns replies to top level comment, oldest first
+// @Tags comment
+// @ID get-comment-replies
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} CommentListResponse
+// @Security Bearer
+// @Router /comment/{id}/replies [get]
+func (h *CommentHandler) GetCommentReplies(c echo.Context) error {
+	commentID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+
+	comments, next, hasNext, err := h.app.GetCommentReplies(commentID, userID, cursor, limit)
+	switch err {
+	case app.ErrCommentNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("comment not found"))
+	case nil:
+		return c.JSON(http.StatusOK, CommentListResponse{
+			Results:    comments,
+			NextCursor: idCursor(next),
+			HasNext:    hasNext,
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get comments"))
+	}
+}
+
+// CreateComment godoc
+// @Summary Create comment
+// @Description Create comment or reply to comment. Mentioned with @username users are resolved
+// @Tags comment
+// @ID post-comment
+// @Accept json
+// @Produce json
+// @Param request body CommentCreateRequest true "Request body"
+// @Success 201 {object} models.Comment
+// @Security Bearer
+// @Router /comment [post]
+func (h *CommentHandler) CreateComment(c echo.Context) error {
+	request := new(CommentCreateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	comment, err := h.app.CreateComment(userID, request.ProjectID, request.ParentID, request.Text)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+
+	switch err {
+	case nil:
+		return c.JSON(http.StatusCreated, comment)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrCommentNotFound:
+		return c.JSON(http.StatusBadRequest, errorResponse("parent comment not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to create comment"))
+	}
+}
+
+// UpdateComment godoc
+// @Summary Edit comment
+// @Description Edit text of own comment
+// @Tags comment
+// @ID update-comment
+// @Accept json
+// @Produce json
+// @Param request body CommentUpdateRequest true "Request body"
+// @Param id path int true "Comment ID"
+// @Success 200 {object} models.Comment
+// @Security Bearer
+// @Router /comment/{id} [patch]
+func (h *CommentHandler) UpdateComment(c echo.Context) error {
+	request := new(CommentUpdateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	commentID, _ := strconv.Atoi(c.Param("id"))
+	comment, err := h.app.UpdateComment(commentID, userID, request.Text)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, comment)
+	case app.ErrCommentNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("comment not found"))
+	case app.ErrCommentModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to update comment"))
+	}
+}
+
+// DeleteComment godoc
+// @Summary Delete comment
+// @Description Delete comment by author or project owner. Replies are kept
+// @Tags comment
+// @ID delete-comment
+// @Param id path int true "Comment ID"
+// @Success 204
+// @Security Bearer
+// @Router /comment/{id} [delete]
+func (h *CommentHandler) DeleteComment(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	commentID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.DeleteComment(commentID, userID)
+	switch err {
+	case nil:
+		return c.NoContent(http.StatusNoContent)
+	case app.ErrCommentNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("comment not found"))
+	case app.ErrCommentModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to delete comment"))
+	}
+}
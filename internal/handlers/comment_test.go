@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type CommentSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *CommentSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *CommentSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *CommentSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *CommentSuite) TestGetProjectComments() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/comment/project/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	c.QueryParams().Add("cursor", "4")
+	c.QueryParams().Add("limit", "500")
+	s.setUser(c, 5)
+
+	comments := []models.Comment{
+		{
+			ID:        5,
+			ProjectID: 10,
+			User:      models.User{ID: 1, FirstName: "John"},
+			Text:      "@jane hi",
+			Mentions:  []models.User{{ID: 2, Username: "jane"}},
+			Replies:   2,
+			CreatedAt: time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	h := NewCommentHandler(s.mockApp)
+	s.mockApp.EXPECT().GetProjectComments(10, 5, 4, maxCursorLimit).Return(comments, 5, true, nil)
+	s.Require().NoError(h.GetProjectComments(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var cJSON = `{"results":[{"id":5,"project":10,"user":{"id":1,"username":"","first_name":"John","last_name":"","avatar":"","project_count":0,"success_rate":0},"text":"@jane hi","mentions":[{"id":2,"username":"jane","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0}],"replies":2,"deleted":false,"created_at":"2020-08-20T12:00:00Z","edited_at":null}],"next_cursor":"eyJpZCI6NX0","has_next":true}`
+	s.Require().Equal(cJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *CommentSuite) TestGetProjectCommentsNotFound() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/comment/project/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 5)
+
+	h := NewCommentHandler(s.mockApp)
+	s.mockApp.EXPECT().GetProjectComments(10, 5, 0, defaultCursorLimit).Return(nil, 0, false, app.ErrProjectNotFound)
+	s.Require().NoError(h.GetProjectComments(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func (s *CommentSuite) TestCreateComment() {
+	body, err := json.Marshal(CommentCreateRequest{ProjectID: 10, ParentID: 3, Text: "agree"})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/comment")
+	s.setUser(c, 5)
+
+	h := NewCommentHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateComment(5, 10, 3, "agree").Return(&models.Comment{ID: 7, ParentID: 3}, nil)
+	s.Require().NoError(h.CreateComment(c))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+}
+
+func (s *CommentSuite) TestUpdateCommentForbidden() {
+	body, err := json.Marshal(CommentUpdateRequest{Text: "edited"})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.PATCH, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/comment/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+	s.setUser(c, 5)
+
+	h := NewCommentHandler(s.mockApp)
+	s.mockApp.EXPECT().UpdateComment(7, 5, "edited").Return(nil, app.ErrCommentModifyNotAllowed)
+	s.Require().NoError(h.UpdateComment(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *CommentSuite) TestDeleteComment() {
+	req := httptest.NewRequest(echo.DELETE, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/comment/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+	s.setUser(c, 5)
+
+	h := NewCommentHandler(s.mockApp)
+	s.mockApp.EXPECT().DeleteComment(7, 5).Return(nil)
+	s.Require().NoError(h.DeleteComment(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func TestCommentSuite(t *testing.T) {
+	suite.Run(t, new(CommentSuite))
+}
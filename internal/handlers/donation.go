@@ -23,23 +23,65 @@ func NewDonationHandler(a app.Application) *DonationHandler {
 
 // DonationCreateRequest ...
 type DonationCreateRequest struct {
-	ProjectID int `json:"project"`
-	Payment   int `json:"payment"`
+	ProjectID int    `json:"project"`
+	TierID    int    `json:"tier,omitempty"`
+	Payment   int64  `json:"payment"`
+	Anonymous bool   `json:"anonymous,omitempty"`
+	Message   string `json:"message,omitempty"`
 }
 
 // DonationUpdateRequest ...
 type DonationUpdateRequest struct {
-	Paid    bool `json:"paid,omitempty"`
-	Payment int  `json:"payment,omitempty"`
+	Paid    bool  `json:"paid,omitempty"`
+	Payment int64 `json:"payment,omitempty"`
+}
+
+// DonationAnonymityRequest ...
+type DonationAnonymityRequest struct {
+	Anonymous bool `json:"anonymous"`
+}
+
+// DonationMessageRequest ...
+type DonationMessageRequest struct {
+	Message string `json:"message"`
+}
+
+// MessageHideRequest ...
+type MessageHideRequest struct {
+	Hidden bool `json:"hidden"`
+}
+
+// GuestbookResponse ...
+type GuestbookResponse struct {
+	Results  []app.GuestbookEntry `json:"results"`
+	NextPage int                  `json:"next"`
+	HasNext  bool                 `json:"has_next"`
+}
+
+// DonationListResponse page of user donations
+type DonationListResponse struct {
+	Results    []models.Donation `json:"results"`
+	NextCursor string            `json:"next_cursor"`
+	HasNext    bool              `json:"has_next"`
+}
+
+// ShortDonationListResponse page of project donations
+type ShortDonationListResponse struct {
+	Results    []app.ShortDonation `json:"results"`
+	NextCursor string              `json:"next_cursor"`
+	HasNext    bool                `json:"has_next"`
 }
 
 // GetUserDonations godoc
 // @Summary Returns list of user's donations
-// @Description Returns list of user's donations
+// @Description Returns list of user's donations.
+// @Description With cursor or limit params returns page of donations, otherwise all donations as array.
 // @Tags donation
 // @ID get-user-donations
 // @Produce json
-// @Success 200 {object} []models.Donation
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} DonationListResponse
 // @Security Bearer
 // @Router /donation [get]
 func (h *DonationHandler) GetUserDonations(c echo.Context) error {
@@ -47,22 +89,40 @@ func (h *DonationHandler) GetUserDonations(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, err)
 	}
-	donations, err := h.app.GetUserDonations(userID)
+	if !isCursorMode(c) {
+		donations, _, _, err := h.app.GetUserDonations(userID, 0, 0)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, err)
+		}
+		return c.JSON(http.StatusOK, donations)
+	}
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+	donations, next, hasNext, err := h.app.GetUserDonations(userID, cursor, limit)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, donations)
+	return c.JSON(http.StatusOK, DonationListResponse{
+		Results:    donations,
+		NextCursor: idCursor(next),
+		HasNext:    hasNext,
+	})
 }
 
 // GetProjectDonations godoc
 // @Summary Returns list of project donations
-// @Description Returns list of project donations
+// @Description Returns list of project donations. Anonymous donors and private amounts are visible only to project owner and donor.
+// @Description With cursor or limit params returns page of donations, otherwise all donations as array.
 // @Tags donation
 // @ID get-project-donations
 // @Produce json
 // @Param id path int true "Project ID"
-// @Success 200 {object} []app.ShortDonation
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} ShortDonationListResponse
 // @Security Bearer
 // @Router /donation/project/{id} [get]
 func (h *DonationHandler) GetProjectDonations(c echo.Context) error {
@@ -70,12 +130,34 @@ func (h *DonationHandler) GetProjectDonations(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
 	}
-	donations, err := h.app.GetProjectDonations(intID)
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+	cursorMode := isCursorMode(c)
+	if !cursorMode {
+		limit = 0
+	}
+	donations, next, hasNext, err := h.app.GetProjectDonations(intID, userID, cursor, limit)
+	if err == app.ErrProjectNotFound {
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err)
 	}
+	if !cursorMode {
+		return c.JSON(http.StatusOK, donations)
+	}
 
-	return c.JSON(http.StatusOK, donations)
+	return c.JSON(http.StatusOK, ShortDonationListResponse{
+		Results:    donations,
+		NextCursor: idCursor(next),
+		HasNext:    hasNext,
+	})
 }
 
 // CreateDonation godoc
@@ -98,7 +180,10 @@ func (h *DonationHandler) CreateDonation(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, err)
 	}
-	donation, err := h.app.CreateDonation(userID, request.ProjectID, request.Payment)
+	donation, err := h.app.CreateDonation(userID, request.ProjectID, request.TierID, request.Payment, request.Anonymous, request.Message)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
 
 	switch err {
 	case nil:
@@ -109,6 +194,14 @@ func (h *DonationHandler) CreateDonation(c echo.Context) error {
 		return c.JSON(http.StatusForbidden, err)
 	case models.ErrUserNotFound:
 		return c.JSON(http.StatusBadRequest, err)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrTierNotFound:
+		return c.JSON(http.StatusBadRequest, errorResponse("tier not found"))
+	case models.ErrTierSoldOut:
+		return c.JSON(http.StatusConflict, errorResponse("all rewards of tier are taken"))
+	case app.ErrMessageRateLimit:
+		return c.JSON(http.StatusTooManyRequests, errorResponse("too many messages"))
 	default:
 		return c.JSON(http.StatusInternalServerError, err)
 	}
@@ -166,6 +259,9 @@ func (h *DonationHandler) UpdateDonation(c echo.Context) error {
 	}
 	donationID, _ := strconv.Atoi(c.Param("id"))
 	donation, err := h.app.UpdateDonation(donationID, userID, request.Payment, request.Paid)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
 
 	switch err {
 	case app.ErrDonationNotFound:
@@ -180,3 +276,239 @@ func (h *DonationHandler) UpdateDonation(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
 	}
 }
+
+// SetDonationAnonymity godoc
+// @Summary Hide or show donor
+// @Description Hide donor from other participants of project. Owner of project still sees donor
+// @Tags donation
+// @ID set-donation-anonymity
+// @Accept json
+// @Produce json
+// @Param request body DonationAnonymityRequest true "Request body"
+// @Param id path int true "Donation ID"
+// @Success 200 {object} models.Donation
+// @Security Bearer
+// @Router /donation/{id}/anonymity [put]
+func (h *DonationHandler) SetDonationAnonymity(c echo.Context) error {
+	request := new(DonationAnonymityRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+	}
+	donationID, _ := strconv.Atoi(c.Param("id"))
+	donation, err := h.app.SetDonationAnonymity(donationID, userID, request.Anonymous)
+
+	switch err {
+	case app.ErrDonationNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("donation not found"))
+	case app.ErrDonationModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case nil:
+		return c.JSON(http.StatusOK, donation)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
+
+// SetDonationMessage godoc
+// @Summary Set donation message
+// @Description Set, change or clear message of not locked donation
+// @Tags donation
+// @ID set-donation-message
+// @Accept json
+// @Produce json
+// @Param request body DonationMessageRequest true "Request body"
+// @Param id path int true "Donation ID"
+// @Success 200 {object} models.Donation
+// @Security Bearer
+// @Router /donation/{id}/message [put]
+func (h *DonationHandler) SetDonationMessage(c echo.Context) error {
+	request := new(DonationMessageRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+	}
+	donationID, _ := strconv.Atoi(c.Param("id"))
+	donation, err := h.app.SetDonationMessage(donationID, userID, request.Message)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+
+	switch err {
+	case app.ErrDonationNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("donation not found"))
+	case app.ErrDonationModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case app.ErrMessageRateLimit:
+		return c.JSON(http.StatusTooManyRequests, errorResponse("too many messages"))
+	case nil:
+		return c.JSON(http.StatusOK, donation)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
+
+// HideDonationMessage godoc
+// @Summary Hide donation message
+// @Description Hide or show donation message in guestbook by project owner
+// @Tags donation
+// @ID hide-donation-message
+// @Accept json
+// @Produce json
+// @Param request body MessageHideRequest true "Request body"
+// @Param id path int true "Donation ID"
+// @Success 200 {object} models.Donation
+// @Security Bearer
+// @Router /donation/{id}/message/hidden [put]
+func (h *DonationHandler) HideDonationMessage(c echo.Context) error {
+	request := new(MessageHideRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+	}
+	donationID, _ := strconv.Atoi(c.Param("id"))
+	donation, err := h.app.HideDonationMessage(donationID, userID, request.Hidden)
+
+	switch err {
+	case app.ErrDonationNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("donation not found"))
+	case app.ErrDonationModifyWrong:
+		return c.JSON(http.StatusBadRequest, errorResponse("donation has no message"))
+	case app.ErrDonationModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case nil:
+		return c.JSON(http.StatusOK, donation)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
+
+// DeleteDonationMessage godoc
+// @Summary Delete donation message
+// @Description Delete donation message by project owner
+// @Tags donation
+// @ID delete-donation-message
+// @Param id path int true "Donation ID"
+// @Success 204
+// @Security Bearer
+// @Router /donation/{id}/message [delete]
+func (h *DonationHandler) DeleteDonationMessage(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+	}
+	donationID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.DeleteDonationMessage(donationID, userID)
+	switch err {
+	case app.ErrDonationNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("donation not found"))
+	case app.ErrDonationModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case nil:
+		return c.NoContent(http.StatusNoContent)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
+
+// GetGuestbook godoc
+// @Summary Returns project guestbook
+// @Description Returns messages of project participants. Hidden messages are visible only to project owner
+// @Tags donation
+// @ID get-project-guestbook
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param page query int false "Page num"
+// @Param page_size query int false "Capasity of one page, 100 at most"
+// @Success 200 {object} GuestbookResponse
+// @Security Bearer
+// @Router /donation/project/{id}/guestbook [get]
+func (h *DonationHandler) GetGuestbook(c echo.Context) error {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	page, pageSize := parsePage(c)
+
+	entries, next, hasNext, err := h.app.GetGuestbook(projectID, userID, page, pageSize)
+	switch err {
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case nil:
+		return c.JSON(http.StatusOK, GuestbookResponse{
+			Results:  entries,
+			NextPage: next,
+			HasNext:  hasNext,
+		})
+	default:
+		return c.JSON(http.StatusInternalServerError, err)
+	}
+}
+
+// SettleCredit godoc
+// @Summary Mark donation credit as returned
+// @Description Mark credit of participant as returned by project owner
+// @Tags donation
+// @ID settle-donation-credit
+// @Produce json
+// @Param id path int true "Donation ID"
+// @Success 200 {object} models.Donation
+// @Security Bearer
+// @Router /donation/{id}/settle [post]
+func (h *DonationHandler) SettleCredit(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse(err.Error()))
+	}
+	donationID, _ := strconv.Atoi(c.Param("id"))
+	donation, err := h.app.SettleCredit(donationID, userID)
+
+	switch err {
+	case app.ErrDonationNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("donation not found"))
+	case app.ErrDonationModifyWrong:
+		return c.JSON(http.StatusBadRequest, errorResponse("donation has no credit"))
+	case app.ErrDonationModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case nil:
+		return c.JSON(http.StatusOK, donation)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
+
+// GetUserAdjustments godoc
+// @Summary Returns list of user's share adjustments
+// @Description Returns changes of user's share in fair campaigns after lock
+// @Tags donation
+// @ID get-user-adjustments
+// @Produce json
+// @Success 200 {object} []models.Adjustment
+// @Security Bearer
+// @Router /donation/adjustment [get]
+func (h *DonationHandler) GetUserAdjustments(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	adjustments, err := h.app.GetUserAdjustments(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, adjustments)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/mock/gomock"
@@ -48,8 +49,14 @@ func (s *DonationSuite) TestGetProjectDonations() {
 	c.SetParamNames("id")
 	c.SetParamValues("1")
 
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1)
+	c.Set("user", token)
+
 	h := NewDonationHandler(s.mockApp)
 
+	payment := int64(100)
 	donations := []app.ShortDonation{
 		{
 			ID:      1,
@@ -61,20 +68,17 @@ func (s *DonationSuite) TestGetProjectDonations() {
 			},
 		},
 		{
-			ID:      2,
-			Paid:    true,
-			User: models.User{
-				ID:        2,
-				FirstName: "Jane",
-				LastName:  "Doe",
-			},
+			ID:        2,
+			Paid:      true,
+			Anonymous: true,
+			Payment:   &payment,
 		},
 	}
-	s.mockApp.EXPECT().GetProjectDonations(1).Return(donations, nil)
+	s.mockApp.EXPECT().GetProjectDonations(1, 1, 0, 0).Return(donations, 0, false, nil)
 	s.Require().NoError(h.GetProjectDonations(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pDonationsJSON = `[{"id":1,"user":{"id":1,"username":"","first_name":"John","last_name":"Doe","avatar":"","project_count":0,"success_rate":0},"locked":false,"paid":true},{"id":2,"user":{"id":2,"username":"","first_name":"Jane","last_name":"Doe","avatar":"","project_count":0,"success_rate":0},"locked":false,"paid":true}]`
+	var pDonationsJSON = `[{"id":1,"user":{"id":1,"username":"","first_name":"John","last_name":"Doe","avatar":"","project_count":0,"success_rate":0},"anonymous":false,"locked":false,"paid":true},{"id":2,"user":{"id":0,"username":"","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0},"anonymous":true,"payment":100,"locked":false,"paid":true}]`
 
 	s.Require().Equal(pDonationsJSON, strings.Trim(rec.Body.String(), "\n"))
 }
@@ -105,11 +109,11 @@ func (s *DonationSuite) TestGetUserDonations() {
 			ProjectID: 20,
 		},
 	}
-	s.mockApp.EXPECT().GetUserDonations(111).Return(donations, nil)
+	s.mockApp.EXPECT().GetUserDonations(111, 0, 0).Return(donations, 0, false, nil)
 	s.Require().NoError(h.GetUserDonations(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pDonationsJSON = `[{"id":1,"payment":100,"locked":false,"paid":true,"project":10},{"id":2,"payment":200,"locked":false,"paid":true,"project":20}]`
+	var pDonationsJSON = `[{"id":1,"payment":100,"currency":"","locked":false,"paid":true,"paid_amount":0,"credit":0,"project":10,"anonymous":false,"message":"","message_hidden":false},{"id":2,"payment":200,"currency":"","locked":false,"paid":true,"paid_amount":0,"credit":0,"project":20,"anonymous":false,"message":"","message_hidden":false}]`
 
 	s.Require().Equal(pDonationsJSON, strings.Trim(rec.Body.String(), "\n"))
 }
@@ -141,15 +145,50 @@ func (s *DonationSuite) TestCreateDonation() {
 	}
 
 	h := NewDonationHandler(s.mockApp)
-	s.mockApp.EXPECT().CreateDonation(111, reqStruct.ProjectID, reqStruct.Payment).Return(expect, nil)
+	s.mockApp.EXPECT().CreateDonation(111, reqStruct.ProjectID, reqStruct.TierID, reqStruct.Payment, false, "").Return(expect, nil)
 	s.Require().NoError(h.CreateDonation(c))
 	s.Require().Equal(http.StatusCreated, rec.Code)
 
-	var pDonationsJSON = `{"id":111,"payment":100,"locked":false,"paid":false,"project":10}`
+	var pDonationsJSON = `{"id":111,"payment":100,"currency":"","locked":false,"paid":false,"paid_amount":0,"credit":0,"project":10,"anonymous":false,"message":"","message_hidden":false}`
 
 	s.Require().Equal(pDonationsJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
+func (s *DonationSuite) TestCreateDonationValidationFailed() {
+	body, err := json.Marshal(DonationCreateRequest{
+		ProjectID: 10,
+		Payment:   150,
+	})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/donation")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(111)
+	c.Set("user", token)
+
+	verr := &app.ValidationError{
+		Fields: []app.FieldError{
+			{Field: "payment", Code: app.CodeStep, Message: "payment must be a multiple of 1.00 RUB"},
+		},
+	}
+	h := NewDonationHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateDonation(111, 10, 0, int64(150), false, "").Return(nil, verr)
+	s.Require().NoError(h.CreateDonation(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var errJSON = `{"error":"validation failed","fields":[{"field":"payment","code":"step","message":"payment must be a multiple of 1.00 RUB"}]}`
+
+	s.Require().Equal(errJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
 func (s *DonationSuite) TestUpdateDonation() {
 	body, err := json.Marshal(DonationUpdateRequest{
 		Payment: 200,
@@ -181,11 +220,11 @@ func (s *DonationSuite) TestUpdateDonation() {
 		Locked:    false,
 		ProjectID: 33,
 	}
-	s.mockApp.EXPECT().UpdateDonation(1, 111, 200, false).Return(donation, nil)
+	s.mockApp.EXPECT().UpdateDonation(1, 111, int64(200), false).Return(donation, nil)
 	s.Require().NoError(h.UpdateDonation(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pDonationsJSON = `{"id":1,"payment":200,"locked":false,"paid":false,"project":33}`
+	var pDonationsJSON = `{"id":1,"payment":200,"currency":"","locked":false,"paid":false,"paid_amount":0,"credit":0,"project":33,"anonymous":false,"message":"","message_hidden":false}`
 	s.Require().Equal(pDonationsJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
@@ -215,6 +254,172 @@ func (s *DonationSuite) TestDeleteDonation() {
 	s.Require().Equal(http.StatusNoContent, rec.Code)
 }
 
+func (s *DonationSuite) TestSetDonationAnonymity() {
+	body, err := json.Marshal(DonationAnonymityRequest{Anonymous: true})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.PUT, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/donation/:id/anonymity")
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(111)
+	c.Set("user", token)
+
+	h := NewDonationHandler(s.mockApp)
+	s.mockApp.EXPECT().SetDonationAnonymity(5, 111, true).Return(nil, app.ErrDonationModifyNotAllowed)
+	s.Require().NoError(h.SetDonationAnonymity(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *DonationSuite) TestGetGuestbook() {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(s.buildRequest(), rec)
+	c.SetPath("/donation/project/:id/guestbook")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	c.QueryParams().Add("page_size", "1")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(3)
+	c.Set("user", token)
+
+	entries := []app.GuestbookEntry{
+		{
+			DonationID: 7,
+			Anonymous:  true,
+			Message:    "Happy birthday!",
+			CreatedAt:  time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	h := NewDonationHandler(s.mockApp)
+	s.mockApp.EXPECT().GetGuestbook(1, 3, 1, 1).Return(entries, 2, true, nil)
+	s.Require().NoError(h.GetGuestbook(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var gJSON = `{"results":[{"donation":7,"user":{"id":0,"username":"","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0},"anonymous":true,"message":"Happy birthday!","hidden":false,"created_at":"2020-08-20T12:00:00Z"}],"next":2,"has_next":true}`
+	s.Require().Equal(gJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *DonationSuite) TestGetGuestbookClampsPage() {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(s.buildRequest(), rec)
+	c.SetPath("/donation/project/:id/guestbook")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	c.QueryParams().Add("page", "-3")
+	c.QueryParams().Add("page_size", "100000")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(3)
+	c.Set("user", token)
+
+	h := NewDonationHandler(s.mockApp)
+	s.mockApp.EXPECT().GetGuestbook(1, 3, 1, maxCursorLimit).Return([]app.GuestbookEntry{}, 0, false, nil)
+	s.Require().NoError(h.GetGuestbook(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *DonationSuite) TestSetDonationMessageRateLimit() {
+	body, err := json.Marshal(DonationMessageRequest{Message: "Hi"})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.PUT, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/donation/:id/message")
+	c.SetParamNames("id")
+	c.SetParamValues("5")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(111)
+	c.Set("user", token)
+
+	h := NewDonationHandler(s.mockApp)
+	s.mockApp.EXPECT().SetDonationMessage(5, 111, "Hi").Return(nil, app.ErrMessageRateLimit)
+	s.Require().NoError(h.SetDonationMessage(c))
+	s.Require().Equal(http.StatusTooManyRequests, rec.Code)
+}
+
+func (s *DonationSuite) TestSettleCredit() {
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(nil))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/donation/:id/settle")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1212)
+	c.Set("user", token)
+
+	h := NewDonationHandler(s.mockApp)
+	donation := &models.Donation{
+		ID:         1,
+		Payment:    400,
+		PaidAmount: 400,
+		Paid:       true,
+		Locked:     true,
+		ProjectID:  33,
+	}
+	s.mockApp.EXPECT().SettleCredit(1, 1212).Return(donation, nil)
+	s.Require().NoError(h.SettleCredit(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var pDonationsJSON = `{"id":1,"payment":400,"currency":"","locked":true,"paid":true,"paid_amount":400,"credit":0,"project":33,"anonymous":false,"message":"","message_hidden":false}`
+	s.Require().Equal(pDonationsJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *DonationSuite) TestGetUserAdjustments() {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(s.buildRequest(), rec)
+	c.SetPath("/donation/adjustment")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(111)
+	c.Set("user", token)
+
+	h := NewDonationHandler(s.mockApp)
+	adjustments := []models.Adjustment{
+		{
+			ID:         1,
+			DonationID: 3,
+			ProjectID:  10,
+			UserID:     111,
+			OldPayment: 500,
+			NewPayment: 400,
+			Credit:     100,
+			CreatedAt:  time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	s.mockApp.EXPECT().GetUserAdjustments(111).Return(adjustments, nil)
+	s.Require().NoError(h.GetUserAdjustments(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var adjJSON = `[{"id":1,"donation":3,"project":10,"old_payment":500,"new_payment":400,"credit":100,"created_at":"2020-10-01T12:00:00Z"}]`
+	s.Require().Equal(adjJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
 func TestDonationSuite(t *testing.T) {
 	suite.Run(t, new(DonationSuite))
 }
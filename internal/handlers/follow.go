@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// FollowHandler ...
+type FollowHandler struct {
+	app app.Application
+}
+
+// NewFollowHandler ...
+func NewFollowHandler(a app.Application) *FollowHandler {
+	return &FollowHandler{app: a}
+}
+
+// FeedResponse ...
+type FeedResponse struct {
+	Results    []models.Activity `json:"results"`
+	NextCursor string            `json:"next_cursor"`
+	HasNext    bool              `json:"has_next"`
+}
+
+// GetFollows godoc
+// @Summary Returns follows of user
+// @Description Returns projects, categories and users followed by current user
+// @Tags follow
+// @ID get-follows
+// @Produce json
+// @Success 200 {array} models.Follow
+// @Security Bearer
+// @Router /follow [get]
+func (h *FollowHandler) GetFollows(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	follows, err := h.app.GetFollows(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get follows"))
+	}
+
+	return c.JSON(http.StatusOK, follows)
+}
+
+// Follow godoc
+// @Summary Follow project, category or user
+// @Description Watch progress and updates of project, or follow new projects of category or user
+// @Tags follow
+// @ID follow
+// @Produce json
+// @Param type path string true "Type of followed entity: project, category or user"
+// @Param id path int true "ID of followed entity"
+// @Success 200 {object} models.Follow
+// @Failure 400 {object} map[string]interface{}
+// @Security Bearer
+// @Router /follow/{type}/{id} [put]
+func (h *FollowHandler) Follow(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	targetID, _ := strconv.Atoi(c.Param("id"))
+
+	follow, err := h.app.Follow(userID, c.Param("type"), targetID)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case app.ErrFollowTargetNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse(c.Param("type")+" not found"))
+	case nil:
+		return c.JSON(http.StatusOK, follow)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to follow"))
+	}
+}
+
+// Unfollow godoc
+// @Summary Unfollow project, category or user
+// @Description Stop following project, category or user
+// @Tags follow
+// @ID unfollow
+// @Param type path string true "Type of followed entity: project, category or user"
+// @Param id path int true "ID of followed entity"
+// @Success 204
+// @Security Bearer
+// @Router /follow/{type}/{id} [delete]
+func (h *FollowHandler) Unfollow(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	targetID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.Unfollow(userID, c.Param("type"), targetID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to unfollow"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetFeed godoc
+// @Summary Returns personal feed
+// @Description Returns new projects of followed categories and users, updates and state changes of watched projects, newest first
+// @Tags follow
+// @ID get-feed
+// @Produce json
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} FeedResponse
+// @Security Bearer
+// @Router /feed [get]
+func (h *FollowHandler) GetFeed(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+
+	activities, next, hasNext, err := h.app.GetFeed(userID, cursor, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get feed"))
+	}
+
+	return c.JSON(http.StatusOK, FeedResponse{
+		Results:    activities,
+		NextCursor: idCursor(next),
+		HasNext:    hasNext,
+	})
+}
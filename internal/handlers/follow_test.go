@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type FollowSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *FollowSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *FollowSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *FollowSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *FollowSuite) TestFollow() {
+	req := httptest.NewRequest(echo.PUT, "/", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/follow/:type/:id")
+	c.SetParamNames("type", "id")
+	c.SetParamValues("category", "3")
+	s.setUser(c, 5)
+
+	h := NewFollowHandler(s.mockApp)
+	follow := &models.Follow{
+		UserID:     5,
+		TargetType: "category",
+		TargetID:   3,
+		CreatedAt:  time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+	}
+	s.mockApp.EXPECT().Follow(5, "category", 3).Return(follow, nil)
+	s.Require().NoError(h.Follow(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var fJSON = `{"type":"category","id":3,"created_at":"2020-05-01T10:00:00Z"}`
+	s.Require().Equal(fJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *FollowSuite) TestFollowNotFound() {
+	req := httptest.NewRequest(echo.PUT, "/", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/follow/:type/:id")
+	c.SetParamNames("type", "id")
+	c.SetParamValues("project", "10")
+	s.setUser(c, 5)
+
+	h := NewFollowHandler(s.mockApp)
+	s.mockApp.EXPECT().Follow(5, "project", 10).Return(nil, app.ErrFollowTargetNotFound)
+	s.Require().NoError(h.Follow(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+	s.Require().Equal(`{"error":"project not found"}`, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *FollowSuite) TestUnfollow() {
+	req := httptest.NewRequest(echo.DELETE, "/", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/follow/:type/:id")
+	c.SetParamNames("type", "id")
+	c.SetParamValues("user", "2")
+	s.setUser(c, 5)
+
+	h := NewFollowHandler(s.mockApp)
+	s.mockApp.EXPECT().Unfollow(5, "user", 2).Return(nil)
+	s.Require().NoError(h.Unfollow(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *FollowSuite) TestGetFeed() {
+	req := httptest.NewRequest(echo.GET, "/feed?limit=1", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/feed")
+	s.setUser(c, 5)
+
+	h := NewFollowHandler(s.mockApp)
+	activities := []models.Activity{{
+		ID:        5,
+		Type:      "project_locked",
+		ProjectID: 10,
+		Data:      map[string]interface{}{"title": "Pizza"},
+		CreatedAt: time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC),
+	}}
+	s.mockApp.EXPECT().GetFeed(5, 0, 1).Return(activities, 5, true, nil)
+	s.Require().NoError(h.GetFeed(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var fJSON = `{"results":[{"id":5,"type":"project_locked","project":10,"data":{"title":"Pizza"},"created_at":"2020-05-01T10:00:00Z"}],"next_cursor":"eyJpZCI6NX0","has_next":true}`
+	s.Require().Equal(fJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func TestFollowSuite(t *testing.T) {
+	suite.Run(t, new(FollowSuite))
+}
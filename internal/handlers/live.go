@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+const liveKeepalive = 15 * time.Second
+
+// LiveHandler ...
+type LiveHandler struct {
+	app       app.Application
+	keepalive time.Duration
+}
+
+// NewLiveHandler ...
+func NewLiveHandler(a app.Application) *LiveHandler {
+	return &LiveHandler{app: a, keepalive: liveKeepalive}
+}
+
+// writeEvent writes server-sent event and flushes it to client.
+func writeEvent(c echo.Context, msg *app.LiveMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(c.Response(), "event: %s\ndata: %s\n\n", msg.Event, data)
+	if err != nil {
+		return err
+	}
+	c.Response().Flush()
+
+	return nil
+}
+
+// StreamProject godoc
+// @Summary Stream project progress
+// @Description Streams server-sent events with total, percent, status and participants of project.
+// @Description First event contains current progress, comment lines are sent as keepalives.
+// @Description Token could be passed in "token" query param for EventSource clients
+// @Tags live
+// @ID stream-project
+// @Produce text/event-stream
+// @Param id path int true "Project ID"
+// @Success 200 {object} app.LiveMessage
+// @Security Bearer
+// @Router /live/project/{id} [get]
+func (h *LiveHandler) StreamProject(c echo.Context) error {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	current, updates, cancel, err := h.app.SubscribeProject(userID, projectID)
+	switch err {
+	case nil:
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to subscribe"))
+	}
+	defer cancel()
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentType, "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	c.Response().WriteHeader(http.StatusOK)
+	err = writeEvent(c, current)
+	if err != nil {
+		return nil
+	}
+
+	ticker := time.NewTicker(h.keepalive)
+	defer ticker.Stop()
+	for {
+		select {
+		case msg, open := <-updates:
+			if !open {
+				return nil
+			}
+			if writeEvent(c, &msg) != nil {
+				return nil
+			}
+		case <-ticker.C:
+			_, err = fmt.Fprint(c.Response(), ": keepalive\n\n")
+			if err != nil {
+				return nil
+			}
+			c.Response().Flush()
+		case <-c.Request().Context().Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+)
+
+type LiveSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *LiveSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *LiveSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *LiveSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *LiveSuite) TestStreamProject() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/live/project/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 5)
+
+	current := &app.LiveMessage{
+		Event:     app.EventProjectProgress,
+		ProjectID: 10,
+		Data:      map[string]interface{}{"percent": 50},
+	}
+	updates := make(chan app.LiveMessage, 1)
+	updates <- app.LiveMessage{
+		Event:     app.EventParticipantJoined,
+		ProjectID: 10,
+		Data:      map[string]interface{}{"user": 6},
+	}
+	close(updates)
+	cancelled := false
+	h := NewLiveHandler(s.mockApp)
+	s.mockApp.EXPECT().SubscribeProject(5, 10).Return(current, (<-chan app.LiveMessage)(updates), func() { cancelled = true }, nil)
+	s.Require().NoError(h.StreamProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Equal("text/event-stream", rec.Header().Get("Content-Type"))
+	s.Require().True(cancelled)
+
+	var stream = "event: project_progress\ndata: {\"event\":\"project_progress\",\"project\":10,\"data\":{\"percent\":50}}\n\n" +
+		"event: participant_joined\ndata: {\"event\":\"participant_joined\",\"project\":10,\"data\":{\"user\":6}}\n\n"
+	s.Require().Equal(stream, rec.Body.String())
+}
+
+func (s *LiveSuite) TestStreamProjectNotFound() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/live/project/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 5)
+
+	h := NewLiveHandler(s.mockApp)
+	s.mockApp.EXPECT().SubscribeProject(5, 10).Return(nil, nil, nil, app.ErrProjectNotFound)
+	s.Require().NoError(h.StreamProject(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestLiveSuite(t *testing.T) {
+	suite.Run(t, new(LiveSuite))
+}
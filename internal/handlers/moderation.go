@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+// ModerationHandler ...
+type ModerationHandler struct {
+	app app.Application
+}
+
+// NewModerationHandler ...
+func NewModerationHandler(a app.Application) *ModerationHandler {
+	return &ModerationHandler{app: a}
+}
+
+// ReviewRequest ...
+type ReviewRequest struct {
+	Comment string `json:"comment"`
+}
+
+// GetReviewQueue godoc
+// @Summary Returns projects waiting for review
+// @Description Returns drafts of moderated categories waiting for approval, oldest first, only for admins
+// @Tags moderation
+// @ID get-review-queue
+// @Produce json
+// @Success 200 {array} app.ExtendedProject
+// @Failure 403 {object} map[string]interface{}
+// @Security Bearer
+// @Router /moderation [get]
+func (h *ModerationHandler) GetReviewQueue(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	projects, err := h.app.GetReviewQueue(userID)
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, projects)
+	case app.ErrAdminRequired:
+		return c.JSON(http.StatusForbidden, errorResponse("admin required"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get review queue"))
+	}
+}
+
+// ApproveProject godoc
+// @Summary Approve project
+// @Description Approve draft waiting for review, it is published right away unless publication is scheduled later
+// @Tags moderation
+// @ID approve-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ReviewRequest false "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /moderation/{id}/approve [post]
+func (h *ModerationHandler) ApproveProject(c echo.Context) error {
+	request := new(ReviewRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	project, err := h.app.ApproveProject(userID, projectID, request.Comment)
+
+	return lifecycleErrorResponse(c, err, project, "unable to approve project")
+}
+
+// RejectProject godoc
+// @Summary Reject project
+// @Description Return draft waiting for review to owner with comment
+// @Tags moderation
+// @ID reject-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ReviewRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /moderation/{id}/reject [post]
+func (h *ModerationHandler) RejectProject(c echo.Context) error {
+	request := new(ReviewRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	project, err := h.app.RejectProject(userID, projectID, request.Comment)
+
+	return lifecycleErrorResponse(c, err, project, "unable to reject project")
+}
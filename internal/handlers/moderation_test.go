@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ModerationSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *ModerationSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *ModerationSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *ModerationSuite) newContext(method, path, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set("Content-type", "application/json")
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetPath(path)
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1)
+	c.Set("user", token)
+
+	return c, rec
+}
+
+func (s *ModerationSuite) TestGetReviewQueue() {
+	c, rec := s.newContext(echo.GET, "/moderation", "")
+	h := NewModerationHandler(s.mockApp)
+	s.mockApp.EXPECT().GetReviewQueue(1).Return([]*app.ExtendedProject{
+		{ID: 10, Title: "Pizza", Status: models.StatusDraft, ReviewStatus: models.ReviewPending},
+	}, nil)
+
+	s.Require().NoError(h.GetReviewQueue(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Contains(rec.Body.String(), `"review_status":"pending"`)
+}
+
+func (s *ModerationSuite) TestGetReviewQueueNotAdmin() {
+	c, rec := s.newContext(echo.GET, "/moderation", "")
+	h := NewModerationHandler(s.mockApp)
+	s.mockApp.EXPECT().GetReviewQueue(1).Return(nil, app.ErrAdminRequired)
+
+	s.Require().NoError(h.GetReviewQueue(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *ModerationSuite) TestApproveProject() {
+	c, rec := s.newContext(echo.POST, "/moderation/:id/approve", `{}`)
+	h := NewModerationHandler(s.mockApp)
+	s.mockApp.EXPECT().ApproveProject(1, 10, "").
+		Return(&app.ExtendedProject{ID: 10, Status: models.StatusSearch, ReviewStatus: models.ReviewApproved}, nil)
+
+	s.Require().NoError(h.ApproveProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ModerationSuite) TestApproveProjectWrongStatus() {
+	c, rec := s.newContext(echo.POST, "/moderation/:id/approve", `{}`)
+	h := NewModerationHandler(s.mockApp)
+	s.mockApp.EXPECT().ApproveProject(1, 10, "").Return(nil, app.ErrProjectWrongStatus)
+
+	s.Require().NoError(h.ApproveProject(c))
+	s.Require().Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *ModerationSuite) TestRejectProject() {
+	c, rec := s.newContext(echo.POST, "/moderation/:id/reject", `{"comment":"Add instructions"}`)
+	h := NewModerationHandler(s.mockApp)
+	s.mockApp.EXPECT().RejectProject(1, 10, "Add instructions").
+		Return(&app.ExtendedProject{ID: 10, ReviewStatus: models.ReviewRejected, ReviewComment: "Add instructions"}, nil)
+
+	s.Require().NoError(h.RejectProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Contains(rec.Body.String(), `"review_comment":"Add instructions"`)
+}
+
+func (s *ModerationSuite) TestRejectProjectWithoutComment() {
+	c, rec := s.newContext(echo.POST, "/moderation/:id/reject", `{}`)
+	h := NewModerationHandler(s.mockApp)
+	verr := &app.ValidationError{Fields: []app.FieldError{
+		{Field: "comment", Code: app.CodeRequired, Message: "comment is required"},
+	}}
+	s.mockApp.EXPECT().RejectProject(1, 10, "").Return(nil, verr)
+
+	s.Require().NoError(h.RejectProject(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func TestModerationSuite(t *testing.T) {
+	suite.Run(t, new(ModerationSuite))
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationHandler ...
+type NotificationHandler struct {
+	app app.Application
+}
+
+// NewNotificationHandler ...
+func NewNotificationHandler(a app.Application) *NotificationHandler {
+	return &NotificationHandler{app: a}
+}
+
+// NotificationReadRequest ...
+type NotificationReadRequest struct {
+	IDs []int `json:"ids"`
+}
+
+// NotificationListResponse ...
+type NotificationListResponse struct {
+	Results    []models.Notification `json:"results"`
+	Unread     int                   `json:"unread"`
+	NextCursor string                `json:"next_cursor"`
+	HasNext    bool                  `json:"has_next"`
+}
+
+// GetNotifications godoc
+// @Summary Returns user notifications
+// @Description Returns notifications of current user, newest first, with count of unread ones
+// @Tags notification
+// @ID get-notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} NotificationListResponse
+// @Security Bearer
+// @Router /notifications [get]
+func (h *NotificationHandler) GetNotifications(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	onlyUnread, _ := strconv.ParseBool(c.QueryParam("unread"))
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+
+	notifications, next, hasNext, err := h.app.GetNotifications(userID, cursor, limit, onlyUnread)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get notifications"))
+	}
+	unread, err := h.app.CountUnreadNotifications(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get notifications"))
+	}
+
+	return c.JSON(http.StatusOK, NotificationListResponse{
+		Results:    notifications,
+		Unread:     unread,
+		NextCursor: idCursor(next),
+		HasNext:    hasNext,
+	})
+}
+
+// MarkRead godoc
+// @Summary Mark notifications as read
+// @Description Mark given notifications of current user as read
+// @Tags notification
+// @ID read-notifications
+// @Accept json
+// @Param request body NotificationReadRequest true "Request body"
+// @Success 204
+// @Security Bearer
+// @Router /notifications/read [post]
+func (h *NotificationHandler) MarkRead(c echo.Context) error {
+	request := new(NotificationReadRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	if len(request.IDs) == 0 {
+		return c.JSON(http.StatusBadRequest, errorResponse("ids are required"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	err = h.app.MarkNotificationsRead(userID, request.IDs)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to mark notifications"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// MarkAllRead godoc
+// @Summary Mark all notifications as read
+// @Description Mark all notifications of current user as read
+// @Tags notification
+// @ID read-all-notifications
+// @Success 204
+// @Security Bearer
+// @Router /notifications/read_all [post]
+func (h *NotificationHandler) MarkAllRead(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	err = h.app.MarkAllNotificationsRead(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to mark notifications"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type NotificationSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *NotificationSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *NotificationSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *NotificationSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *NotificationSuite) TestGetNotifications() {
+	req := httptest.NewRequest(echo.GET, "/?unread=true&limit=1", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/notifications")
+	s.setUser(c, 5)
+
+	notifications := []models.Notification{
+		{
+			ID:        7,
+			UserID:    5,
+			Type:      "project_locked",
+			ProjectID: 10,
+			Data:      map[string]interface{}{"title": "Pizza"},
+			CreatedAt: time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	h := NewNotificationHandler(s.mockApp)
+	s.mockApp.EXPECT().GetNotifications(5, 0, 1, true).Return(notifications, 7, true, nil)
+	s.mockApp.EXPECT().CountUnreadNotifications(5).Return(3, nil)
+	s.Require().NoError(h.GetNotifications(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var nJSON = `{"results":[{"id":7,"type":"project_locked","project":10,"data":{"title":"Pizza"},"read":false,"created_at":"2020-08-20T12:00:00Z"}],"unread":3,"next_cursor":"eyJpZCI6N30","has_next":true}`
+	s.Require().Equal(nJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *NotificationSuite) TestMarkRead() {
+	body, err := json.Marshal(NotificationReadRequest{IDs: []int{7, 8}})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/notifications/read")
+	s.setUser(c, 5)
+
+	h := NewNotificationHandler(s.mockApp)
+	s.mockApp.EXPECT().MarkNotificationsRead(5, []int{7, 8}).Return(nil)
+	s.Require().NoError(h.MarkRead(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func (s *NotificationSuite) TestMarkReadEmpty() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"ids":[]}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/notifications/read")
+	s.setUser(c, 5)
+
+	h := NewNotificationHandler(s.mockApp)
+	s.Require().NoError(h.MarkRead(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *NotificationSuite) TestMarkAllRead() {
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/notifications/read_all")
+	s.setUser(c, 5)
+
+	h := NewNotificationHandler(s.mockApp)
+	s.mockApp.EXPECT().MarkAllNotificationsRead(5).Return(nil)
+	s.Require().NoError(h.MarkAllRead(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func TestNotificationSuite(t *testing.T) {
+	suite.Run(t, new(NotificationSuite))
+}
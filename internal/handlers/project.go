@@ -9,11 +9,24 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+const (
+	defaultRankingLimit = 10
+	maxRankingLimit     = 50
+)
+
 // ProjectListResponse paginated projects
 type ProjectListResponse struct {
-	Results  []ProjectListView `json:"results"`
-	NextPage int               `json:"next"`
-	HasNext  bool              `json:"has_next"`
+	Results    []ProjectListView `json:"results"`
+	NextPage   int               `json:"next"`
+	NextCursor string            `json:"next_cursor,omitempty"`
+	HasNext    bool              `json:"has_next"`
+}
+
+// UserProjectListResponse page of user projects
+type UserProjectListResponse struct {
+	Results    []ProjectListView `json:"results"`
+	NextCursor string            `json:"next_cursor"`
+	HasNext    bool              `json:"has_next"`
 }
 
 // ProjectListView light project entry
@@ -25,27 +38,47 @@ type ProjectListView struct {
 	ReleaseDate string             `json:"release_date"`
 	EventDate   *string            `json:"event_date"`
 	ImageLink   string             `json:"image_link"`
-	Total       int                `json:"total"`
+	Total       int64              `json:"total"`
+	Currency    string             `json:"currency"`
 	Percent     int                `json:"percent"`
 	Category    models.Category    `json:"category"`
 	ProjectType models.ProjectType `json:"project_type"`
+	Snippet     string             `json:"snippet,omitempty"`
 }
 
 // ProjectModifyRequest Request for project creation
 type ProjectModifyRequest struct {
-	Title         string `json:"title"`
-	SubTitle      string `json:"subtitle"`
-	ReleaseDate   string `json:"release_date"`
-	EventDate     string `json:"event_date,omitempty"`
-	Category      int    `json:"category"`
-	GoalPeople    int    `json:"goal_people"`
-	GoalAmount    int    `json:"goal_amount"`
-	ImageLink     string `json:"image_link"`
-	Instructions  string `json:"instructions"`
-	Description   string `json:"description"`
-	ProjectType   int    `json:"project_type"`
-	Published     bool   `json:"published,omitempty"`
-	DropEventDate bool   `json:"drop_event_date,omitempty"`
+	Title         string              `json:"title"`
+	SubTitle      string              `json:"subtitle"`
+	ReleaseDate   string              `json:"release_date"`
+	EventDate     string              `json:"event_date,omitempty"`
+	Category      int                 `json:"category"`
+	GoalPeople    int                 `json:"goal_people"`
+	GoalAmount    int64               `json:"goal_amount"`
+	Currency      string              `json:"currency,omitempty"`
+	ImageLink     string              `json:"image_link"`
+	Instructions  string              `json:"instructions"`
+	Description   string              `json:"description"`
+	ProjectType   int                 `json:"project_type"`
+	Pledge        *models.PledgeRules `json:"pledge,omitempty"`
+	Published     bool                `json:"published,omitempty"`
+	DropEventDate bool                `json:"drop_event_date,omitempty"`
+	Template      int                 `json:"template,omitempty"`
+}
+
+// ProjectPrivacyRequest ...
+type ProjectPrivacyRequest struct {
+	PrivateAmounts bool `json:"private_amounts"`
+}
+
+// ProjectTagsRequest ...
+type ProjectTagsRequest struct {
+	Tags []string `json:"tags"`
+}
+
+// ProjectCloneRequest ...
+type ProjectCloneRequest struct {
+	ReleaseDate string `json:"release_date,omitempty"`
 }
 
 // ProjectCreateResponse Response for project creation
@@ -65,22 +98,43 @@ func NewProjectHandler(a app.Application) *ProjectHandler {
 
 // GetProjects godoc
 // @Summary Returns list of projects
-// @Description Returns list of projects with filters
+// @Description Returns list of published projects with filters and sorting.
+// @Description List params accept several values, repeated or comma-separated.
+// @Description Sort fields: id, percent, total, release_date, popularity, relevance (requires q),
+// @Description prefix "-" sorts descending, e.g. sort=-percent,release_date.
+// @Description By default projects are sorted by relevance when q is set and by id descending otherwise.
+// @Description Pages are selected by page and page_size, or, if cursor or limit is set, by cursor from next_cursor of previous page.
 // @Tags project
 // @ID get-projects
 // @Produce json
 // @Param page query int false "Page num"
-// @Param page_size query int false "Capasity of one page"
-// @Param category query int false "Category ID"
-// @Param project_type query int false "Project Type ID"
+// @Param page_size query int false "Capasity of one page, 100 at most"
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page in cursor mode"
+// @Param category query []int false "Category IDs" collectionFormat(csv)
+// @Param project_type query []int false "Project Type IDs" collectionFormat(csv)
+// @Param status query []string false "Statuses: search, harvest, success, fail, cancelled" collectionFormat(csv)
+// @Param owner query []int false "Owner IDs" collectionFormat(csv)
+// @Param tag query []string false "Tag aliases, projects with any of them are returned" collectionFormat(csv)
+// @Param release_from query string false "Release date from, YYYY-MM-DD"
+// @Param release_to query string false "Release date to inclusive, YYYY-MM-DD"
+// @Param event_from query string false "Event date from, YYYY-MM-DD"
+// @Param event_to query string false "Event date to inclusive, YYYY-MM-DD"
 // @Param open query bool false "Return only open"
+// @Param q query string false "Search words"
+// @Param sort query []string false "Sort fields" collectionFormat(csv)
 // @Success 200 {object} ProjectListResponse
+// @Failure 400 {object} map[string]interface{}
 // @Security Bearer
 // @Router /project [get]
 func (h *ProjectHandler) GetProjects(c echo.Context) error {
-	categoryInt, _ := strconv.Atoi(c.QueryParam("category"))
-	typeInt, _ := strconv.Atoi(c.QueryParam("type"))
-	onlyOpen, _ := strconv.ParseBool(c.QueryParam("open"))
+	filter, err := parseProjectFilter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(err.(*app.ValidationError)))
+	}
+	if isCursorMode(c) {
+		return h.getProjectsWithCursor(c, filter)
+	}
 
 	pageInt, err := strconv.Atoi(c.QueryParam("page"))
 	if err != nil || pageInt == 0 {
@@ -91,7 +145,10 @@ func (h *ProjectHandler) GetProjects(c echo.Context) error {
 		pageSizeInt = 10
 	}
 
-	projects, next, hasNext, err := h.app.GetProjectsWithPagination(categoryInt, typeInt, pageInt, pageSizeInt, onlyOpen)
+	projects, next, hasNext, err := h.app.GetProjectsWithPagination(filter, pageInt, pageSizeInt)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err)
 	}
@@ -103,6 +160,26 @@ func (h *ProjectHandler) GetProjects(c echo.Context) error {
 	})
 }
 
+func (h *ProjectHandler) getProjectsWithCursor(c echo.Context, filter *models.ProjectFilter) error {
+	cursor, limit, err := parseCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+	projects, next, err := h.app.GetProjectsWithCursor(filter, cursor, limit)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, err)
+	}
+
+	return c.JSON(http.StatusOK, ProjectListResponse{
+		Results:    projectToListView(projects),
+		NextCursor: next.Encode(),
+		HasNext:    next != nil,
+	})
+}
+
 func projectToListView(projects []*app.ExtendedProject) []ProjectListView {
 	projectListEntries := make([]ProjectListView, 0)
 	for _, project := range projects {
@@ -114,9 +191,11 @@ func projectToListView(projects []*app.ExtendedProject) []ProjectListView {
 			ReleaseDate: project.ReleaseDate,
 			ImageLink:   project.ImageLink,
 			Total:       project.Total,
+			Currency:    project.Currency,
 			Percent:     project.Percent,
 			Category:    project.Category,
 			ProjectType: project.ProjectType,
+			Snippet:     project.Snippet,
 		}
 		projectListEntries = append(projectListEntries, plv)
 	}
@@ -126,14 +205,17 @@ func projectToListView(projects []*app.ExtendedProject) []ProjectListView {
 
 // GetUserProjects godoc
 // @Summary Returns list of projects associated with user
-// @Description Returns list of projects associated with user with filters
+// @Description Returns list of projects associated with user with filters, newest first.
+// @Description With cursor or limit params returns page of projects, otherwise first 20 projects as array.
 // @Tags project
 // @ID get-user-projects
 // @Produce json
 // @Param owned query bool false "Return projects where user is owner"
 // @Param contributed query bool false "Return projects where user is contributor"
 // @Param id path int true "User ID"
-// @Success 200 {object} []ProjectListView
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} UserProjectListResponse
 // @Security Bearer
 // @Router /project/user/{id} [get]
 func (h *ProjectHandler) GetUserProjects(c echo.Context) error {
@@ -141,13 +223,87 @@ func (h *ProjectHandler) GetUserProjects(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, errorResponse("wrong user id"))
 	}
+	viewerID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
 	onlyOwned, _ := strconv.ParseBool(c.QueryParam("owned"))
 	onlyContributed, _ := strconv.ParseBool(c.QueryParam("contributed"))
 
-	projects, err := h.app.GetUserProjects(userID, onlyContributed, onlyOwned)
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+
+	projects, next, hasNext, err := h.app.GetUserProjects(userID, viewerID, onlyContributed, onlyOwned, cursor, limit)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, err)
 	}
+	if !isCursorMode(c) {
+		return c.JSON(http.StatusOK, projectToListView(projects))
+	}
+
+	return c.JSON(http.StatusOK, UserProjectListResponse{
+		Results:    projectToListView(projects),
+		NextCursor: idCursor(next),
+		HasNext:    hasNext,
+	})
+}
+
+// rankingLimit returns count of ranked projects requested.
+func rankingLimit(c echo.Context) int {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		return defaultRankingLimit
+	}
+	if limit > maxRankingLimit {
+		return maxRankingLimit
+	}
+
+	return limit
+}
+
+// GetTrendingProjects godoc
+// @Summary Returns trending projects
+// @Description Returns projects on search stage ranked by donation velocity, goal growth over recent days and time to deadline.
+// @Description Rankings are recalculated periodically.
+// @Tags project
+// @ID get-trending-projects
+// @Produce json
+// @Param limit query int false "Count of projects, 50 at most"
+// @Success 200 {array} ProjectListView
+// @Security Bearer
+// @Router /project/trending [get]
+func (h *ProjectHandler) GetTrendingProjects(c echo.Context) error {
+	projects, err := h.app.GetTrendingProjects(rankingLimit(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get trending projects"))
+	}
+
+	return c.JSON(http.StatusOK, projectToListView(projects))
+}
+
+// GetRecommendedProjects godoc
+// @Summary Returns projects recommended for current user
+// @Description Returns projects on search stage similar by type and category to projects user participated in.
+// @Description Trending projects are returned if there is nothing to recommend. Rankings are recalculated periodically.
+// @Tags project
+// @ID get-recommended-projects
+// @Produce json
+// @Param limit query int false "Count of projects, 50 at most"
+// @Success 200 {array} ProjectListView
+// @Security Bearer
+// @Router /project/recommended [get]
+func (h *ProjectHandler) GetRecommendedProjects(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	projects, err := h.app.GetRecommendedProjects(userID, rankingLimit(c))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get recommended projects"))
+	}
 
 	return c.JSON(http.StatusOK, projectToListView(projects))
 }
@@ -183,7 +339,7 @@ func (h *ProjectHandler) GetSingleProject(c echo.Context) error {
 // @ID post-project
 // @Accept json
 // @Produce json
-// @Param request body DonationModifyRequest true "Request body"
+// @Param request body ProjectModifyRequest true "Request body"
 // @Success 200 {object} ProjectCreateResponse
 // @Security Bearer
 // @Router /project [post]
@@ -204,12 +360,18 @@ func (h *ProjectHandler) CreateProject(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, errorResponse("wrong event date"))
 	}
+	rules := models.PledgeRules{}
+	if cpRequest.Pledge != nil {
+		rules = *cpRequest.Pledge
+	}
 	id, err := h.app.CreateProject(
 		userID, 
+		cpRequest.Template,
 		cpRequest.GoalPeople, 
 		cpRequest.GoalAmount, 
 		cpRequest.Category, 
 		cpRequest.ProjectType,
+		cpRequest.Currency,
 		cpRequest.Title,
 		cpRequest.SubTitle,
 		cpRequest.Description,
@@ -217,17 +379,66 @@ func (h *ProjectHandler) CreateProject(c echo.Context) error {
 		cpRequest.Instructions,
 		releaseDate,
 		eventTime,
+		rules,
 	)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
 	switch err {
 	case nil:
 		return c.JSON(http.StatusCreated, ProjectCreateResponse{ID: id})
 	case models.ErrUserNotFound:
 		return c.JSON(http.StatusBadRequest, err)
+	case app.ErrProjectWrongCurrency:
+		return c.JSON(http.StatusBadRequest, errorResponse("unsupported currency"))
+	case app.ErrTemplateNotFound:
+		return c.JSON(http.StatusBadRequest, errorResponse("template not found"))
 	default:
 		return c.JSON(http.StatusInternalServerError, err)
 	}
 }
 
+// CloneProject godoc
+// @Summary Clone project
+// @Description Copy own project with tiers and tags into new draft, progress and participants are not copied.
+// @Description Dates are shifted to given release date, or by whole weeks to the nearest future date.
+// @Tags project
+// @ID clone-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ProjectCloneRequest false "Request body"
+// @Success 201 {object} ProjectCreateResponse
+// @Security Bearer
+// @Router /project/{id}/clone [post]
+func (h *ProjectHandler) CloneProject(c echo.Context) error {
+	request := new(ProjectCloneRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+	releaseDate, err := parseDate(request.ReleaseDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong release date"))
+	}
+
+	id, err := h.app.CloneProject(userID, projectID, releaseDate)
+	switch err {
+	case nil:
+		return c.JSON(http.StatusCreated, ProjectCreateResponse{ID: id})
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrProjectModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("only owner can clone project"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to clone project"))
+	}
+}
+
 // UpdateProject godoc
 // @Summary update single value of project
 // @Description Mofidy project fields
@@ -235,8 +446,8 @@ func (h *ProjectHandler) CreateProject(c echo.Context) error {
 // @ID update-project
 // @Accept json
 // @Produce json
-// @Param request body DonationModifyRequest true "Request body"
-// @Success 200 {object} ProjectDetailView
+// @Param request body ProjectModifyRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
 // @Security Bearer
 // @Router /project/{id} [patch]
 func (h *ProjectHandler) UpdateProject(c echo.Context) error {
@@ -265,6 +476,7 @@ func (h *ProjectHandler) UpdateProject(c echo.Context) error {
 		upRequest.GoalAmount, 
 		upRequest.Category, 
 		upRequest.ProjectType,
+		upRequest.Currency,
 		upRequest.Title,
 		upRequest.SubTitle,
 		upRequest.Description,
@@ -272,14 +484,20 @@ func (h *ProjectHandler) UpdateProject(c echo.Context) error {
 		upRequest.Instructions,
 		releaseDate,
 		eventTime,
+		upRequest.Pledge,
 		upRequest.Published,
 		upRequest.DropEventDate,
 	)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
 	switch err {
 	case app.ErrProjectNotFound:
 		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
 	case app.ErrProjectModifyNotAllowed:
 		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case app.ErrProjectWrongCurrency:
+		return c.JSON(http.StatusBadRequest, errorResponse("unsupported currency"))
 	case nil:
 		return c.JSON(http.StatusOK, project)
 	default:
@@ -310,8 +528,84 @@ func (h *ProjectHandler) DeleteProject(c echo.Context) error {
 	case app.ErrProjectModifyNotAllowed:
 		return c.JSON(http.StatusForbidden, errorResponse("project modify not allowed"))
 	case nil:
-		return c.JSON(http.StatusNoContent, nil)
+		return c.NoContent(http.StatusNoContent)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
+
+// SetProjectPrivacy godoc
+// @Summary Hide or show amounts of participants
+// @Description Make amounts in participant list visible only to owner and donors themselves
+// @Tags project
+// @ID set-project-privacy
+// @Accept json
+// @Produce json
+// @Param request body ProjectPrivacyRequest true "Request body"
+// @Param id path int true "Project ID"
+// @Success 200 {object} app.ExtendedProject
+// @Security Bearer
+// @Router /project/{id}/privacy [put]
+func (h *ProjectHandler) SetProjectPrivacy(c echo.Context) error {
+	request := new(ProjectPrivacyRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	project, err := h.app.SetProjectPrivacy(userID, projectID, request.PrivateAmounts)
+	switch err {
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrProjectModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("project modify not allowed"))
+	case nil:
+		return c.JSON(http.StatusOK, project)
 	default:
 		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
 	}
 }
+
+// SetProjectTags godoc
+// @Summary Set project tags
+// @Description Replace tags of project, owner only. Unknown tags are created
+// @Tags project
+// @ID set-project-tags
+// @Accept json
+// @Produce json
+// @Param request body ProjectTagsRequest true "Request body"
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/tags [put]
+func (h *ProjectHandler) SetProjectTags(c echo.Context) error {
+	request := new(ProjectTagsRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	tags, err := h.app.SetProjectTags(userID, projectID, request.Tags)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrProjectModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("project modify not allowed"))
+	case nil:
+		return c.JSON(http.StatusOK, tags)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to set tags"))
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// projectFilterParser collects invalid query params while building project filter.
+type projectFilterParser struct {
+	c    echo.Context
+	verr *app.ValidationError
+}
+
+func (p *projectFilterParser) invalid(field, message string) {
+	p.verr.Fields = append(p.verr.Fields, app.FieldError{Field: field, Code: app.CodeInvalid, Message: message})
+}
+
+// list returns values of repeated or comma-separated query param.
+func (p *projectFilterParser) list(name string) []string {
+	var values []string
+	for _, param := range p.c.QueryParams()[name] {
+		for _, v := range strings.Split(param, ",") {
+			v = strings.TrimSpace(v)
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+
+	return values
+}
+
+func (p *projectFilterParser) ids(name string) []int {
+	values := p.list(name)
+	if len(values) == 0 {
+		return nil
+	}
+	ids := make([]int, 0, len(values))
+	for _, v := range values {
+		id, err := strconv.Atoi(v)
+		if err != nil || id <= 0 {
+			p.invalid(name, "wrong ID "+strconv.Quote(v))
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func (p *projectFilterParser) bool(name string) bool {
+	value := p.c.QueryParam(name)
+	if value == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		p.invalid(name, "wrong boolean "+strconv.Quote(value))
+	}
+
+	return b
+}
+
+// date returns date from query param, end of range is returned as the next day
+// so inclusive date in params becomes exclusive bound of filter.
+func (p *projectFilterParser) date(name string, end bool) time.Time {
+	value := p.c.QueryParam(name)
+	date, err := parseDate(value)
+	if err != nil {
+		p.invalid(name, "wrong date "+strconv.Quote(value)+", expected YYYY-MM-DD")
+		return time.Time{}
+	}
+	if end && !date.IsZero() {
+		date = date.AddDate(0, 0, 1)
+	}
+
+	return date
+}
+
+func (p *projectFilterParser) sort() []models.ProjectSort {
+	values := p.list("sort")
+	if len(values) == 0 {
+		return nil
+	}
+	sort := make([]models.ProjectSort, 0, len(values))
+	for _, v := range values {
+		s := models.ProjectSort{Field: strings.TrimPrefix(v, "-"), Desc: strings.HasPrefix(v, "-")}
+		sort = append(sort, s)
+	}
+
+	return sort
+}
+
+// parseProjectFilter builds project filter from query params.
+// Multi-value params could be repeated or comma-separated.
+func parseProjectFilter(c echo.Context) (*models.ProjectFilter, error) {
+	p := &projectFilterParser{c: c, verr: &app.ValidationError{}}
+	filter := &models.ProjectFilter{
+		Categories: p.ids("category"),
+		Statuses:   p.list("status"),
+		Owners:     p.ids("owner"),
+		Tags:       p.list("tag"),
+		OnlyOpen:   p.bool("open"),
+		Search:     c.QueryParam("q"),
+		Sort:       p.sort(),
+	}
+	if c.QueryParam("project_type") != "" {
+		filter.ProjectTypes = p.ids("project_type")
+	} else {
+		// deprecated name of project_type param
+		filter.ProjectTypes = p.ids("type")
+	}
+	filter.ReleaseFrom = p.date("release_from", false)
+	filter.ReleaseTo = p.date("release_to", true)
+	filter.EventFrom = p.date("event_from", false)
+	filter.EventTo = p.date("event_to", true)
+	if len(p.verr.Fields) > 0 {
+		return nil, p.verr
+	}
+
+	return filter, nil
+}
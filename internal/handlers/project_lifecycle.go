@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+// ProjectCancelRequest ...
+type ProjectCancelRequest struct {
+	Reason string `json:"reason"`
+}
+
+// ProjectReleaseRequest ...
+type ProjectReleaseRequest struct {
+	ReleaseDate string `json:"release_date"`
+}
+
+// ProjectScheduleRequest ...
+type ProjectScheduleRequest struct {
+	PublishAt string `json:"publish_at"`
+}
+
+// ProjectEditRequest fields of published project, omitted fields stay unchanged
+type ProjectEditRequest struct {
+	Title        *string `json:"title,omitempty"`
+	SubTitle     *string `json:"subtitle,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	ImageLink    *string `json:"image_link,omitempty"`
+	Instructions *string `json:"instructions,omitempty"`
+}
+
+// lifecycleErrorResponse maps errors of published project actions to responses.
+func lifecycleErrorResponse(c echo.Context, err error, project *app.ExtendedProject, msg string) error {
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, project)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrProjectModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case app.ErrAdminRequired:
+		return c.JSON(http.StatusForbidden, errorResponse("admin required"))
+	case app.ErrProjectWrongStatus:
+		return c.JSON(http.StatusConflict, errorResponse("action isn't allowed in current project status"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(msg))
+	}
+}
+
+// CancelProject godoc
+// @Summary Cancel project
+// @Description Owner cancels published project with reason, unpaid pledges are released and participants are notified
+// @Tags project
+// @ID cancel-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ProjectCancelRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/cancel [post]
+func (h *ProjectHandler) CancelProject(c echo.Context) error {
+	request := new(ProjectCancelRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	project, err := h.app.CancelProject(userID, projectID, request.Reason)
+
+	return lifecycleErrorResponse(c, err, project, "unable to cancel project")
+}
+
+// ExtendProject godoc
+// @Summary Extend release date of project
+// @Description Move release date of project on search stage, it can be extended once by 28 days at most
+// @Tags project
+// @ID extend-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ProjectReleaseRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/extend [post]
+func (h *ProjectHandler) ExtendProject(c echo.Context) error {
+	request := new(ProjectReleaseRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+	releaseDate, err := parseDate(request.ReleaseDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong release date"))
+	}
+
+	project, err := h.app.ExtendProject(userID, projectID, releaseDate)
+
+	return lifecycleErrorResponse(c, err, project, "unable to extend project")
+}
+
+// EditProject godoc
+// @Summary Edit published project
+// @Description Change title, subtitle, description, image and instructions of published project, changes are recorded to audit trail
+// @Tags project
+// @ID edit-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ProjectEditRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/details [patch]
+func (h *ProjectHandler) EditProject(c echo.Context) error {
+	request := new(ProjectEditRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	project, err := h.app.EditProject(
+		userID,
+		projectID,
+		request.Title,
+		request.SubTitle,
+		request.Description,
+		request.ImageLink,
+		request.Instructions,
+	)
+
+	return lifecycleErrorResponse(c, err, project, "unable to edit project")
+}
+
+// ReopenProject godoc
+// @Summary Reopen failed project
+// @Description Admin returns failed project to search stage with new release date
+// @Tags project
+// @ID reopen-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ProjectReleaseRequest true "Request body"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/reopen [post]
+func (h *ProjectHandler) ReopenProject(c echo.Context) error {
+	request := new(ProjectReleaseRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+	releaseDate, err := parseDate(request.ReleaseDate)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong release date"))
+	}
+
+	project, err := h.app.ReopenProject(userID, projectID, releaseDate)
+
+	return lifecycleErrorResponse(c, err, project, "unable to reopen project")
+}
+
+// ScheduleProject godoc
+// @Summary Schedule publication of project
+// @Description Set time when draft is published, empty time cancels schedule.
+// @Description Drafts of moderated categories are submitted to review and published after approval.
+// @Tags project
+// @ID schedule-project
+// @Accept json
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param request body ProjectScheduleRequest true "Request body, publish_at in format YYYY-MM-DD hh:mm:ss"
+// @Success 200 {object} app.ExtendedProject
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/schedule [put]
+func (h *ProjectHandler) ScheduleProject(c echo.Context) error {
+	request := new(ProjectScheduleRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+	publishAt, err := parseDateTime(request.PublishAt)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong publication time"))
+	}
+
+	project, err := h.app.SchedulePublication(userID, projectID, publishAt)
+
+	return lifecycleErrorResponse(c, err, project, "unable to schedule project")
+}
+
+// GetProjectAudit godoc
+// @Summary Returns audit trail of project
+// @Description Returns edits, extensions, cancellation and reopening of published project, newest first
+// @Tags project
+// @ID get-project-audit
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.ProjectAudit
+// @Failure 404 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/audit [get]
+func (h *ProjectHandler) GetProjectAudit(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	entries, err := h.app.GetProjectAudit(userID, projectID)
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, entries)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get audit"))
+	}
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ProjectLifecycleSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *ProjectLifecycleSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *ProjectLifecycleSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *ProjectLifecycleSuite) newContext(method, path, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set("Content-type", "application/json")
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetPath(path)
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(42)
+	c.Set("user", token)
+
+	return c, rec
+}
+
+func (s *ProjectLifecycleSuite) TestCancelProject() {
+	c, rec := s.newContext(echo.POST, "/project/:id/cancel", `{"reason":"Pizzeria is closed"}`)
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().CancelProject(42, 10, "Pizzeria is closed").
+		Return(&app.ExtendedProject{ID: 10, Status: models.StatusCancelled, CancelReason: "Pizzeria is closed"}, nil)
+
+	s.Require().NoError(h.CancelProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Contains(rec.Body.String(), `"status":"cancelled"`)
+	s.Require().Contains(rec.Body.String(), `"cancel_reason":"Pizzeria is closed"`)
+}
+
+func (s *ProjectLifecycleSuite) TestCancelProjectWrongStatus() {
+	c, rec := s.newContext(echo.POST, "/project/:id/cancel", `{"reason":"Pizzeria is closed"}`)
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().CancelProject(42, 10, "Pizzeria is closed").Return(nil, app.ErrProjectWrongStatus)
+
+	s.Require().NoError(h.CancelProject(c))
+	s.Require().Equal(http.StatusConflict, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestExtendProject() {
+	c, rec := s.newContext(echo.POST, "/project/:id/extend", `{"release_date":"2020-10-20"}`)
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().ExtendProject(42, 10, time.Date(2020, 10, 20, 0, 0, 0, 0, time.UTC)).
+		Return(&app.ExtendedProject{ID: 10, ReleaseDate: "2020-10-20"}, nil)
+
+	s.Require().NoError(h.ExtendProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestExtendProjectWrongDate() {
+	c, rec := s.newContext(echo.POST, "/project/:id/extend", `{"release_date":"20.10.2020"}`)
+	h := NewProjectHandler(s.mockApp)
+
+	s.Require().NoError(h.ExtendProject(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestEditProject() {
+	c, rec := s.newContext(echo.PATCH, "/project/:id/details", `{"title":"Big pizza"}`)
+	h := NewProjectHandler(s.mockApp)
+	title := "Big pizza"
+	s.mockApp.EXPECT().EditProject(42, 10, &title, nil, nil, nil, nil).
+		Return(&app.ExtendedProject{ID: 10, Title: "Big pizza"}, nil)
+
+	s.Require().NoError(h.EditProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestReopenProjectNotAdmin() {
+	c, rec := s.newContext(echo.POST, "/project/:id/reopen", `{"release_date":"2020-11-01"}`)
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().ReopenProject(42, 10, time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC)).Return(nil, app.ErrAdminRequired)
+
+	s.Require().NoError(h.ReopenProject(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestScheduleProject() {
+	c, rec := s.newContext(echo.PUT, "/project/:id/schedule", `{"publish_at":"2020-10-09 10:00:00"}`)
+	h := NewProjectHandler(s.mockApp)
+	publishAt := "2020-10-09 10:00:00"
+	s.mockApp.EXPECT().SchedulePublication(42, 10, time.Date(2020, 10, 9, 10, 0, 0, 0, time.UTC)).
+		Return(&app.ExtendedProject{ID: 10, PublishAt: &publishAt}, nil)
+
+	s.Require().NoError(h.ScheduleProject(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	s.Require().Contains(rec.Body.String(), `"publish_at":"2020-10-09 10:00:00"`)
+}
+
+func (s *ProjectLifecycleSuite) TestScheduleProjectWrongTime() {
+	c, rec := s.newContext(echo.PUT, "/project/:id/schedule", `{"publish_at":"09.10.2020"}`)
+	h := NewProjectHandler(s.mockApp)
+
+	s.Require().NoError(h.ScheduleProject(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestGetProjectAudit() {
+	c, rec := s.newContext(echo.GET, "/project/:id/audit", "")
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().GetProjectAudit(42, 10).Return([]models.ProjectAudit{{
+		ID:        1,
+		ProjectID: 10,
+		UserID:    42,
+		Action:    models.AuditEdit,
+		Field:     "title",
+		OldValue:  "Pizza",
+		NewValue:  "Big pizza",
+		CreatedAt: time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC),
+	}}, nil)
+
+	s.Require().NoError(h.GetProjectAudit(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	var aJSON = `[{"id":1,"project":10,"user":42,"action":"edit","field":"title","old_value":"Pizza","new_value":"Big pizza","created_at":"2020-10-07T12:00:00Z"}]`
+	s.Require().Equal(aJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func TestProjectLifecycleSuite(t *testing.T) {
+	suite.Run(t, new(ProjectLifecycleSuite))
+}
@@ -81,7 +81,7 @@ func (s *ProjectSuite) TestGetSingleProject() {
 	s.Require().NoError(h.GetSingleProject(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pJSON = `{"id":1,"title":"Title","subtitle":"Subtitle","status":"search","release_date":"2020-09-30","event_date":null,"image_link":"","total":344,"percent":34,"category":{"id":1,"alias":"","name":""},"project_type":{"id":1,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true},"goal_people":0,"goal_amount":1000,"description":"","instructions":"","owner":{"id":1,"username":"","first_name":"John","last_name":"Doe","avatar":"","project_count":0,"success_rate":0}}`
+	var pJSON = `{"id":1,"title":"Title","subtitle":"Subtitle","status":"search","release_date":"2020-09-30","event_date":null,"image_link":"","total":344,"currency":"","percent":34,"category":{"id":1,"alias":"","name":""},"project_type":{"id":1,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true},"goal_people":0,"goal_amount":1000,"description":"","instructions":"","owner":{"id":1,"username":"","first_name":"John","last_name":"Doe","avatar":"","project_count":0,"success_rate":0},"pledge":{"min":0,"max":0,"step":0,"deny_overfunding":false},"private_amounts":false}`
 	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
@@ -119,10 +119,12 @@ func (s *ProjectSuite) TestCreateProject() {
 	h := NewProjectHandler(s.mockApp)
 	s.mockApp.EXPECT().CreateProject(
 		113,
+		0,
 		reqStruct.GoalPeople,
 		reqStruct.GoalAmount,
 		reqStruct.Category,
 		reqStruct.ProjectType,
+		reqStruct.Currency,
 		reqStruct.Title,
 		reqStruct.SubTitle,
 		reqStruct.Description,
@@ -130,6 +132,7 @@ func (s *ProjectSuite) TestCreateProject() {
 		reqStruct.Instructions,
 		time.Date(2020, 8, 20, 0, 0, 0, 0, time.UTC),
 		time.Time{},
+		models.PledgeRules{},
 	).Return(115, nil)
 	s.Require().NoError(h.CreateProject(c))
 	s.Require().Equal(http.StatusCreated, rec.Code)
@@ -175,12 +178,12 @@ func (s *ProjectSuite) TestUpdateProject() {
 		},
 	}
 	s.mockApp.EXPECT().UpdateProject(
-		17, 42, 0, 0, 0, 0, "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, false, false,
+		17, 42, 0, int64(0), 0, 0, "", "ChangeProject", "", "", "", "", time.Time{}, time.Time{}, (*models.PledgeRules)(nil), false, false,
 	).Return(expect, nil)
 	s.Require().NoError(h.UpdateProject(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pJSON = `{"id":17,"title":"ChangeProject","subtitle":"","status":"draft","release_date":"2020-09-30","event_date":null,"image_link":"","total":0,"percent":0,"category":{"id":0,"alias":"","name":""},"project_type":{"id":0,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true},"goal_people":0,"goal_amount":0,"description":"","instructions":"","owner":{"id":42,"username":"","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0}}`
+	var pJSON = `{"id":17,"title":"ChangeProject","subtitle":"","status":"draft","release_date":"2020-09-30","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":0,"alias":"","name":""},"project_type":{"id":0,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true},"goal_people":0,"goal_amount":0,"description":"","instructions":"","owner":{"id":42,"username":"","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0},"pledge":{"min":0,"max":0,"step":0,"deny_overfunding":false},"private_amounts":false}`
 	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
@@ -223,12 +226,12 @@ func (s *ProjectSuite) TestDropEventDate() {
 		},
 	}
 	s.mockApp.EXPECT().UpdateProject(
-		17, 42, 0, 0, 0, 0, "", "", "", "", "", time.Time{}, time.Time{}, false, true,
+		17, 42, 0, int64(0), 0, 0, "", "", "", "", "", "", time.Time{}, time.Time{}, (*models.PledgeRules)(nil), false, true,
 	).Return(expect, nil)
 	s.Require().NoError(h.UpdateProject(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pJSON = `{"id":17,"title":"ChangeProject","subtitle":"","status":"draft","release_date":"2020-09-30","event_date":null,"image_link":"","total":0,"percent":0,"category":{"id":0,"alias":"","name":""},"project_type":{"id":0,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true},"goal_people":0,"goal_amount":0,"description":"","instructions":"","owner":{"id":42,"username":"","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0}}`
+	var pJSON = `{"id":17,"title":"ChangeProject","subtitle":"","status":"draft","release_date":"2020-09-30","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":0,"alias":"","name":""},"project_type":{"id":0,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true},"goal_people":0,"goal_amount":0,"description":"","instructions":"","owner":{"id":42,"username":"","first_name":"","last_name":"","avatar":"","project_count":0,"success_rate":0},"pledge":{"min":0,"max":0,"step":0,"deny_overfunding":false},"private_amounts":false}`
 	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
@@ -271,15 +274,137 @@ func (s *ProjectSuite) TestGetProjectsWithPagination() {
 	c.QueryParams().Add("page_size", strconv.Itoa(pageSize))
 
 	h := NewProjectHandler(s.mockApp)
-	s.mockApp.EXPECT().GetProjectsWithPagination(1, 2, page, pageSize, true).Return(s.makeProjectList(), 2, true, nil)
+	filter := &models.ProjectFilter{Categories: []int{1}, ProjectTypes: []int{2}, OnlyOpen: true}
+	s.mockApp.EXPECT().GetProjectsWithPagination(filter, page, pageSize).Return(s.makeProjectList(), 2, true, nil)
 
 	s.Require().NoError(h.GetProjects(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pJSON = `{"results":[{"id":1,"title":"Title","subtitle":"Subtitle","status":"success","release_date":"2020-10-05","event_date":null,"image_link":"","total":0,"percent":0,"category":{"id":1,"alias":"","name":""},"project_type":{"id":1,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true}},{"id":2,"title":"Second Project","subtitle":"2 Subtitle","status":"search","release_date":"2020-11-01","event_date":null,"image_link":"","total":0,"percent":0,"category":{"id":2,"alias":"","name":""},"project_type":{"id":2,"alias":"","name":"","options":null,"goal_by_people":true,"goal_by_amount":false,"end_by_goal_gain":true}}],"next":2,"has_next":true}`
+	var pJSON = `{"results":[{"id":1,"title":"Title","subtitle":"Subtitle","status":"success","release_date":"2020-10-05","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":1,"alias":"","name":""},"project_type":{"id":1,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true}},{"id":2,"title":"Second Project","subtitle":"2 Subtitle","status":"search","release_date":"2020-11-01","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":2,"alias":"","name":""},"project_type":{"id":2,"alias":"","name":"","options":null,"goal_by_people":true,"goal_by_amount":false,"end_by_goal_gain":true}}],"next":2,"has_next":true}`
 	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
+func (s *ProjectSuite) TestGetProjectsWithFilter() {
+	req := httptest.NewRequest(echo.GET, "/project?category=1,3&project_type=2&status=search&status=harvest&owner=7&release_from=2020-10-01&release_to=2020-10-31&q=pizza&sort=-percent,release_date", nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project")
+
+	h := NewProjectHandler(s.mockApp)
+	filter := &models.ProjectFilter{
+		Categories:   []int{1, 3},
+		ProjectTypes: []int{2},
+		Statuses:     []string{"search", "harvest"},
+		Owners:       []int{7},
+		ReleaseFrom:  time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC),
+		ReleaseTo:    time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC),
+		Search:       "pizza",
+		Sort:         []models.ProjectSort{{Field: "percent", Desc: true}, {Field: "release_date"}},
+	}
+	s.mockApp.EXPECT().GetProjectsWithPagination(filter, 1, 10).Return([]*app.ExtendedProject{}, 0, false, nil)
+
+	s.Require().NoError(h.GetProjects(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ProjectSuite) TestGetProjectsWithCursor() {
+	cursor := &models.Cursor{Sort: "-total", Values: []string{"100"}, ID: 5}
+	req := httptest.NewRequest(echo.GET, "/project?sort=-total&limit=2&cursor="+cursor.Encode(), nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project")
+
+	h := NewProjectHandler(s.mockApp)
+	filter := &models.ProjectFilter{Sort: []models.ProjectSort{{Field: "total", Desc: true}}}
+	next := &models.Cursor{Sort: "-total", Values: []string{"0"}, ID: 2}
+	s.mockApp.EXPECT().GetProjectsWithCursor(filter, cursor, 2).Return([]*app.ExtendedProject{}, next, nil)
+
+	s.Require().NoError(h.GetProjects(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var pJSON = `{"results":[],"next":0,"next_cursor":"` + next.Encode() + `","has_next":true}`
+	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectSuite) TestGetProjectsWrongCursor() {
+	req := httptest.NewRequest(echo.GET, "/project?cursor=garbage", nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project")
+
+	h := NewProjectHandler(s.mockApp)
+	s.Require().NoError(h.GetProjects(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var errJSON = `{"error":"validation failed","fields":[{"field":"cursor","code":"invalid","message":"wrong cursor"}]}`
+	s.Require().Equal(errJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectSuite) TestGetProjectsByTag() {
+	req := httptest.NewRequest(echo.GET, "/project?tag=for-kids&tag=weekend,online", nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project")
+
+	h := NewProjectHandler(s.mockApp)
+	filter := &models.ProjectFilter{Tags: []string{"for-kids", "weekend", "online"}}
+	s.mockApp.EXPECT().GetProjectsWithPagination(filter, 1, 10).Return([]*app.ExtendedProject{}, 0, false, nil)
+
+	s.Require().NoError(h.GetProjects(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+}
+
+func (s *ProjectSuite) TestSetProjectTags() {
+	req := httptest.NewRequest(echo.PUT, "/", strings.NewReader(`{"tags":["For kids","weekend"]}`))
+	req.Header.Set("Content-type", "application/json")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/tags")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(2)
+	c.Set("user", token)
+
+	h := NewProjectHandler(s.mockApp)
+	tags := []models.Tag{{ID: 1, Alias: "for-kids", Name: "For kids", Curated: true}, {ID: 5, Alias: "weekend", Name: "weekend"}}
+	s.mockApp.EXPECT().SetProjectTags(2, 1, []string{"For kids", "weekend"}).Return(tags, nil)
+
+	s.Require().NoError(h.SetProjectTags(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tJSON = `[{"id":1,"alias":"for-kids","name":"For kids","curated":true},{"id":5,"alias":"weekend","name":"weekend","curated":false}]`
+	s.Require().Equal(tJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectSuite) TestGetProjectsWrongFilter() {
+	req := httptest.NewRequest(echo.GET, "/project?category=x&release_to=31.10.2020", nil)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project")
+
+	h := NewProjectHandler(s.mockApp)
+	s.Require().NoError(h.GetProjects(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+
+	var errJSON = `{"error":"validation failed","fields":[{"field":"category","code":"invalid","message":"wrong ID \"x\""},{"field":"release_to","code":"invalid","message":"wrong date \"31.10.2020\", expected YYYY-MM-DD"}]}`
+	s.Require().Equal(errJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
 func (s *ProjectSuite) makeProjectList() []*app.ExtendedProject {
 	return []*app.ExtendedProject{
 		{
@@ -325,16 +450,99 @@ func (s *ProjectSuite) TestGetUserProjects() {
 	c.QueryParams().Add("owned", "true")
 	c.QueryParams().Add("contributed", "true")
 
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(2)
+	c.Set("user", token)
+
 	h := NewProjectHandler(s.mockApp)
-	s.mockApp.EXPECT().GetUserProjects(1, true, true).Return(s.makeProjectList(), nil)
+	s.mockApp.EXPECT().GetUserProjects(1, 2, true, true, 0, 20).Return(s.makeProjectList(), 0, false, nil)
 
 	s.Require().NoError(h.GetUserProjects(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
-	var pJSON = `[{"id":1,"title":"Title","subtitle":"Subtitle","status":"success","release_date":"2020-10-05","event_date":null,"image_link":"","total":0,"percent":0,"category":{"id":1,"alias":"","name":""},"project_type":{"id":1,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true}},{"id":2,"title":"Second Project","subtitle":"2 Subtitle","status":"search","release_date":"2020-11-01","event_date":null,"image_link":"","total":0,"percent":0,"category":{"id":2,"alias":"","name":""},"project_type":{"id":2,"alias":"","name":"","options":null,"goal_by_people":true,"goal_by_amount":false,"end_by_goal_gain":true}}]`
+	var pJSON = `[{"id":1,"title":"Title","subtitle":"Subtitle","status":"success","release_date":"2020-10-05","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":1,"alias":"","name":""},"project_type":{"id":1,"alias":"","name":"","options":null,"goal_by_people":false,"goal_by_amount":true,"end_by_goal_gain":true}},{"id":2,"title":"Second Project","subtitle":"2 Subtitle","status":"search","release_date":"2020-11-01","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":2,"alias":"","name":""},"project_type":{"id":2,"alias":"","name":"","options":null,"goal_by_people":true,"goal_by_amount":false,"end_by_goal_gain":true}}]`
+	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectSuite) TestCloneProject() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"release_date":"2020-10-16"}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/project/:id/clone")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(5)
+	c.Set("user", token)
+
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().CloneProject(5, 10, time.Date(2020, 10, 16, 0, 0, 0, 0, time.UTC)).Return(11, nil)
+
+	s.Require().NoError(h.CloneProject(c))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+	s.Require().Equal(`{"id":11}`, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectSuite) TestCloneForeignProject() {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(echo.POST, "/", nil), rec)
+	c.SetPath("/project/:id/clone")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(5)
+	c.Set("user", token)
+
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().CloneProject(5, 10, time.Time{}).Return(0, app.ErrProjectModifyNotAllowed)
+
+	s.Require().NoError(h.CloneProject(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *ProjectSuite) TestGetTrendingProjects() {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(s.buildRequest(), rec)
+	c.SetPath("/project/trending")
+	c.QueryParams().Add("limit", "100")
+
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().GetTrendingProjects(maxRankingLimit).Return(s.makeProjectList()[1:], nil)
+
+	s.Require().NoError(h.GetTrendingProjects(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var pJSON = `[{"id":2,"title":"Second Project","subtitle":"2 Subtitle","status":"search","release_date":"2020-11-01","event_date":null,"image_link":"","total":0,"currency":"","percent":0,"category":{"id":2,"alias":"","name":""},"project_type":{"id":2,"alias":"","name":"","options":null,"goal_by_people":true,"goal_by_amount":false,"end_by_goal_gain":true}}]`
 	s.Require().Equal(pJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
+func (s *ProjectSuite) TestGetRecommendedProjects() {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(s.buildRequest(), rec)
+	c.SetPath("/project/recommended")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(2)
+	c.Set("user", token)
+
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().GetRecommendedProjects(2, defaultRankingLimit).Return(nil, app.ErrProjectRetrieve)
+
+	s.Require().NoError(h.GetRecommendedProjects(c))
+	s.Require().Equal(http.StatusInternalServerError, rec.Code)
+}
+
 func TestProjectSuite(t *testing.T) {
 	suite.Run(t, new(ProjectSuite))
 }
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+// ProjectUpdateHandler ...
+type ProjectUpdateHandler struct {
+	app app.Application
+}
+
+// NewProjectUpdateHandler ...
+func NewProjectUpdateHandler(a app.Application) *ProjectUpdateHandler {
+	return &ProjectUpdateHandler{app: a}
+}
+
+// ProjectUpdateCreateRequest ...
+type ProjectUpdateCreateRequest struct {
+	ProjectID        int    `json:"project"`
+	Title            string `json:"title"`
+	Text             string `json:"text"`
+	ParticipantsOnly bool   `json:"participants_only"`
+}
+
+// GetProjectUpdates godoc
+// @Summary Returns project updates
+// @Description Returns news of project, newest first. Updates for participants are visible only to owner and participants
+// @Tags update
+// @ID get-project-updates
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} []models.ProjectUpdate
+// @Security Bearer
+// @Router /update/project/{id} [get]
+func (h *ProjectUpdateHandler) GetProjectUpdates(c echo.Context) error {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	updates, err := h.app.GetProjectUpdates(projectID, userID)
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, updates)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get updates"))
+	}
+}
+
+// CreateProjectUpdate godoc
+// @Summary Post project update
+// @Description Post news of published project by owner. Participants are notified
+// @Tags update
+// @ID post-project-update
+// @Accept json
+// @Produce json
+// @Param request body ProjectUpdateCreateRequest true "Request body"
+// @Success 201 {object} models.ProjectUpdate
+// @Security Bearer
+// @Router /update [post]
+func (h *ProjectUpdateHandler) CreateProjectUpdate(c echo.Context) error {
+	request := new(ProjectUpdateCreateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	update, err := h.app.CreateProjectUpdate(userID, request.ProjectID, request.Title, request.Text, request.ParticipantsOnly)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+
+	switch err {
+	case nil:
+		return c.JSON(http.StatusCreated, update)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrUpdateModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("posting is not allowed"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to post update"))
+	}
+}
+
+// DeleteProjectUpdate godoc
+// @Summary Delete project update
+// @Description Delete project update by owner
+// @Tags update
+// @ID delete-project-update
+// @Param id path int true "Update ID"
+// @Success 204
+// @Security Bearer
+// @Router /update/{id} [delete]
+func (h *ProjectUpdateHandler) DeleteProjectUpdate(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	updateID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.DeleteProjectUpdate(userID, updateID)
+	switch err {
+	case nil:
+		return c.NoContent(http.StatusNoContent)
+	case app.ErrUpdateNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("update not found"))
+	case app.ErrUpdateModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to delete update"))
+	}
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type ProjectUpdateSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *ProjectUpdateSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *ProjectUpdateSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *ProjectUpdateSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *ProjectUpdateSuite) TestGetProjectUpdates() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/update/project/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+	s.setUser(c, 5)
+
+	updates := []models.ProjectUpdate{
+		{
+			ID:               1,
+			ProjectID:        10,
+			Title:            "Ordered",
+			Text:             "Waiting for delivery",
+			ParticipantsOnly: true,
+			CreatedAt:        time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	h := NewProjectUpdateHandler(s.mockApp)
+	s.mockApp.EXPECT().GetProjectUpdates(10, 5).Return(updates, nil)
+	s.Require().NoError(h.GetProjectUpdates(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var uJSON = `[{"id":1,"project":10,"title":"Ordered","text":"Waiting for delivery","participants_only":true,"created_at":"2020-08-20T12:00:00Z"}]`
+	s.Require().Equal(uJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectUpdateSuite) TestCreateProjectUpdateForbidden() {
+	body, err := json.Marshal(ProjectUpdateCreateRequest{ProjectID: 10, Title: "Arrived", Text: "Desk 4"})
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/update")
+	s.setUser(c, 5)
+
+	h := NewProjectUpdateHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateProjectUpdate(5, 10, "Arrived", "Desk 4", false).Return(nil, app.ErrUpdateModifyNotAllowed)
+	s.Require().NoError(h.CreateProjectUpdate(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *ProjectUpdateSuite) TestDeleteProjectUpdate() {
+	req := httptest.NewRequest(echo.DELETE, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/update/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	s.setUser(c, 42)
+
+	h := NewProjectUpdateHandler(s.mockApp)
+	s.mockApp.EXPECT().DeleteProjectUpdate(42, 3).Return(nil)
+	s.Require().NoError(h.DeleteProjectUpdate(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
+func TestProjectUpdateSuite(t *testing.T) {
+	suite.Run(t, new(ProjectUpdateSuite))
+}
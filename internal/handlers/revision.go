@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+// GetProjectRevisions godoc
+// @Summary Returns revisions of project
+// @Description Returns every saved state of project with author and time, oldest first.
+// @Description Flagged lists financial fields changed after participants joined.
+// @Tags project
+// @ID get-project-revisions
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.ProjectRevision
+// @Failure 404 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/revisions [get]
+func (h *ProjectHandler) GetProjectRevisions(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+
+	revisions, err := h.app.GetProjectRevisions(userID, projectID)
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, revisions)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get revisions"))
+	}
+}
+
+// GetRevisionDiff godoc
+// @Summary Returns changes between two revisions of project
+// @Description Field-level diff between revisions, financial fields changed after participants joined are marked with after_join.
+// @Description Without to latest revision is used, without from revision before to is used.
+// @Tags project
+// @ID get-revision-diff
+// @Produce json
+// @Param id path int true "Project ID"
+// @Param from query int false "Revision number to compare from"
+// @Param to query int false "Revision number to compare to"
+// @Success 200 {object} app.RevisionDiff
+// @Failure 400 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security Bearer
+// @Router /project/{id}/revisions/diff [get]
+func (h *ProjectHandler) GetRevisionDiff(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	projectID, _ := strconv.Atoi(c.Param("id"))
+	var from, to int
+	if value := c.QueryParam("from"); value != "" {
+		from, err = strconv.Atoi(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, errorResponse("wrong revision"))
+		}
+	}
+	if value := c.QueryParam("to"); value != "" {
+		to, err = strconv.Atoi(value)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, errorResponse("wrong revision"))
+		}
+	}
+
+	diff, err := h.app.GetRevisionDiff(userID, projectID, from, to)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case nil:
+		return c.JSON(http.StatusOK, diff)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrRevisionNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("revision not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get diff"))
+	}
+}
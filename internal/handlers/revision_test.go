@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+func (s *ProjectLifecycleSuite) TestGetProjectRevisions() {
+	c, rec := s.newContext(echo.GET, "/project/:id/revisions", "")
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().GetProjectRevisions(42, 10).Return([]models.ProjectRevision{{
+		ProjectID: 10,
+		Number:    2,
+		UserID:    42,
+		Fields:    map[string]string{"goal_amount": "5000"},
+		Flagged:   []string{"goal_amount"},
+		CreatedAt: time.Date(2020, 10, 7, 12, 0, 0, 0, time.UTC),
+	}}, nil)
+
+	s.Require().NoError(h.GetProjectRevisions(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	var rJSON = `[{"project":10,"number":2,"user":42,"fields":{"goal_amount":"5000"},"flagged":["goal_amount"],"created_at":"2020-10-07T12:00:00Z"}]`
+	s.Require().Equal(rJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectLifecycleSuite) TestGetRevisionDiff() {
+	c, rec := s.newContext(echo.GET, "/project/:id/revisions/diff", "")
+	c.QueryParams().Set("from", "1")
+	c.QueryParams().Set("to", "3")
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().GetRevisionDiff(42, 10, 1, 3).Return(&app.RevisionDiff{
+		From: 1,
+		To:   3,
+		Changes: []app.RevisionChange{
+			{Field: "goal_amount", Old: "3000", New: "5000", Financial: true, AfterJoin: true},
+		},
+	}, nil)
+
+	s.Require().NoError(h.GetRevisionDiff(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+	var dJSON = `{"from":1,"to":3,"changes":[{"field":"goal_amount","old":"3000","new":"5000","financial":true,"after_join":true}]}`
+	s.Require().Equal(dJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *ProjectLifecycleSuite) TestGetRevisionDiffWrongRevision() {
+	c, rec := s.newContext(echo.GET, "/project/:id/revisions/diff", "")
+	c.QueryParams().Set("to", "last")
+	h := NewProjectHandler(s.mockApp)
+
+	s.Require().NoError(h.GetRevisionDiff(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *ProjectLifecycleSuite) TestGetRevisionDiffNotFound() {
+	c, rec := s.newContext(echo.GET, "/project/:id/revisions/diff", "")
+	h := NewProjectHandler(s.mockApp)
+	s.mockApp.EXPECT().GetRevisionDiff(42, 10, 0, 0).Return(nil, app.ErrRevisionNotFound)
+
+	s.Require().NoError(h.GetRevisionDiff(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
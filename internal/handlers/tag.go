@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultTagLimit = 10
+	maxTagLimit     = 50
+)
+
+// TagHandler ...
+type TagHandler struct {
+	app app.Application
+}
+
+// NewTagHandler ...
+func NewTagHandler(a app.Application) *TagHandler {
+	return &TagHandler{app: a}
+}
+
+// TagCreateRequest ...
+type TagCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// TagMergeRequest ...
+type TagMergeRequest struct {
+	Into int `json:"into"`
+}
+
+// tagErrorResponse writes response for common tag errors.
+func tagErrorResponse(c echo.Context, err error, message string) error {
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case app.ErrAdminRequired:
+		return c.JSON(http.StatusForbidden, errorResponse("admin required"))
+	case app.ErrTagNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("tag not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(message))
+	}
+}
+
+// SearchTags godoc
+// @Summary Returns tags for autocomplete
+// @Description Returns tags starting with query, curated tags go first, then the most used ones
+// @Tags tag
+// @ID get-tags
+// @Produce json
+// @Param q query string false "Beginning of tag"
+// @Param limit query int false "Count of tags, 50 at most"
+// @Success 200 {array} models.Tag
+// @Security Bearer
+// @Router /tag [get]
+func (h *TagHandler) SearchTags(c echo.Context) error {
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultTagLimit
+	}
+	if limit > maxTagLimit {
+		limit = maxTagLimit
+	}
+
+	tags, err := h.app.SearchTags(c.QueryParam("q"), limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get tags"))
+	}
+
+	return c.JSON(http.StatusOK, tags)
+}
+
+// GetTagStats godoc
+// @Summary Returns tag usage
+// @Description Returns tags with count of published projects by status
+// @Tags tag
+// @ID get-tag-stats
+// @Produce json
+// @Success 200 {array} app.TagStats
+// @Security Bearer
+// @Router /tag/stats [get]
+func (h *TagHandler) GetTagStats(c echo.Context) error {
+	stats, err := h.app.GetTagStats()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get tag stats"))
+	}
+
+	return c.JSON(http.StatusOK, stats)
+}
+
+// CreateTag godoc
+// @Summary Create curated tag
+// @Description Create curated tag or mark existing one as curated, admin only
+// @Tags tag
+// @ID post-tag
+// @Accept json
+// @Produce json
+// @Param request body TagCreateRequest true "Request body"
+// @Success 201 {object} models.Tag
+// @Security Bearer
+// @Router /tag [post]
+func (h *TagHandler) CreateTag(c echo.Context) error {
+	request := new(TagCreateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	tag, err := h.app.CreateTag(userID, request.Name)
+	if err != nil {
+		return tagErrorResponse(c, err, "unable to create tag")
+	}
+
+	return c.JSON(http.StatusCreated, tag)
+}
+
+// MergeTags godoc
+// @Summary Merge tags
+// @Description Move projects of tag to another tag and delete it, admin only
+// @Tags tag
+// @ID merge-tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param request body TagMergeRequest true "Request body"
+// @Success 200 {object} models.Tag
+// @Security Bearer
+// @Router /tag/{id}/merge [post]
+func (h *TagHandler) MergeTags(c echo.Context) error {
+	request := new(TagMergeRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	tagID, _ := strconv.Atoi(c.Param("id"))
+
+	tag, err := h.app.MergeTags(userID, tagID, request.Into)
+	if err != nil {
+		return tagErrorResponse(c, err, "unable to merge tags")
+	}
+
+	return c.JSON(http.StatusOK, tag)
+}
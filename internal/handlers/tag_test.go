@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type TagSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *TagSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *TagSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *TagSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *TagSuite) TestSearchTags() {
+	req := httptest.NewRequest(echo.GET, "/tag?q=for&limit=500", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tag")
+
+	h := NewTagHandler(s.mockApp)
+	tags := []models.Tag{{ID: 1, Alias: "for-kids", Name: "For kids", Curated: true}}
+	s.mockApp.EXPECT().SearchTags("for", maxTagLimit).Return(tags, nil)
+	s.Require().NoError(h.SearchTags(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tJSON = `[{"id":1,"alias":"for-kids","name":"For kids","curated":true}]`
+	s.Require().Equal(tJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TagSuite) TestGetTagStats() {
+	req := httptest.NewRequest(echo.GET, "/tag/stats", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tag/stats")
+
+	h := NewTagHandler(s.mockApp)
+	stats := []app.TagStats{{
+		Tag:    models.Tag{ID: 2, Alias: "weekend", Name: "Weekend"},
+		Counts: map[string]int{"search": 3, "success": 1},
+		Total:  4,
+	}}
+	s.mockApp.EXPECT().GetTagStats().Return(stats, nil)
+	s.Require().NoError(h.GetTagStats(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var sJSON = `[{"tag":{"id":2,"alias":"weekend","name":"Weekend","curated":false},"counts":{"search":3,"success":1},"total":4}]`
+	s.Require().Equal(sJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TagSuite) TestCreateTagNotAdmin() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"name":"For kids"}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tag")
+	s.setUser(c, 5)
+
+	h := NewTagHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateTag(5, "For kids").Return(nil, app.ErrAdminRequired)
+	s.Require().NoError(h.CreateTag(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *TagSuite) TestMergeTags() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"into":3}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tag/:id/merge")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+	s.setUser(c, 1)
+
+	h := NewTagHandler(s.mockApp)
+	s.mockApp.EXPECT().MergeTags(1, 2, 3).Return(&models.Tag{ID: 3, Alias: "for-kids", Name: "For kids", Curated: true}, nil)
+	s.Require().NoError(h.MergeTags(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tJSON = `{"id":3,"alias":"for-kids","name":"For kids","curated":true}`
+	s.Require().Equal(tJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TagSuite) TestMergeTagsNotFound() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"into":3}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tag/:id/merge")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+	s.setUser(c, 1)
+
+	h := NewTagHandler(s.mockApp)
+	s.mockApp.EXPECT().MergeTags(1, 2, 3).Return(nil, app.ErrTagNotFound)
+	s.Require().NoError(h.MergeTags(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestTagSuite(t *testing.T) {
+	suite.Run(t, new(TagSuite))
+}
@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// TemplateHandler ...
+type TemplateHandler struct {
+	app app.Application
+}
+
+// NewTemplateHandler ...
+func NewTemplateHandler(a app.Application) *TemplateHandler {
+	return &TemplateHandler{app: a}
+}
+
+// TemplateCreateRequest ...
+type TemplateCreateRequest struct {
+	Name         string `json:"name"`
+	Shared       bool   `json:"shared,omitempty"`
+	Title        string `json:"title"`
+	Description  string `json:"description"`
+	Instructions string `json:"instructions"`
+	ProjectType  int    `json:"project_type,omitempty"`
+	GoalPeople   int    `json:"goal_people"`
+	GoalAmount   int64  `json:"goal_amount"`
+}
+
+// GetTemplates godoc
+// @Summary Returns project templates
+// @Description Returns shared templates and templates of current user
+// @Tags template
+// @ID get-templates
+// @Produce json
+// @Success 200 {array} models.ProjectTemplate
+// @Security Bearer
+// @Router /template [get]
+func (h *TemplateHandler) GetTemplates(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	templates, err := h.app.GetTemplates(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get templates"))
+	}
+
+	return c.JSON(http.StatusOK, templates)
+}
+
+// CreateTemplate godoc
+// @Summary Create project template
+// @Description Create template which can seed new projects, only admins can share templates
+// @Tags template
+// @ID post-template
+// @Accept json
+// @Produce json
+// @Param request body TemplateCreateRequest true "Request body"
+// @Success 201 {object} models.ProjectTemplate
+// @Failure 400 {object} map[string]interface{}
+// @Security Bearer
+// @Router /template [post]
+func (h *TemplateHandler) CreateTemplate(c echo.Context) error {
+	request := new(TemplateCreateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	template, err := h.app.CreateTemplate(userID, &models.ProjectTemplate{
+		Name:          request.Name,
+		Shared:        request.Shared,
+		Title:         request.Title,
+		Description:   request.Description,
+		Instructions:  request.Instructions,
+		ProjectTypeID: request.ProjectType,
+		GoalPeople:    request.GoalPeople,
+		GoalAmount:    request.GoalAmount,
+	})
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case nil:
+		return c.JSON(http.StatusCreated, template)
+	case app.ErrAdminRequired:
+		return c.JSON(http.StatusForbidden, errorResponse("only admins can share templates"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to create template"))
+	}
+}
+
+// DeleteTemplate godoc
+// @Summary Delete project template
+// @Description Delete own template, shared templates can be deleted by admins
+// @Tags template
+// @ID delete-template
+// @Param id path int true "Template ID"
+// @Success 204
+// @Security Bearer
+// @Router /template/{id} [delete]
+func (h *TemplateHandler) DeleteTemplate(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	templateID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.DeleteTemplate(userID, templateID)
+	switch err {
+	case nil:
+		return c.NoContent(http.StatusNoContent)
+	case app.ErrTemplateNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("template not found"))
+	case app.ErrTemplateModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to delete template"))
+	}
+}
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type TemplateSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *TemplateSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *TemplateSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *TemplateSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *TemplateSuite) TestGetTemplates() {
+	req := httptest.NewRequest(echo.GET, "/template", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/template")
+	s.setUser(c, 5)
+
+	h := NewTemplateHandler(s.mockApp)
+	templates := []models.ProjectTemplate{{ID: 3, OwnerID: 1, Name: "Pizza", Shared: true, Title: "Pizza order", ProjectTypeID: 1, GoalAmount: 3000}}
+	s.mockApp.EXPECT().GetTemplates(5).Return(templates, nil)
+	s.Require().NoError(h.GetTemplates(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tJSON = `[{"id":3,"owner":1,"name":"Pizza","shared":true,"title":"Pizza order","description":"","instructions":"","project_type":1,"goal_people":0,"goal_amount":3000}]`
+	s.Require().Equal(tJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TemplateSuite) TestCreateTemplate() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"name":"Pizza","title":"Pizza order","goal_amount":3000}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/template")
+	s.setUser(c, 5)
+
+	h := NewTemplateHandler(s.mockApp)
+	s.mockApp.EXPECT().
+		CreateTemplate(5, &models.ProjectTemplate{Name: "Pizza", Title: "Pizza order", GoalAmount: 3000}).
+		Return(&models.ProjectTemplate{ID: 4, OwnerID: 5, Name: "Pizza", Title: "Pizza order", GoalAmount: 3000}, nil)
+	s.Require().NoError(h.CreateTemplate(c))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+
+	var tJSON = `{"id":4,"owner":5,"name":"Pizza","shared":false,"title":"Pizza order","description":"","instructions":"","goal_people":0,"goal_amount":3000}`
+	s.Require().Equal(tJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TemplateSuite) TestCreateSharedTemplateNotAdmin() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"name":"Pizza","shared":true}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/template")
+	s.setUser(c, 5)
+
+	h := NewTemplateHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateTemplate(5, &models.ProjectTemplate{Name: "Pizza", Shared: true}).Return(nil, app.ErrAdminRequired)
+	s.Require().NoError(h.CreateTemplate(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *TemplateSuite) TestDeleteTemplate() {
+	req := httptest.NewRequest(echo.DELETE, "/", nil)
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/template/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("3")
+	s.setUser(c, 5)
+
+	h := NewTemplateHandler(s.mockApp)
+	s.mockApp.EXPECT().DeleteTemplate(5, 3).Return(app.ErrTemplateNotFound)
+	s.Require().NoError(h.DeleteTemplate(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestTemplateSuite(t *testing.T) {
+	suite.Run(t, new(TemplateSuite))
+}
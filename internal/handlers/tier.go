@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/labstack/echo/v4"
+)
+
+// TierHandler ...
+type TierHandler struct {
+	app app.Application
+}
+
+// NewTierHandler ...
+func NewTierHandler(a app.Application) *TierHandler {
+	return &TierHandler{app: a}
+}
+
+// TierCreateRequest ...
+type TierCreateRequest struct {
+	ProjectID   int    `json:"project"`
+	Amount      int64  `json:"amount"`
+	Quantity    int    `json:"quantity"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+// GetProjectTiers godoc
+// @Summary Returns list of project reward tiers
+// @Description Returns list of project reward tiers with taken rewards count
+// @Tags tier
+// @ID get-project-tiers
+// @Produce json
+// @Param id path int true "Project ID"
+// @Success 200 {object} []models.Tier
+// @Security Bearer
+// @Router /tier/project/{id} [get]
+func (h *TierHandler) GetProjectTiers(c echo.Context) error {
+	projectID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	tiers, err := h.app.GetProjectTiers(projectID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get tiers"))
+	}
+
+	return c.JSON(http.StatusOK, tiers)
+}
+
+// CreateTier godoc
+// @Summary Create reward tier
+// @Description Create reward tier for not published project
+// @Tags tier
+// @ID post-tier
+// @Accept json
+// @Produce json
+// @Param request body TierCreateRequest true "Request body"
+// @Success 201 {object} models.Tier
+// @Security Bearer
+// @Router /tier [post]
+func (h *TierHandler) CreateTier(c echo.Context) error {
+	request := new(TierCreateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	tier, err := h.app.CreateTier(userID, request.ProjectID, request.Amount, request.Quantity, request.Title, request.Description)
+
+	switch err {
+	case nil:
+		return c.JSON(http.StatusCreated, tier)
+	case app.ErrProjectNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("project not found"))
+	case app.ErrTierModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case app.ErrTierWrong:
+		return c.JSON(http.StatusBadRequest, errorResponse("params are wrong"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to create tier"))
+	}
+}
+
+// DeleteTier godoc
+// @Summary Delete reward tier
+// @Description Delete reward tier of not published project
+// @Tags tier
+// @ID delete-tier
+// @Param id path int true "Tier ID"
+// @Success 204
+// @Security Bearer
+// @Router /tier/{id} [delete]
+func (h *TierHandler) DeleteTier(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	tierID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.DeleteTier(userID, tierID)
+	switch err {
+	case app.ErrTierNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("tier not found"))
+	case app.ErrTierModifyNotAllowed:
+		return c.JSON(http.StatusForbidden, errorResponse("modification is not allowed"))
+	case nil:
+		return c.NoContent(http.StatusNoContent)
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(err.Error()))
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type TierSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *TierSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *TierSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *TierSuite) TestGetProjectTiers() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tier/project/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("10")
+
+	h := NewTierHandler(s.mockApp)
+	tiers := []models.Tier{
+		{ID: 1, ProjectID: 10, Amount: 50000, Title: "basic"},
+		{ID: 2, ProjectID: 10, Amount: 150000, Title: "weekend", Quantity: 2, Taken: 1},
+	}
+	s.mockApp.EXPECT().GetProjectTiers(10).Return(tiers, nil)
+	s.Require().NoError(h.GetProjectTiers(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var tiersJSON = `[{"id":1,"project":10,"amount":50000,"title":"basic","description":"","quantity":0,"taken":0},{"id":2,"project":10,"amount":150000,"title":"weekend","description":"","quantity":2,"taken":1}]`
+	s.Require().Equal(tiersJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TierSuite) TestCreateTier() {
+	reqStruct := TierCreateRequest{
+		ProjectID: 10,
+		Amount:    150000,
+		Quantity:  2,
+		Title:     "weekend",
+	}
+	body, err := json.Marshal(reqStruct)
+	if err != nil {
+		s.T().Fail()
+	}
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(body))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tier")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(42)
+	c.Set("user", token)
+
+	h := NewTierHandler(s.mockApp)
+	expect := &models.Tier{ID: 2, ProjectID: 10, Amount: 150000, Quantity: 2, Title: "weekend"}
+	s.mockApp.EXPECT().CreateTier(42, 10, int64(150000), 2, "weekend", "").Return(expect, nil)
+	s.Require().NoError(h.CreateTier(c))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+
+	var tierJSON = `{"id":2,"project":10,"amount":150000,"title":"weekend","description":"","quantity":2,"taken":0}`
+	s.Require().Equal(tierJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *TierSuite) TestDeleteTierNotAllowed() {
+	req := httptest.NewRequest(echo.DELETE, "/", bytes.NewBuffer(nil))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/tier/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(7)
+	c.Set("user", token)
+
+	h := NewTierHandler(s.mockApp)
+	s.mockApp.EXPECT().DeleteTier(7, 2).Return(app.ErrTierModifyNotAllowed)
+	s.Require().NoError(h.DeleteTier(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func TestTierSuite(t *testing.T) {
+	suite.Run(t, new(TierSuite))
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/FreakyGranny/launchpad-api/internal/app"
 	"github.com/labstack/echo/v4"
@@ -18,6 +19,19 @@ func NewUserHandler(a app.Application) *UserHandler {
 	return &UserHandler{app: a}
 }
 
+// EmailSettingsRequest ...
+type EmailSettingsRequest struct {
+	Locale string   `json:"locale"`
+	Digest bool     `json:"digest"`
+	Events []string `json:"events"`
+}
+
+// ChatLinkResponse ...
+type ChatLinkResponse struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
 // GetCurrentUser godoc
 // @Summary Show a current user
 // @Description Returns user by ID from token
@@ -33,7 +47,7 @@ func (h *UserHandler) GetCurrentUser(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, err)
 	}
 
-	user, err := h.app.GetUser(userID)
+	user, err := h.app.GetUser(userID, userID)
 	switch err {
 	case app.ErrUserNotFound:
 		return c.JSON(http.StatusNotFound, nil)
@@ -59,7 +73,11 @@ func (h *UserHandler) GetUser(c echo.Context) error {
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
 	}
-	user, err := h.app.GetUser(intID)
+	viewerID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	user, err := h.app.GetUser(intID, viewerID)
 	switch err {
 	case app.ErrUserNotFound:
 		return c.JSON(http.StatusNotFound, nil)
@@ -69,3 +87,103 @@ func (h *UserHandler) GetUser(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, errorResponse("unexpected error"))
 	}
 }
+
+// GetEmailSettings godoc
+// @Summary Show email settings
+// @Description Returns email notification settings of current user
+// @Tags user
+// @ID get-email-settings
+// @Produce json
+// @Success 200 {object} models.EmailSettings
+// @Security Bearer
+// @Router /user/email_settings [get]
+func (h *UserHandler) GetEmailSettings(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	settings, err := h.app.GetEmailSettings(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to get email settings"))
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+// UpdateEmailSettings godoc
+// @Summary Update email settings
+// @Description Sets locale, digest mode and event types which current user wants to receive by email
+// @Tags user
+// @ID update-email-settings
+// @Accept json
+// @Produce json
+// @Param request body EmailSettingsRequest true "Request body"
+// @Success 200 {object} models.EmailSettings
+// @Security Bearer
+// @Router /user/email_settings [put]
+func (h *UserHandler) UpdateEmailSettings(c echo.Context) error {
+	request := new(EmailSettingsRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	settings, err := h.app.UpdateEmailSettings(userID, request.Locale, request.Digest, request.Events)
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to update email settings"))
+	}
+
+	return c.JSON(http.StatusOK, settings)
+}
+
+// CreateChatLink godoc
+// @Summary Create chat link code
+// @Description Returns one-time code, user sends it to chat bot with /link command to link chat account
+// @Tags user
+// @ID create-chat-link
+// @Produce json
+// @Success 200 {object} ChatLinkResponse
+// @Security Bearer
+// @Router /user/chat_link [post]
+func (h *UserHandler) CreateChatLink(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	code, expiresAt, err := h.app.CreateChatLinkCode(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to create chat link code"))
+	}
+
+	return c.JSON(http.StatusOK, &ChatLinkResponse{Code: code, ExpiresAt: expiresAt})
+}
+
+// DeleteChatLink godoc
+// @Summary Unlink chat account
+// @Description Removes link between current user and chat account
+// @Tags user
+// @ID delete-chat-link
+// @Success 204
+// @Security Bearer
+// @Router /user/chat_link [delete]
+func (h *UserHandler) DeleteChatLink(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	err = h.app.UnlinkChatAccount(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, errorResponse("unable to unlink chat account"))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
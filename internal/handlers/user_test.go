@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/mock/gomock"
@@ -71,7 +72,11 @@ func (s *UserSuite) TestGetUserByID() {
 		},
 	}
 
-	s.mockApp.EXPECT().GetUser(1).Return(user, nil)
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(2)
+	c.Set("user", token)
+	s.mockApp.EXPECT().GetUser(1, 2).Return(user, nil)
 
 	s.Require().NoError(h.GetUser(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
@@ -92,7 +97,11 @@ func (s *UserSuite) TestGetUserNotFound() {
 
 	h := NewUserHandler(s.mockApp)
 
-	s.mockApp.EXPECT().GetUser(1).Return(nil, app.ErrUserNotFound)
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(2)
+	c.Set("user", token)
+	s.mockApp.EXPECT().GetUser(1, 2).Return(nil, app.ErrUserNotFound)
 
 	s.Require().NoError(h.GetUser(c))
 	s.Require().Equal(http.StatusNotFound, rec.Code)
@@ -133,7 +142,7 @@ func (s *UserSuite) TestGetCurrentUser() {
 	c.Set("user", token)
 	h := NewUserHandler(s.mockApp)
 
-	s.mockApp.EXPECT().GetUser(1).Return(user, nil)
+	s.mockApp.EXPECT().GetUser(1, 1).Return(user, nil)
 	s.Require().NoError(h.GetCurrentUser(c))
 	s.Require().Equal(http.StatusOK, rec.Code)
 
@@ -141,6 +150,93 @@ func (s *UserSuite) TestGetCurrentUser() {
 	s.Require().Equal(tokenJSON, strings.Trim(rec.Body.String(), "\n"))
 }
 
+func (s *UserSuite) TestUpdateEmailSettings() {
+	req := httptest.NewRequest(echo.PUT, "/", strings.NewReader(`{"locale":"en","digest":true,"events":["project_locked"]}`))
+	req.Header.Set("Content-type", "application/json")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/user/email_settings")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1)
+	c.Set("user", token)
+	h := NewUserHandler(s.mockApp)
+
+	settings := &models.EmailSettings{UserID: 1, Locale: "en", Digest: true, Events: []string{"project_locked"}}
+	s.mockApp.EXPECT().UpdateEmailSettings(1, "en", true, []string{"project_locked"}).Return(settings, nil)
+	s.Require().NoError(h.UpdateEmailSettings(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var settingsJSON = `{"locale":"en","digest":true,"events":["project_locked"]}`
+	s.Require().Equal(settingsJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *UserSuite) TestUpdateEmailSettingsInvalid() {
+	req := httptest.NewRequest(echo.PUT, "/", strings.NewReader(`{"locale":"de"}`))
+	req.Header.Set("Content-type", "application/json")
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/user/email_settings")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1)
+	c.Set("user", token)
+	h := NewUserHandler(s.mockApp)
+
+	vErr := &app.ValidationError{Fields: []app.FieldError{{Field: "locale", Code: app.CodeNotAllowed, Message: "locale \"de\" is not supported"}}}
+	s.mockApp.EXPECT().UpdateEmailSettings(1, "de", false, nil).Return(nil, vErr)
+	s.Require().NoError(h.UpdateEmailSettings(c))
+	s.Require().Equal(http.StatusBadRequest, rec.Code)
+}
+
+func (s *UserSuite) TestCreateChatLink() {
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(nil))
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/user/chat_link")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1)
+	c.Set("user", token)
+	h := NewUserHandler(s.mockApp)
+
+	expiresAt := time.Date(2020, 10, 1, 12, 10, 0, 0, time.UTC)
+	s.mockApp.EXPECT().CreateChatLinkCode(1).Return("ABCD2345", expiresAt, nil)
+	s.Require().NoError(h.CreateChatLink(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var linkJSON = `{"code":"ABCD2345","expires_at":"2020-10-01T12:10:00Z"}`
+	s.Require().Equal(linkJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *UserSuite) TestDeleteChatLink() {
+	req := httptest.NewRequest(echo.DELETE, "/", bytes.NewBuffer(nil))
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/user/chat_link")
+
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(1)
+	c.Set("user", token)
+	h := NewUserHandler(s.mockApp)
+
+	s.mockApp.EXPECT().UnlinkChatAccount(1).Return(nil)
+	s.Require().NoError(h.DeleteChatLink(c))
+	s.Require().Equal(http.StatusNoContent, rec.Code)
+}
+
 func TestUserSuite(t *testing.T) {
 	suite.Run(t, new(UserSuite))
 }
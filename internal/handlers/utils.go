@@ -2,10 +2,19 @@ package handlers
 
 import (
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
 	"github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	defaultCursorLimit = 20
+	maxCursorLimit     = 100
+	defaultPageSize    = 10
 )
 
 func errorResponse(message string) map[string]string {
@@ -14,6 +23,13 @@ func errorResponse(message string) map[string]string {
 	}
 }
 
+func validationErrorResponse(err *app.ValidationError) map[string]interface{} {
+	return map[string]interface{}{
+		"error":  "validation failed",
+		"fields": err.Fields,
+	}
+}
+
 func getUserIDFromToken(t interface{}) (int, error) {
 	userToken, ok := t.(*jwt.Token)
 	if !ok {
@@ -40,3 +56,71 @@ func parseDateTime(value string) (time.Time, error) {
 
 	return time.Time{}, nil
 }
+
+// isCursorMode checks client requested cursor pagination instead of legacy one.
+func isCursorMode(c echo.Context) bool {
+	params := c.QueryParams()
+	_, hasCursor := params["cursor"]
+	_, hasLimit := params["limit"]
+
+	return hasCursor || hasLimit
+}
+
+// parseCursor returns opaque cursor and page limit from query params.
+func parseCursor(c echo.Context) (*models.Cursor, int, error) {
+	cursor, err := models.DecodeCursor(c.QueryParam("cursor"))
+	if err != nil {
+		return nil, 0, err
+	}
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultCursorLimit
+	}
+	if limit > maxCursorLimit {
+		limit = maxCursorLimit
+	}
+
+	return cursor, limit, nil
+}
+
+// parsePage returns page number and page size, out of range values are clamped like cursor limit.
+func parsePage(c echo.Context) (int, int) {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page <= 0 {
+		page = 1
+	}
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxCursorLimit {
+		pageSize = maxCursorLimit
+	}
+
+	return page, pageSize
+}
+
+// parseIDCursor returns position from cursor of list ordered by id and page limit.
+func parseIDCursor(c echo.Context) (int, int, error) {
+	cursor, limit, err := parseCursor(c)
+	if err != nil || cursor == nil {
+		return 0, limit, err
+	}
+
+	return cursor.ID, limit, nil
+}
+
+// idCursor returns opaque cursor of list ordered by id, empty for the last page.
+func idCursor(id int) string {
+	if id == 0 {
+		return ""
+	}
+
+	return (&models.Cursor{ID: id}).Encode()
+}
+
+func invalidCursorResponse() map[string]interface{} {
+	return validationErrorResponse(&app.ValidationError{Fields: []app.FieldError{
+		{Field: "cursor", Code: app.CodeInvalid, Message: "wrong cursor"},
+	}})
+}
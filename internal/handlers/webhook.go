@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// WebhookHandler ...
+type WebhookHandler struct {
+	app app.Application
+}
+
+// NewWebhookHandler ...
+func NewWebhookHandler(a app.Application) *WebhookHandler {
+	return &WebhookHandler{app: a}
+}
+
+// WebhookCreateRequest ...
+type WebhookCreateRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// WebhookUpdateRequest ...
+type WebhookUpdateRequest struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+	Active bool     `json:"active"`
+}
+
+// WebhookDeliveryListResponse ...
+type WebhookDeliveryListResponse struct {
+	Results    []models.WebhookDelivery `json:"results"`
+	NextCursor string                   `json:"next_cursor"`
+	HasNext    bool                     `json:"has_next"`
+}
+
+// webhookErrorResponse writes response for common webhook errors.
+func webhookErrorResponse(c echo.Context, err error, message string) error {
+	if vErr, ok := err.(*app.ValidationError); ok {
+		return c.JSON(http.StatusBadRequest, validationErrorResponse(vErr))
+	}
+	switch err {
+	case app.ErrAdminRequired:
+		return c.JSON(http.StatusForbidden, errorResponse("admin required"))
+	case app.ErrWebhookNotFound:
+		return c.JSON(http.StatusNotFound, errorResponse("webhook not found"))
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse(message))
+	}
+}
+
+// GetWebhooks godoc
+// @Summary Returns webhooks
+// @Description Returns all outgoing webhooks, admin only
+// @Tags webhook
+// @ID get-webhooks
+// @Produce json
+// @Success 200 {array} models.Webhook
+// @Security Bearer
+// @Router /webhook [get]
+func (h *WebhookHandler) GetWebhooks(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+
+	webhooks, err := h.app.GetWebhooks(userID)
+	if err != nil {
+		return webhookErrorResponse(c, err, "unable to get webhooks")
+	}
+
+	return c.JSON(http.StatusOK, webhooks)
+}
+
+// CreateWebhook godoc
+// @Summary Create webhook
+// @Description Create webhook subscribed to events, admin only. Secret for payload signature is generated and returned only in this response
+// @Tags webhook
+// @ID post-webhook
+// @Accept json
+// @Produce json
+// @Param request body WebhookCreateRequest true "Request body"
+// @Success 201 {object} app.CreatedWebhook
+// @Security Bearer
+// @Router /webhook [post]
+func (h *WebhookHandler) CreateWebhook(c echo.Context) error {
+	request := new(WebhookCreateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+
+	webhook, err := h.app.CreateWebhook(userID, request.URL, request.Events)
+	if err != nil {
+		return webhookErrorResponse(c, err, "unable to create webhook")
+	}
+
+	return c.JSON(http.StatusCreated, webhook)
+}
+
+// UpdateWebhook godoc
+// @Summary Update webhook
+// @Description Update url, events and activity of webhook, admin only
+// @Tags webhook
+// @ID update-webhook
+// @Accept json
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param request body WebhookUpdateRequest true "Request body"
+// @Success 200 {object} models.Webhook
+// @Security Bearer
+// @Router /webhook/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(c echo.Context) error {
+	request := new(WebhookUpdateRequest)
+	if err := c.Bind(request); err != nil {
+		return c.JSON(http.StatusBadRequest, nil)
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	webhookID, _ := strconv.Atoi(c.Param("id"))
+
+	webhook, err := h.app.UpdateWebhook(userID, webhookID, request.URL, request.Events, request.Active)
+	if err != nil {
+		return webhookErrorResponse(c, err, "unable to update webhook")
+	}
+
+	return c.JSON(http.StatusOK, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete webhook
+// @Description Delete webhook with its delivery log, admin only
+// @Tags webhook
+// @ID delete-webhook
+// @Param id path int true "Webhook ID"
+// @Success 204
+// @Security Bearer
+// @Router /webhook/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	webhookID, _ := strconv.Atoi(c.Param("id"))
+
+	err = h.app.DeleteWebhook(userID, webhookID)
+	if err != nil {
+		return webhookErrorResponse(c, err, "unable to delete webhook")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries godoc
+// @Summary Returns webhook delivery log
+// @Description Returns deliveries of webhook, newest first, admin only
+// @Tags webhook
+// @ID get-webhook-deliveries
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Param cursor query string false "Cursor from previous page"
+// @Param limit query int false "Capasity of one page"
+// @Success 200 {object} WebhookDeliveryListResponse
+// @Security Bearer
+// @Router /webhook/{id}/deliveries [get]
+func (h *WebhookHandler) GetWebhookDeliveries(c echo.Context) error {
+	webhookID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, err)
+	}
+	cursor, limit, err := parseIDCursor(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, invalidCursorResponse())
+	}
+
+	deliveries, next, hasNext, err := h.app.GetWebhookDeliveries(userID, webhookID, cursor, limit)
+	if err != nil {
+		return webhookErrorResponse(c, err, "unable to get deliveries")
+	}
+
+	return c.JSON(http.StatusOK, WebhookDeliveryListResponse{
+		Results:    deliveries,
+		NextCursor: idCursor(next),
+		HasNext:    hasNext,
+	})
+}
+
+// SendTestWebhook godoc
+// @Summary Send test event
+// @Description Sends "ping" event to webhook once and returns delivery result, admin only
+// @Tags webhook
+// @ID test-webhook
+// @Produce json
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} models.WebhookDelivery
+// @Security Bearer
+// @Router /webhook/{id}/test [post]
+func (h *WebhookHandler) SendTestWebhook(c echo.Context) error {
+	userID, err := getUserIDFromToken(c.Get("user"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, errorResponse("wrong ID"))
+	}
+	webhookID, _ := strconv.Atoi(c.Param("id"))
+
+	delivery, err := h.app.SendTestWebhook(userID, webhookID)
+	if err != nil {
+		return webhookErrorResponse(c, err, "unable to send test event")
+	}
+
+	return c.JSON(http.StatusOK, delivery)
+}
@@ -0,0 +1,165 @@
+package handlers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/FreakyGranny/launchpad-api/internal/app"
+	mockapp "github.com/FreakyGranny/launchpad-api/internal/app/mock"
+	"github.com/FreakyGranny/launchpad-api/internal/models"
+)
+
+type WebhookSuite struct {
+	suite.Suite
+	mockAppCtl *gomock.Controller
+	mockApp    *mockapp.MockApplication
+}
+
+func (s *WebhookSuite) SetupTest() {
+	s.mockAppCtl = gomock.NewController(s.T())
+	s.mockApp = mockapp.NewMockApplication(s.mockAppCtl)
+}
+
+func (s *WebhookSuite) TearDownTest() {
+	s.mockAppCtl.Finish()
+}
+
+func (s *WebhookSuite) setUser(c echo.Context, id int) {
+	token := jwt.New(jwt.SigningMethodHS256)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["id"] = float64(id)
+	c.Set("user", token)
+}
+
+func (s *WebhookSuite) TestCreateWebhook() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"url":"https://chat.example.com/hook","events":["project.closed"]}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/webhook")
+	s.setUser(c, 1)
+
+	webhook := &app.CreatedWebhook{
+		Webhook: models.Webhook{
+			ID:        2,
+			URL:       "https://chat.example.com/hook",
+			Secret:    "abc",
+			Events:    []string{"project.closed"},
+			Active:    true,
+			CreatedAt: time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC),
+		},
+		Secret: "abc",
+	}
+	h := NewWebhookHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateWebhook(1, "https://chat.example.com/hook", []string{"project.closed"}).Return(webhook, nil)
+	s.Require().NoError(h.CreateWebhook(c))
+	s.Require().Equal(http.StatusCreated, rec.Code)
+
+	var wJSON = `{"id":2,"url":"https://chat.example.com/hook","events":["project.closed"],"active":true,"created_at":"2020-08-20T12:00:00Z","secret":"abc"}`
+	s.Require().Equal(wJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *WebhookSuite) TestCreateWebhookNotAdmin() {
+	req := httptest.NewRequest(echo.POST, "/", strings.NewReader(`{"url":"https://chat.example.com/hook","events":["project.closed"]}`))
+	req.Header.Set("Content-type", "application/json")
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/webhook")
+	s.setUser(c, 5)
+
+	h := NewWebhookHandler(s.mockApp)
+	s.mockApp.EXPECT().CreateWebhook(5, "https://chat.example.com/hook", []string{"project.closed"}).Return(nil, app.ErrAdminRequired)
+	s.Require().NoError(h.CreateWebhook(c))
+	s.Require().Equal(http.StatusForbidden, rec.Code)
+}
+
+func (s *WebhookSuite) TestGetWebhooksHidesSecret() {
+	req := httptest.NewRequest(echo.GET, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/webhook")
+	s.setUser(c, 1)
+
+	webhooks := []models.Webhook{
+		{
+			ID:        2,
+			URL:       "https://chat.example.com/hook",
+			Secret:    "abc",
+			Events:    []string{"project.closed"},
+			Active:    true,
+			CreatedAt: time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC),
+		},
+	}
+	h := NewWebhookHandler(s.mockApp)
+	s.mockApp.EXPECT().GetWebhooks(1).Return(webhooks, nil)
+	s.Require().NoError(h.GetWebhooks(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var wJSON = `[{"id":2,"url":"https://chat.example.com/hook","events":["project.closed"],"active":true,"created_at":"2020-08-20T12:00:00Z"}]`
+	s.Require().Equal(wJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *WebhookSuite) TestGetWebhookDeliveries() {
+	req := httptest.NewRequest(echo.GET, "/?limit=1", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/webhook/:id/deliveries")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+	s.setUser(c, 1)
+
+	created := time.Date(2020, 8, 20, 12, 0, 0, 0, time.UTC)
+	deliveries := []models.WebhookDelivery{
+		{
+			ID:         9,
+			WebhookID:  2,
+			Event:      "ping",
+			Payload:    `{"event":"ping"}`,
+			Attempts:   1,
+			StatusCode: 200,
+			Delivered:  true,
+			CreatedAt:  created,
+			UpdatedAt:  created,
+		},
+	}
+	h := NewWebhookHandler(s.mockApp)
+	s.mockApp.EXPECT().GetWebhookDeliveries(1, 2, 0, 1).Return(deliveries, 9, true, nil)
+	s.Require().NoError(h.GetWebhookDeliveries(c))
+	s.Require().Equal(http.StatusOK, rec.Code)
+
+	var dJSON = `{"results":[{"id":9,"webhook":2,"event":"ping","payload":"{\"event\":\"ping\"}","attempts":1,"status_code":200,"error":"","delivered":true,"next_attempt_at":null,"created_at":"2020-08-20T12:00:00Z","updated_at":"2020-08-20T12:00:00Z"}],"next_cursor":"eyJpZCI6OX0","has_next":true}`
+	s.Require().Equal(dJSON, strings.Trim(rec.Body.String(), "\n"))
+}
+
+func (s *WebhookSuite) TestSendTestWebhookNotFound() {
+	req := httptest.NewRequest(echo.POST, "/", bytes.NewBuffer(nil))
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/webhook/:id/test")
+	c.SetParamNames("id")
+	c.SetParamValues("2")
+	s.setUser(c, 1)
+
+	h := NewWebhookHandler(s.mockApp)
+	s.mockApp.EXPECT().SendTestWebhook(1, 2).Return(nil, app.ErrWebhookNotFound)
+	s.Require().NoError(h.SendTestWebhook(c))
+	s.Require().Equal(http.StatusNotFound, rec.Code)
+}
+
+func TestWebhookSuite(t *testing.T) {
+	suite.Run(t, new(WebhookSuite))
+}
@@ -0,0 +1,59 @@
+package mail
+
+import (
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MailSuite struct {
+	suite.Suite
+}
+
+func (s *MailSuite) TestBuildMessage() {
+	raw, err := buildMessage("launchpad@localhost", &Message{
+		To:      "user@example.com",
+		Subject: "Проект собран",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+	})
+	s.Require().NoError(err)
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	s.Require().NoError(err)
+	s.Require().Equal("launchpad@localhost", msg.Header.Get("From"))
+	s.Require().Equal("user@example.com", msg.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	s.Require().NoError(err)
+	s.Require().Equal("Проект собран", subject)
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	s.Require().NoError(err)
+	s.Require().Equal("multipart/alternative", mediaType)
+
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	expected := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", "plain body"},
+		{"text/html; charset=utf-8", "<p>html body</p>"},
+	}
+	for _, e := range expected {
+		part, err := r.NextPart()
+		s.Require().NoError(err)
+		s.Require().Equal(e.contentType, part.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(part)
+		s.Require().NoError(err)
+		s.Require().Equal(e.body, string(body))
+	}
+}
+
+func TestMailSuite(t *testing.T) {
+	suite.Run(t, new(MailSuite))
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: activity.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockActivityImpl is a mock of ActivityImpl interface
+type MockActivityImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityImplMockRecorder
+}
+
+// MockActivityImplMockRecorder is the mock recorder for MockActivityImpl
+type MockActivityImplMockRecorder struct {
+	mock *MockActivityImpl
+}
+
+// NewMockActivityImpl creates a new mock instance
+func NewMockActivityImpl(ctrl *gomock.Controller) *MockActivityImpl {
+	mock := &MockActivityImpl{ctrl: ctrl}
+	mock.recorder = &MockActivityImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockActivityImpl) EXPECT() *MockActivityImplMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockActivityImpl) Create(a *models.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockActivityImplMockRecorder) Create(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockActivityImpl)(nil).Create), a)
+}
+
+// GetFeed mocks base method
+func (m *MockActivityImpl) GetFeed(userID, cursor, limit int) ([]models.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", userID, cursor, limit)
+	ret0, _ := ret[0].([]models.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed
+func (mr *MockActivityImplMockRecorder) GetFeed(userID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockActivityImpl)(nil).GetFeed), userID, cursor, limit)
+}
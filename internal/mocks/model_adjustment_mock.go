@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: adjustment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockAdjustmentImpl is a mock of AdjustmentImpl interface
+type MockAdjustmentImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdjustmentImplMockRecorder
+}
+
+// MockAdjustmentImplMockRecorder is the mock recorder for MockAdjustmentImpl
+type MockAdjustmentImplMockRecorder struct {
+	mock *MockAdjustmentImpl
+}
+
+// NewMockAdjustmentImpl creates a new mock instance
+func NewMockAdjustmentImpl(ctrl *gomock.Controller) *MockAdjustmentImpl {
+	mock := &MockAdjustmentImpl{ctrl: ctrl}
+	mock.recorder = &MockAdjustmentImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAdjustmentImpl) EXPECT() *MockAdjustmentImplMockRecorder {
+	return m.recorder
+}
+
+// GetAllByUser mocks base method
+func (m *MockAdjustmentImpl) GetAllByUser(userID int) ([]models.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", userID)
+	ret0, _ := ret[0].([]models.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser
+func (mr *MockAdjustmentImplMockRecorder) GetAllByUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockAdjustmentImpl)(nil).GetAllByUser), userID)
+}
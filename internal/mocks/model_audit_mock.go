@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockAuditImpl is a mock of AuditImpl interface
+type MockAuditImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditImplMockRecorder
+}
+
+// MockAuditImplMockRecorder is the mock recorder for MockAuditImpl
+type MockAuditImplMockRecorder struct {
+	mock *MockAuditImpl
+}
+
+// NewMockAuditImpl creates a new mock instance
+func NewMockAuditImpl(ctrl *gomock.Controller) *MockAuditImpl {
+	mock := &MockAuditImpl{ctrl: ctrl}
+	mock.recorder = &MockAuditImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuditImpl) EXPECT() *MockAuditImplMockRecorder {
+	return m.recorder
+}
+
+// GetAllByProject mocks base method
+func (m *MockAuditImpl) GetAllByProject(projectID int) ([]models.ProjectAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByProject", projectID)
+	ret0, _ := ret[0].([]models.ProjectAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByProject indicates an expected call of GetAllByProject
+func (mr *MockAuditImplMockRecorder) GetAllByProject(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByProject", reflect.TypeOf((*MockAuditImpl)(nil).GetAllByProject), projectID)
+}
@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: channel.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockChannelImpl is a mock of ChannelImpl interface
+type MockChannelImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelImplMockRecorder
+}
+
+// MockChannelImplMockRecorder is the mock recorder for MockChannelImpl
+type MockChannelImplMockRecorder struct {
+	mock *MockChannelImpl
+}
+
+// NewMockChannelImpl creates a new mock instance
+func NewMockChannelImpl(ctrl *gomock.Controller) *MockChannelImpl {
+	mock := &MockChannelImpl{ctrl: ctrl}
+	mock.recorder = &MockChannelImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChannelImpl) EXPECT() *MockChannelImplMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method
+func (m *MockChannelImpl) Notify(channel, payload string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", channel, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify
+func (mr *MockChannelImplMockRecorder) Notify(channel, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockChannelImpl)(nil).Notify), channel, payload)
+}
+
+// Listen mocks base method
+func (m *MockChannelImpl) Listen(ctx context.Context, channel string) <-chan string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listen", ctx, channel)
+	ret0, _ := ret[0].(<-chan string)
+	return ret0
+}
+
+// Listen indicates an expected call of Listen
+func (mr *MockChannelImplMockRecorder) Listen(ctx, channel interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockChannelImpl)(nil).Listen), ctx, channel)
+}
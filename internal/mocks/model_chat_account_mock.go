@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chat_account.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockChatAccountImpl is a mock of ChatAccountImpl interface
+type MockChatAccountImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatAccountImplMockRecorder
+}
+
+// MockChatAccountImplMockRecorder is the mock recorder for MockChatAccountImpl
+type MockChatAccountImplMockRecorder struct {
+	mock *MockChatAccountImpl
+}
+
+// NewMockChatAccountImpl creates a new mock instance
+func NewMockChatAccountImpl(ctrl *gomock.Controller) *MockChatAccountImpl {
+	mock := &MockChatAccountImpl{ctrl: ctrl}
+	mock.recorder = &MockChatAccountImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChatAccountImpl) EXPECT() *MockChatAccountImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockChatAccountImpl) Get(userID int) (*models.ChatAccount, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", userID)
+	ret0, _ := ret[0].(*models.ChatAccount)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockChatAccountImplMockRecorder) Get(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChatAccountImpl)(nil).Get), userID)
+}
+
+// GetByChatUser mocks base method
+func (m *MockChatAccountImpl) GetByChatUser(chatUserID string) (*models.ChatAccount, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByChatUser", chatUserID)
+	ret0, _ := ret[0].(*models.ChatAccount)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetByChatUser indicates an expected call of GetByChatUser
+func (mr *MockChatAccountImplMockRecorder) GetByChatUser(chatUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByChatUser", reflect.TypeOf((*MockChatAccountImpl)(nil).GetByChatUser), chatUserID)
+}
+
+// GetByCode mocks base method
+func (m *MockChatAccountImpl) GetByCode(code string) (*models.ChatAccount, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByCode", code)
+	ret0, _ := ret[0].(*models.ChatAccount)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetByCode indicates an expected call of GetByCode
+func (mr *MockChatAccountImplMockRecorder) GetByCode(code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByCode", reflect.TypeOf((*MockChatAccountImpl)(nil).GetByCode), code)
+}
+
+// GetByUsers mocks base method
+func (m *MockChatAccountImpl) GetByUsers(userIDs []int) ([]models.ChatAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsers", userIDs)
+	ret0, _ := ret[0].([]models.ChatAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsers indicates an expected call of GetByUsers
+func (mr *MockChatAccountImplMockRecorder) GetByUsers(userIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsers", reflect.TypeOf((*MockChatAccountImpl)(nil).GetByUsers), userIDs)
+}
+
+// Save mocks base method
+func (m *MockChatAccountImpl) Save(a *models.ChatAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockChatAccountImplMockRecorder) Save(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockChatAccountImpl)(nil).Save), a)
+}
+
+// Delete mocks base method
+func (m *MockChatAccountImpl) Delete(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockChatAccountImplMockRecorder) Delete(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChatAccountImpl)(nil).Delete), userID)
+}
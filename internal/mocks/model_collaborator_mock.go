@@ -0,0 +1,135 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: collaborator.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockCollaboratorImpl is a mock of CollaboratorImpl interface
+type MockCollaboratorImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockCollaboratorImplMockRecorder
+}
+
+// MockCollaboratorImplMockRecorder is the mock recorder for MockCollaboratorImpl
+type MockCollaboratorImplMockRecorder struct {
+	mock *MockCollaboratorImpl
+}
+
+// NewMockCollaboratorImpl creates a new mock instance
+func NewMockCollaboratorImpl(ctrl *gomock.Controller) *MockCollaboratorImpl {
+	mock := &MockCollaboratorImpl{ctrl: ctrl}
+	mock.recorder = &MockCollaboratorImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCollaboratorImpl) EXPECT() *MockCollaboratorImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockCollaboratorImpl) Get(projectID, userID int) (*models.Collaborator, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", projectID, userID)
+	ret0, _ := ret[0].(*models.Collaborator)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockCollaboratorImplMockRecorder) Get(projectID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCollaboratorImpl)(nil).Get), projectID, userID)
+}
+
+// GetAllByProject mocks base method
+func (m *MockCollaboratorImpl) GetAllByProject(projectID int) ([]models.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByProject", projectID)
+	ret0, _ := ret[0].([]models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByProject indicates an expected call of GetAllByProject
+func (mr *MockCollaboratorImplMockRecorder) GetAllByProject(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByProject", reflect.TypeOf((*MockCollaboratorImpl)(nil).GetAllByProject), projectID)
+}
+
+// GetInvitations mocks base method
+func (m *MockCollaboratorImpl) GetInvitations(userID int) ([]models.Collaborator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvitations", userID)
+	ret0, _ := ret[0].([]models.Collaborator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvitations indicates an expected call of GetInvitations
+func (mr *MockCollaboratorImplMockRecorder) GetInvitations(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvitations", reflect.TypeOf((*MockCollaboratorImpl)(nil).GetInvitations), userID)
+}
+
+// Create mocks base method
+func (m *MockCollaboratorImpl) Create(c *models.Collaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockCollaboratorImplMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCollaboratorImpl)(nil).Create), c)
+}
+
+// Accept mocks base method
+func (m *MockCollaboratorImpl) Accept(c *models.Collaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Accept indicates an expected call of Accept
+func (mr *MockCollaboratorImplMockRecorder) Accept(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockCollaboratorImpl)(nil).Accept), c)
+}
+
+// Delete mocks base method
+func (m *MockCollaboratorImpl) Delete(c *models.Collaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockCollaboratorImplMockRecorder) Delete(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCollaboratorImpl)(nil).Delete), c)
+}
+
+// TransferOwnership mocks base method
+func (m *MockCollaboratorImpl) TransferOwnership(p *models.Project, owner, previous *models.Collaborator) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferOwnership", p, owner, previous)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferOwnership indicates an expected call of TransferOwnership
+func (mr *MockCollaboratorImplMockRecorder) TransferOwnership(p, owner, previous interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferOwnership", reflect.TypeOf((*MockCollaboratorImpl)(nil).TransferOwnership), p, owner, previous)
+}
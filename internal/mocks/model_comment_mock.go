@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockCommentImpl is a mock of CommentImpl interface
+type MockCommentImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentImplMockRecorder
+}
+
+// MockCommentImplMockRecorder is the mock recorder for MockCommentImpl
+type MockCommentImplMockRecorder struct {
+	mock *MockCommentImpl
+}
+
+// NewMockCommentImpl creates a new mock instance
+func NewMockCommentImpl(ctrl *gomock.Controller) *MockCommentImpl {
+	mock := &MockCommentImpl{ctrl: ctrl}
+	mock.recorder = &MockCommentImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCommentImpl) EXPECT() *MockCommentImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockCommentImpl) Get(id int) (*models.Comment, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*models.Comment)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockCommentImplMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommentImpl)(nil).Get), id)
+}
+
+// GetThreads mocks base method
+func (m *MockCommentImpl) GetThreads(projectID, cursor, limit int) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetThreads", projectID, cursor, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetThreads indicates an expected call of GetThreads
+func (mr *MockCommentImplMockRecorder) GetThreads(projectID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetThreads", reflect.TypeOf((*MockCommentImpl)(nil).GetThreads), projectID, cursor, limit)
+}
+
+// GetReplies mocks base method
+func (m *MockCommentImpl) GetReplies(parentID, cursor, limit int) ([]models.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplies", parentID, cursor, limit)
+	ret0, _ := ret[0].([]models.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplies indicates an expected call of GetReplies
+func (mr *MockCommentImplMockRecorder) GetReplies(parentID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplies", reflect.TypeOf((*MockCommentImpl)(nil).GetReplies), parentID, cursor, limit)
+}
+
+// Create mocks base method
+func (m *MockCommentImpl) Create(c *models.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockCommentImplMockRecorder) Create(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentImpl)(nil).Create), c)
+}
+
+// Update mocks base method
+func (m *MockCommentImpl) Update(c *models.Comment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockCommentImplMockRecorder) Update(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentImpl)(nil).Update), c)
+}
@@ -49,18 +49,18 @@ func (mr *MockDonationImplMockRecorder) Get(id interface{}) *gomock.Call {
 }
 
 // GetAllByUser mocks base method
-func (m *MockDonationImpl) GetAllByUser(id int) ([]models.Donation, error) {
+func (m *MockDonationImpl) GetAllByUser(id, cursor, limit int) ([]models.Donation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllByUser", id)
+	ret := m.ctrl.Call(m, "GetAllByUser", id, cursor, limit)
 	ret0, _ := ret[0].([]models.Donation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllByUser indicates an expected call of GetAllByUser
-func (mr *MockDonationImplMockRecorder) GetAllByUser(id interface{}) *gomock.Call {
+func (mr *MockDonationImplMockRecorder) GetAllByUser(id, cursor, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockDonationImpl)(nil).GetAllByUser), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockDonationImpl)(nil).GetAllByUser), id, cursor, limit)
 }
 
 // GetAllByProject mocks base method
@@ -78,18 +78,49 @@ func (mr *MockDonationImplMockRecorder) GetAllByProject(id interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByProject", reflect.TypeOf((*MockDonationImpl)(nil).GetAllByProject), id)
 }
 
+// GetPageByProject mocks base method
+func (m *MockDonationImpl) GetPageByProject(id, cursor, limit int) ([]models.Donation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPageByProject", id, cursor, limit)
+	ret0, _ := ret[0].([]models.Donation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPageByProject indicates an expected call of GetPageByProject
+func (mr *MockDonationImplMockRecorder) GetPageByProject(id, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPageByProject", reflect.TypeOf((*MockDonationImpl)(nil).GetPageByProject), id, cursor, limit)
+}
+
+// GetMessagesByProject mocks base method
+func (m *MockDonationImpl) GetMessagesByProject(id, page, pageSize int, withHidden bool) ([]models.Donation, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByProject", id, page, pageSize, withHidden)
+	ret0, _ := ret[0].([]models.Donation)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMessagesByProject indicates an expected call of GetMessagesByProject
+func (mr *MockDonationImplMockRecorder) GetMessagesByProject(id, page, pageSize, withHidden interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByProject", reflect.TypeOf((*MockDonationImpl)(nil).GetMessagesByProject), id, page, pageSize, withHidden)
+}
+
 // Create mocks base method
-func (m *MockDonationImpl) Create(d *models.Donation) error {
+func (m *MockDonationImpl) Create(d *models.Donation, joinAfterLock bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", d)
+	ret := m.ctrl.Call(m, "Create", d, joinAfterLock)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create
-func (mr *MockDonationImplMockRecorder) Create(d interface{}) *gomock.Call {
+func (mr *MockDonationImplMockRecorder) Create(d, joinAfterLock interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDonationImpl)(nil).Create), d)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDonationImpl)(nil).Create), d, joinAfterLock)
 }
 
 // Update mocks base method
@@ -106,6 +137,62 @@ func (mr *MockDonationImplMockRecorder) Update(d interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDonationImpl)(nil).Update), d)
 }
 
+// UpdatePayment mocks base method
+func (m *MockDonationImpl) UpdatePayment(d *models.Donation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayment", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayment indicates an expected call of UpdatePayment
+func (mr *MockDonationImplMockRecorder) UpdatePayment(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockDonationImpl)(nil).UpdatePayment), d)
+}
+
+// UpdatePaid mocks base method
+func (m *MockDonationImpl) UpdatePaid(d *models.Donation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePaid", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePaid indicates an expected call of UpdatePaid
+func (mr *MockDonationImplMockRecorder) UpdatePaid(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePaid", reflect.TypeOf((*MockDonationImpl)(nil).UpdatePaid), d)
+}
+
+// UpdateAnonymity mocks base method
+func (m *MockDonationImpl) UpdateAnonymity(d *models.Donation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnonymity", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnonymity indicates an expected call of UpdateAnonymity
+func (mr *MockDonationImplMockRecorder) UpdateAnonymity(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnonymity", reflect.TypeOf((*MockDonationImpl)(nil).UpdateAnonymity), d)
+}
+
+// UpdateMessage mocks base method
+func (m *MockDonationImpl) UpdateMessage(d *models.Donation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage
+func (mr *MockDonationImplMockRecorder) UpdateMessage(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockDonationImpl)(nil).UpdateMessage), d)
+}
+
 // Delete mocks base method
 func (m *MockDonationImpl) Delete(d *models.Donation) error {
 	m.ctrl.T.Helper()
@@ -119,3 +206,17 @@ func (mr *MockDonationImplMockRecorder) Delete(d interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDonationImpl)(nil).Delete), d)
 }
+
+// SetReminded mocks base method
+func (m *MockDonationImpl) SetReminded(d *models.Donation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReminded", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReminded indicates an expected call of SetReminded
+func (mr *MockDonationImplMockRecorder) SetReminded(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReminded", reflect.TypeOf((*MockDonationImpl)(nil).SetReminded), d)
+}
@@ -0,0 +1,63 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: email_settings.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockEmailSettingsImpl is a mock of EmailSettingsImpl interface
+type MockEmailSettingsImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailSettingsImplMockRecorder
+}
+
+// MockEmailSettingsImplMockRecorder is the mock recorder for MockEmailSettingsImpl
+type MockEmailSettingsImplMockRecorder struct {
+	mock *MockEmailSettingsImpl
+}
+
+// NewMockEmailSettingsImpl creates a new mock instance
+func NewMockEmailSettingsImpl(ctrl *gomock.Controller) *MockEmailSettingsImpl {
+	mock := &MockEmailSettingsImpl{ctrl: ctrl}
+	mock.recorder = &MockEmailSettingsImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockEmailSettingsImpl) EXPECT() *MockEmailSettingsImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockEmailSettingsImpl) Get(userID int) (*models.EmailSettings, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", userID)
+	ret0, _ := ret[0].(*models.EmailSettings)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockEmailSettingsImplMockRecorder) Get(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockEmailSettingsImpl)(nil).Get), userID)
+}
+
+// Save mocks base method
+func (m *MockEmailSettingsImpl) Save(s *models.EmailSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockEmailSettingsImplMockRecorder) Save(s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockEmailSettingsImpl)(nil).Save), s)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: follow.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockFollowImpl is a mock of FollowImpl interface
+type MockFollowImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowImplMockRecorder
+}
+
+// MockFollowImplMockRecorder is the mock recorder for MockFollowImpl
+type MockFollowImplMockRecorder struct {
+	mock *MockFollowImpl
+}
+
+// NewMockFollowImpl creates a new mock instance
+func NewMockFollowImpl(ctrl *gomock.Controller) *MockFollowImpl {
+	mock := &MockFollowImpl{ctrl: ctrl}
+	mock.recorder = &MockFollowImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockFollowImpl) EXPECT() *MockFollowImplMockRecorder {
+	return m.recorder
+}
+
+// GetAllByUser mocks base method
+func (m *MockFollowImpl) GetAllByUser(userID int) ([]models.Follow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", userID)
+	ret0, _ := ret[0].([]models.Follow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser
+func (mr *MockFollowImplMockRecorder) GetAllByUser(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockFollowImpl)(nil).GetAllByUser), userID)
+}
+
+// GetFollowerIDs mocks base method
+func (m *MockFollowImpl) GetFollowerIDs(activityID int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowerIDs", activityID)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowerIDs indicates an expected call of GetFollowerIDs
+func (mr *MockFollowImplMockRecorder) GetFollowerIDs(activityID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowerIDs", reflect.TypeOf((*MockFollowImpl)(nil).GetFollowerIDs), activityID)
+}
+
+// Create mocks base method
+func (m *MockFollowImpl) Create(f *models.Follow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockFollowImplMockRecorder) Create(f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockFollowImpl)(nil).Create), f)
+}
+
+// Delete mocks base method
+func (m *MockFollowImpl) Delete(f *models.Follow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockFollowImplMockRecorder) Delete(f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockFollowImpl)(nil).Delete), f)
+}
@@ -0,0 +1,122 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: notification.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockNotificationImpl is a mock of NotificationImpl interface
+type MockNotificationImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationImplMockRecorder
+}
+
+// MockNotificationImplMockRecorder is the mock recorder for MockNotificationImpl
+type MockNotificationImplMockRecorder struct {
+	mock *MockNotificationImpl
+}
+
+// NewMockNotificationImpl creates a new mock instance
+func NewMockNotificationImpl(ctrl *gomock.Controller) *MockNotificationImpl {
+	mock := &MockNotificationImpl{ctrl: ctrl}
+	mock.recorder = &MockNotificationImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockNotificationImpl) EXPECT() *MockNotificationImplMockRecorder {
+	return m.recorder
+}
+
+// GetAllByUser mocks base method
+func (m *MockNotificationImpl) GetAllByUser(userID, cursor, limit int, onlyUnread bool) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByUser", userID, cursor, limit, onlyUnread)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByUser indicates an expected call of GetAllByUser
+func (mr *MockNotificationImplMockRecorder) GetAllByUser(userID, cursor, limit, onlyUnread interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockNotificationImpl)(nil).GetAllByUser), userID, cursor, limit, onlyUnread)
+}
+
+// CountUnread mocks base method
+func (m *MockNotificationImpl) CountUnread(userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread
+func (mr *MockNotificationImplMockRecorder) CountUnread(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockNotificationImpl)(nil).CountUnread), userID)
+}
+
+// Create mocks base method
+func (m *MockNotificationImpl) Create(ns []models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ns)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockNotificationImplMockRecorder) Create(ns interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationImpl)(nil).Create), ns)
+}
+
+// MarkRead mocks base method
+func (m *MockNotificationImpl) MarkRead(userID int, ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", userID, ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead
+func (mr *MockNotificationImplMockRecorder) MarkRead(userID, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockNotificationImpl)(nil).MarkRead), userID, ids)
+}
+
+// MarkAllRead mocks base method
+func (m *MockNotificationImpl) MarkAllRead(userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead
+func (mr *MockNotificationImplMockRecorder) MarkAllRead(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockNotificationImpl)(nil).MarkAllRead), userID)
+}
+
+// DeleteOlderThan mocks base method
+func (m *MockNotificationImpl) DeleteOlderThan(t time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOlderThan", t)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOlderThan indicates an expected call of DeleteOlderThan
+func (mr *MockNotificationImplMockRecorder) DeleteOlderThan(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOlderThan", reflect.TypeOf((*MockNotificationImpl)(nil).DeleteOlderThan), t)
+}
@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pending_email.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockPendingEmailImpl is a mock of PendingEmailImpl interface
+type MockPendingEmailImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockPendingEmailImplMockRecorder
+}
+
+// MockPendingEmailImplMockRecorder is the mock recorder for MockPendingEmailImpl
+type MockPendingEmailImplMockRecorder struct {
+	mock *MockPendingEmailImpl
+}
+
+// NewMockPendingEmailImpl creates a new mock instance
+func NewMockPendingEmailImpl(ctrl *gomock.Controller) *MockPendingEmailImpl {
+	mock := &MockPendingEmailImpl{ctrl: ctrl}
+	mock.recorder = &MockPendingEmailImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockPendingEmailImpl) EXPECT() *MockPendingEmailImplMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method
+func (m *MockPendingEmailImpl) GetAll() ([]models.PendingEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]models.PendingEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll
+func (mr *MockPendingEmailImplMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPendingEmailImpl)(nil).GetAll))
+}
+
+// Create mocks base method
+func (m *MockPendingEmailImpl) Create(e *models.PendingEmail) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockPendingEmailImplMockRecorder) Create(e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPendingEmailImpl)(nil).Create), e)
+}
+
+// Delete mocks base method
+func (m *MockPendingEmailImpl) Delete(ids []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ids)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockPendingEmailImplMockRecorder) Delete(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPendingEmailImpl)(nil).Delete), ids)
+}
@@ -8,6 +8,7 @@ import (
 	models "github.com/FreakyGranny/launchpad-api/internal/models"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockProjectImpl is a mock of ProjectImpl interface
@@ -49,33 +50,64 @@ func (mr *MockProjectImplMockRecorder) Get(id interface{}) *gomock.Call {
 }
 
 // GetProjectsWithPagination mocks base method
-func (m *MockProjectImpl) GetProjectsWithPagination(category, projectType, page, pageSize int, onlyOpen bool) (models.ProjectPaginatorImpl, error) {
+func (m *MockProjectImpl) GetProjectsWithPagination(filter *models.ProjectFilter, page, pageSize int) (models.ProjectPaginatorImpl, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetProjectsWithPagination", category, projectType, page, pageSize, onlyOpen)
+	ret := m.ctrl.Call(m, "GetProjectsWithPagination", filter, page, pageSize)
 	ret0, _ := ret[0].(models.ProjectPaginatorImpl)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetProjectsWithPagination indicates an expected call of GetProjectsWithPagination
-func (mr *MockProjectImplMockRecorder) GetProjectsWithPagination(category, projectType, page, pageSize, onlyOpen interface{}) *gomock.Call {
+func (mr *MockProjectImplMockRecorder) GetProjectsWithPagination(filter, page, pageSize interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsWithPagination", reflect.TypeOf((*MockProjectImpl)(nil).GetProjectsWithPagination), category, projectType, page, pageSize, onlyOpen)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsWithPagination", reflect.TypeOf((*MockProjectImpl)(nil).GetProjectsWithPagination), filter, page, pageSize)
+}
+
+// GetProjectsAfter mocks base method
+func (m *MockProjectImpl) GetProjectsAfter(filter *models.ProjectFilter, cursor *models.Cursor, limit int) (*[]models.Project, *models.Cursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectsAfter", filter, cursor, limit)
+	ret0, _ := ret[0].(*[]models.Project)
+	ret1, _ := ret[1].(*models.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectsAfter indicates an expected call of GetProjectsAfter
+func (mr *MockProjectImplMockRecorder) GetProjectsAfter(filter, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectsAfter", reflect.TypeOf((*MockProjectImpl)(nil).GetProjectsAfter), filter, cursor, limit)
+}
+
+// GetSnippets mocks base method
+func (m *MockProjectImpl) GetSnippets(ids []int, search string) (map[int]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnippets", ids, search)
+	ret0, _ := ret[0].(map[int]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnippets indicates an expected call of GetSnippets
+func (mr *MockProjectImplMockRecorder) GetSnippets(ids, search interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnippets", reflect.TypeOf((*MockProjectImpl)(nil).GetSnippets), ids, search)
 }
 
 // GetUserProjects mocks base method
-func (m *MockProjectImpl) GetUserProjects(user int, contributed, owned bool) (*[]models.Project, error) {
+func (m *MockProjectImpl) GetUserProjects(user, viewer int, contributed, owned bool, cursor, limit int) (*[]models.Project, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserProjects", user, contributed, owned)
+	ret := m.ctrl.Call(m, "GetUserProjects", user, viewer, contributed, owned, cursor, limit)
 	ret0, _ := ret[0].(*[]models.Project)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserProjects indicates an expected call of GetUserProjects
-func (mr *MockProjectImplMockRecorder) GetUserProjects(user, contributed, owned interface{}) *gomock.Call {
+func (mr *MockProjectImplMockRecorder) GetUserProjects(user, viewer, contributed, owned, cursor, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProjects", reflect.TypeOf((*MockProjectImpl)(nil).GetUserProjects), user, contributed, owned)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProjects", reflect.TypeOf((*MockProjectImpl)(nil).GetUserProjects), user, viewer, contributed, owned, cursor, limit)
 }
 
 // GetActiveProjects mocks base method
@@ -93,6 +125,36 @@ func (mr *MockProjectImplMockRecorder) GetActiveProjects() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveProjects", reflect.TypeOf((*MockProjectImpl)(nil).GetActiveProjects))
 }
 
+// GetScheduledProjects mocks base method
+func (m *MockProjectImpl) GetScheduledProjects(now time.Time) (*[]models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledProjects", now)
+	ret0, _ := ret[0].(*[]models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledProjects indicates an expected call of GetScheduledProjects
+func (mr *MockProjectImplMockRecorder) GetScheduledProjects(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledProjects", reflect.TypeOf((*MockProjectImpl)(nil).GetScheduledProjects), now)
+}
+
+// GetPendingReview mocks base method
+func (m *MockProjectImpl) GetPendingReview() (*[]models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingReview")
+	ret0, _ := ret[0].(*[]models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingReview indicates an expected call of GetPendingReview
+func (mr *MockProjectImplMockRecorder) GetPendingReview() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingReview", reflect.TypeOf((*MockProjectImpl)(nil).GetPendingReview))
+}
+
 // Create mocks base method
 func (m *MockProjectImpl) Create(p *models.Project) error {
 	m.ctrl.T.Helper()
@@ -107,6 +169,20 @@ func (mr *MockProjectImplMockRecorder) Create(p interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectImpl)(nil).Create), p)
 }
 
+// Clone mocks base method
+func (m *MockProjectImpl) Clone(sourceID int, p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", sourceID, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone
+func (mr *MockProjectImplMockRecorder) Clone(sourceID, p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockProjectImpl)(nil).Clone), sourceID, p)
+}
+
 // Update mocks base method
 func (m *MockProjectImpl) Update(p *models.Project) error {
 	m.ctrl.T.Helper()
@@ -135,6 +211,34 @@ func (mr *MockProjectImplMockRecorder) DropEventDate(p interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropEventDate", reflect.TypeOf((*MockProjectImpl)(nil).DropEventDate), p)
 }
 
+// UpdatePledgeRules mocks base method
+func (m *MockProjectImpl) UpdatePledgeRules(p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePledgeRules", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePledgeRules indicates an expected call of UpdatePledgeRules
+func (mr *MockProjectImplMockRecorder) UpdatePledgeRules(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePledgeRules", reflect.TypeOf((*MockProjectImpl)(nil).UpdatePledgeRules), p)
+}
+
+// UpdatePrivacy mocks base method
+func (m *MockProjectImpl) UpdatePrivacy(p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivacy", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivacy indicates an expected call of UpdatePrivacy
+func (mr *MockProjectImplMockRecorder) UpdatePrivacy(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacy", reflect.TypeOf((*MockProjectImpl)(nil).UpdatePrivacy), p)
+}
+
 // Delete mocks base method
 func (m *MockProjectImpl) Delete(p *models.Project) error {
 	m.ctrl.T.Helper()
@@ -178,17 +282,17 @@ func (mr *MockProjectImplMockRecorder) UpdateTotalByCount(p interface{}) *gomock
 }
 
 // Lock mocks base method
-func (m *MockProjectImpl) Lock(p *models.Project) error {
+func (m *MockProjectImpl) Lock(p *models.Project, lockedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Lock", p)
+	ret := m.ctrl.Call(m, "Lock", p, lockedAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Lock indicates an expected call of Lock
-func (mr *MockProjectImplMockRecorder) Lock(p interface{}) *gomock.Call {
+func (mr *MockProjectImplMockRecorder) Lock(p, lockedAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockProjectImpl)(nil).Lock), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockProjectImpl)(nil).Lock), p, lockedAt)
 }
 
 // Close mocks base method
@@ -205,6 +309,132 @@ func (mr *MockProjectImplMockRecorder) Close(p interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockProjectImpl)(nil).Close), p)
 }
 
+// Fail mocks base method
+func (m *MockProjectImpl) Fail(p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fail", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Fail indicates an expected call of Fail
+func (mr *MockProjectImplMockRecorder) Fail(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fail", reflect.TypeOf((*MockProjectImpl)(nil).Fail), p)
+}
+
+// Escalate mocks base method
+func (m *MockProjectImpl) Escalate(p *models.Project, escalatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Escalate", p, escalatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Escalate indicates an expected call of Escalate
+func (mr *MockProjectImplMockRecorder) Escalate(p, escalatedAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Escalate", reflect.TypeOf((*MockProjectImpl)(nil).Escalate), p, escalatedAt)
+}
+
+// Cancel mocks base method
+func (m *MockProjectImpl) Cancel(p *models.Project, audit *models.ProjectAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", p, audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel
+func (mr *MockProjectImplMockRecorder) Cancel(p, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockProjectImpl)(nil).Cancel), p, audit)
+}
+
+// Extend mocks base method
+func (m *MockProjectImpl) Extend(p *models.Project, audit *models.ProjectAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extend", p, audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Extend indicates an expected call of Extend
+func (mr *MockProjectImplMockRecorder) Extend(p, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extend", reflect.TypeOf((*MockProjectImpl)(nil).Extend), p, audit)
+}
+
+// Edit mocks base method
+func (m *MockProjectImpl) Edit(p *models.Project, audit []models.ProjectAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Edit", p, audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Edit indicates an expected call of Edit
+func (mr *MockProjectImplMockRecorder) Edit(p, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockProjectImpl)(nil).Edit), p, audit)
+}
+
+// Reopen mocks base method
+func (m *MockProjectImpl) Reopen(p *models.Project, audit *models.ProjectAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", p, audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reopen indicates an expected call of Reopen
+func (mr *MockProjectImplMockRecorder) Reopen(p, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockProjectImpl)(nil).Reopen), p, audit)
+}
+
+// SchedulePublication mocks base method
+func (m *MockProjectImpl) SchedulePublication(p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePublication", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulePublication indicates an expected call of SchedulePublication
+func (mr *MockProjectImplMockRecorder) SchedulePublication(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePublication", reflect.TypeOf((*MockProjectImpl)(nil).SchedulePublication), p)
+}
+
+// UpdateReview mocks base method
+func (m *MockProjectImpl) UpdateReview(p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview
+func (mr *MockProjectImplMockRecorder) UpdateReview(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockProjectImpl)(nil).UpdateReview), p)
+}
+
+// Publish mocks base method
+func (m *MockProjectImpl) Publish(p *models.Project) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish
+func (mr *MockProjectImplMockRecorder) Publish(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockProjectImpl)(nil).Publish), p)
+}
+
 // CheckForPaid mocks base method
 func (m *MockProjectImpl) CheckForPaid(projectID int) (bool, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +464,21 @@ func (mr *MockProjectImplMockRecorder) SetEqualDonation(p interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEqualDonation", reflect.TypeOf((*MockProjectImpl)(nil).SetEqualDonation), p)
 }
 
+// ResplitDonations mocks base method
+func (m *MockProjectImpl) ResplitDonations(p *models.Project) ([]models.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResplitDonations", p)
+	ret0, _ := ret[0].([]models.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResplitDonations indicates an expected call of ResplitDonations
+func (mr *MockProjectImplMockRecorder) ResplitDonations(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResplitDonations", reflect.TypeOf((*MockProjectImpl)(nil).ResplitDonations), p)
+}
+
 // MockProjectPaginatorImpl is a mock of ProjectPaginatorImpl interface
 type MockProjectPaginatorImpl struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_update.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockProjectUpdateImpl is a mock of ProjectUpdateImpl interface
+type MockProjectUpdateImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectUpdateImplMockRecorder
+}
+
+// MockProjectUpdateImplMockRecorder is the mock recorder for MockProjectUpdateImpl
+type MockProjectUpdateImplMockRecorder struct {
+	mock *MockProjectUpdateImpl
+}
+
+// NewMockProjectUpdateImpl creates a new mock instance
+func NewMockProjectUpdateImpl(ctrl *gomock.Controller) *MockProjectUpdateImpl {
+	mock := &MockProjectUpdateImpl{ctrl: ctrl}
+	mock.recorder = &MockProjectUpdateImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockProjectUpdateImpl) EXPECT() *MockProjectUpdateImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockProjectUpdateImpl) Get(id int) (*models.ProjectUpdate, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*models.ProjectUpdate)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockProjectUpdateImplMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProjectUpdateImpl)(nil).Get), id)
+}
+
+// GetAllByProject mocks base method
+func (m *MockProjectUpdateImpl) GetAllByProject(projectID int, withPrivate bool) ([]models.ProjectUpdate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByProject", projectID, withPrivate)
+	ret0, _ := ret[0].([]models.ProjectUpdate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByProject indicates an expected call of GetAllByProject
+func (mr *MockProjectUpdateImplMockRecorder) GetAllByProject(projectID, withPrivate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByProject", reflect.TypeOf((*MockProjectUpdateImpl)(nil).GetAllByProject), projectID, withPrivate)
+}
+
+// Create mocks base method
+func (m *MockProjectUpdateImpl) Create(u *models.ProjectUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockProjectUpdateImplMockRecorder) Create(u interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProjectUpdateImpl)(nil).Create), u)
+}
+
+// Delete mocks base method
+func (m *MockProjectUpdateImpl) Delete(u *models.ProjectUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockProjectUpdateImplMockRecorder) Delete(u interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProjectUpdateImpl)(nil).Delete), u)
+}
@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ranking.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockRankingImpl is a mock of RankingImpl interface
+type MockRankingImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockRankingImplMockRecorder
+}
+
+// MockRankingImplMockRecorder is the mock recorder for MockRankingImpl
+type MockRankingImplMockRecorder struct {
+	mock *MockRankingImpl
+}
+
+// NewMockRankingImpl creates a new mock instance
+func NewMockRankingImpl(ctrl *gomock.Controller) *MockRankingImpl {
+	mock := &MockRankingImpl{ctrl: ctrl}
+	mock.recorder = &MockRankingImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRankingImpl) EXPECT() *MockRankingImplMockRecorder {
+	return m.recorder
+}
+
+// GetRecentDonations mocks base method
+func (m *MockRankingImpl) GetRecentDonations(since time.Time) ([]models.RecentDonations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecentDonations", since)
+	ret0, _ := ret[0].([]models.RecentDonations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecentDonations indicates an expected call of GetRecentDonations
+func (mr *MockRankingImplMockRecorder) GetRecentDonations(since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentDonations", reflect.TypeOf((*MockRankingImpl)(nil).GetRecentDonations), since)
+}
+
+// GetDonorAffinity mocks base method
+func (m *MockRankingImpl) GetDonorAffinity() ([]models.DonorAffinity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDonorAffinity")
+	ret0, _ := ret[0].([]models.DonorAffinity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDonorAffinity indicates an expected call of GetDonorAffinity
+func (mr *MockRankingImplMockRecorder) GetDonorAffinity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDonorAffinity", reflect.TypeOf((*MockRankingImpl)(nil).GetDonorAffinity))
+}
+
+// GetJoinedProjects mocks base method
+func (m *MockRankingImpl) GetJoinedProjects() ([]models.JoinedProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJoinedProjects")
+	ret0, _ := ret[0].([]models.JoinedProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJoinedProjects indicates an expected call of GetJoinedProjects
+func (mr *MockRankingImplMockRecorder) GetJoinedProjects() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJoinedProjects", reflect.TypeOf((*MockRankingImpl)(nil).GetJoinedProjects))
+}
+
+// SaveTrending mocks base method
+func (m *MockRankingImpl) SaveTrending(rankings []models.TrendingProject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrending", rankings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTrending indicates an expected call of SaveTrending
+func (mr *MockRankingImplMockRecorder) SaveTrending(rankings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrending", reflect.TypeOf((*MockRankingImpl)(nil).SaveTrending), rankings)
+}
+
+// SaveRecommended mocks base method
+func (m *MockRankingImpl) SaveRecommended(userID int, rankings []models.RecommendedProject) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRecommended", userID, rankings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRecommended indicates an expected call of SaveRecommended
+func (mr *MockRankingImplMockRecorder) SaveRecommended(userID, rankings interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRecommended", reflect.TypeOf((*MockRankingImpl)(nil).SaveRecommended), userID, rankings)
+}
+
+// GetTrending mocks base method
+func (m *MockRankingImpl) GetTrending(limit int) (*[]models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrending", limit)
+	ret0, _ := ret[0].(*[]models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrending indicates an expected call of GetTrending
+func (mr *MockRankingImplMockRecorder) GetTrending(limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrending", reflect.TypeOf((*MockRankingImpl)(nil).GetTrending), limit)
+}
+
+// GetRecommended mocks base method
+func (m *MockRankingImpl) GetRecommended(userID, limit int) (*[]models.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommended", userID, limit)
+	ret0, _ := ret[0].(*[]models.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommended indicates an expected call of GetRecommended
+func (mr *MockRankingImplMockRecorder) GetRecommended(userID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommended", reflect.TypeOf((*MockRankingImpl)(nil).GetRecommended), userID, limit)
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: revision.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockRevisionImpl is a mock of RevisionImpl interface
+type MockRevisionImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevisionImplMockRecorder
+}
+
+// MockRevisionImplMockRecorder is the mock recorder for MockRevisionImpl
+type MockRevisionImplMockRecorder struct {
+	mock *MockRevisionImpl
+}
+
+// NewMockRevisionImpl creates a new mock instance
+func NewMockRevisionImpl(ctrl *gomock.Controller) *MockRevisionImpl {
+	mock := &MockRevisionImpl{ctrl: ctrl}
+	mock.recorder = &MockRevisionImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRevisionImpl) EXPECT() *MockRevisionImplMockRecorder {
+	return m.recorder
+}
+
+// GetAllByProject mocks base method
+func (m *MockRevisionImpl) GetAllByProject(projectID int) ([]models.ProjectRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByProject", projectID)
+	ret0, _ := ret[0].([]models.ProjectRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByProject indicates an expected call of GetAllByProject
+func (mr *MockRevisionImplMockRecorder) GetAllByProject(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByProject", reflect.TypeOf((*MockRevisionImpl)(nil).GetAllByProject), projectID)
+}
+
+// GetLast mocks base method
+func (m *MockRevisionImpl) GetLast(projectID int) (*models.ProjectRevision, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLast", projectID)
+	ret0, _ := ret[0].(*models.ProjectRevision)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetLast indicates an expected call of GetLast
+func (mr *MockRevisionImplMockRecorder) GetLast(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLast", reflect.TypeOf((*MockRevisionImpl)(nil).GetLast), projectID)
+}
+
+// Create mocks base method
+func (m *MockRevisionImpl) Create(r *models.ProjectRevision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockRevisionImplMockRecorder) Create(r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRevisionImpl)(nil).Create), r)
+}
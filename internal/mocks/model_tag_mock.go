@@ -0,0 +1,137 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockTagImpl is a mock of TagImpl interface
+type MockTagImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagImplMockRecorder
+}
+
+// MockTagImplMockRecorder is the mock recorder for MockTagImpl
+type MockTagImplMockRecorder struct {
+	mock *MockTagImpl
+}
+
+// NewMockTagImpl creates a new mock instance
+func NewMockTagImpl(ctrl *gomock.Controller) *MockTagImpl {
+	mock := &MockTagImpl{ctrl: ctrl}
+	mock.recorder = &MockTagImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTagImpl) EXPECT() *MockTagImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockTagImpl) Get(id int) (*models.Tag, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*models.Tag)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockTagImplMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTagImpl)(nil).Get), id)
+}
+
+// Search mocks base method
+func (m *MockTagImpl) Search(prefix string, limit int) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", prefix, limit)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockTagImplMockRecorder) Search(prefix, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTagImpl)(nil).Search), prefix, limit)
+}
+
+// GetByProject mocks base method
+func (m *MockTagImpl) GetByProject(projectID int) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByProject", projectID)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByProject indicates an expected call of GetByProject
+func (mr *MockTagImplMockRecorder) GetByProject(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByProject", reflect.TypeOf((*MockTagImpl)(nil).GetByProject), projectID)
+}
+
+// SetProjectTags mocks base method
+func (m *MockTagImpl) SetProjectTags(projectID int, tags []models.Tag) ([]models.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProjectTags", projectID, tags)
+	ret0, _ := ret[0].([]models.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProjectTags indicates an expected call of SetProjectTags
+func (mr *MockTagImplMockRecorder) SetProjectTags(projectID, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProjectTags", reflect.TypeOf((*MockTagImpl)(nil).SetProjectTags), projectID, tags)
+}
+
+// CountByStatus mocks base method
+func (m *MockTagImpl) CountByStatus() ([]models.TagStatusCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByStatus")
+	ret0, _ := ret[0].([]models.TagStatusCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStatus indicates an expected call of CountByStatus
+func (mr *MockTagImplMockRecorder) CountByStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStatus", reflect.TypeOf((*MockTagImpl)(nil).CountByStatus))
+}
+
+// Save mocks base method
+func (m *MockTagImpl) Save(t *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockTagImplMockRecorder) Save(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTagImpl)(nil).Save), t)
+}
+
+// Merge mocks base method
+func (m *MockTagImpl) Merge(source, target *models.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", source, target)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge
+func (mr *MockTagImplMockRecorder) Merge(source, target interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockTagImpl)(nil).Merge), source, target)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: template.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockTemplateImpl is a mock of TemplateImpl interface
+type MockTemplateImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockTemplateImplMockRecorder
+}
+
+// MockTemplateImplMockRecorder is the mock recorder for MockTemplateImpl
+type MockTemplateImplMockRecorder struct {
+	mock *MockTemplateImpl
+}
+
+// NewMockTemplateImpl creates a new mock instance
+func NewMockTemplateImpl(ctrl *gomock.Controller) *MockTemplateImpl {
+	mock := &MockTemplateImpl{ctrl: ctrl}
+	mock.recorder = &MockTemplateImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTemplateImpl) EXPECT() *MockTemplateImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockTemplateImpl) Get(id int) (*models.ProjectTemplate, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*models.ProjectTemplate)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockTemplateImplMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTemplateImpl)(nil).Get), id)
+}
+
+// GetAvailable mocks base method
+func (m *MockTemplateImpl) GetAvailable(userID int) ([]models.ProjectTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailable", userID)
+	ret0, _ := ret[0].([]models.ProjectTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailable indicates an expected call of GetAvailable
+func (mr *MockTemplateImplMockRecorder) GetAvailable(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailable", reflect.TypeOf((*MockTemplateImpl)(nil).GetAvailable), userID)
+}
+
+// Create mocks base method
+func (m *MockTemplateImpl) Create(t *models.ProjectTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockTemplateImplMockRecorder) Create(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTemplateImpl)(nil).Create), t)
+}
+
+// Delete mocks base method
+func (m *MockTemplateImpl) Delete(t *models.ProjectTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockTemplateImplMockRecorder) Delete(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTemplateImpl)(nil).Delete), t)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tier.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockTierImpl is a mock of TierImpl interface
+type MockTierImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockTierImplMockRecorder
+}
+
+// MockTierImplMockRecorder is the mock recorder for MockTierImpl
+type MockTierImplMockRecorder struct {
+	mock *MockTierImpl
+}
+
+// NewMockTierImpl creates a new mock instance
+func NewMockTierImpl(ctrl *gomock.Controller) *MockTierImpl {
+	mock := &MockTierImpl{ctrl: ctrl}
+	mock.recorder = &MockTierImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTierImpl) EXPECT() *MockTierImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockTierImpl) Get(id int) (*models.Tier, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*models.Tier)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockTierImplMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTierImpl)(nil).Get), id)
+}
+
+// GetAllByProject mocks base method
+func (m *MockTierImpl) GetAllByProject(projectID int) ([]models.Tier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByProject", projectID)
+	ret0, _ := ret[0].([]models.Tier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByProject indicates an expected call of GetAllByProject
+func (mr *MockTierImplMockRecorder) GetAllByProject(projectID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByProject", reflect.TypeOf((*MockTierImpl)(nil).GetAllByProject), projectID)
+}
+
+// Create mocks base method
+func (m *MockTierImpl) Create(t *models.Tier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockTierImplMockRecorder) Create(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTierImpl)(nil).Create), t)
+}
+
+// Delete mocks base method
+func (m *MockTierImpl) Delete(t *models.Tier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockTierImplMockRecorder) Delete(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTierImpl)(nil).Delete), t)
+}
@@ -48,6 +48,51 @@ func (mr *MockUserImplMockRecorder) Get(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserImpl)(nil).Get), id)
 }
 
+// GetByIDs mocks base method
+func (m *MockUserImpl) GetByIDs(ids []int) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ids)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs
+func (mr *MockUserImplMockRecorder) GetByIDs(ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockUserImpl)(nil).GetByIDs), ids)
+}
+
+// GetByUsernames mocks base method
+func (m *MockUserImpl) GetByUsernames(usernames []string) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsernames", usernames)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsernames indicates an expected call of GetByUsernames
+func (mr *MockUserImplMockRecorder) GetByUsernames(usernames interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsernames", reflect.TypeOf((*MockUserImpl)(nil).GetByUsernames), usernames)
+}
+
+// GetAdmins mocks base method
+func (m *MockUserImpl) GetAdmins() ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdmins")
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdmins indicates an expected call of GetAdmins
+func (mr *MockUserImplMockRecorder) GetAdmins() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdmins", reflect.TypeOf((*MockUserImpl)(nil).GetAdmins))
+}
+
 // Create mocks base method
 func (m *MockUserImpl) Create(arg0 *models.User) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -79,18 +124,18 @@ func (mr *MockUserImplMockRecorder) Update(arg0 interface{}) *gomock.Call {
 }
 
 // GetParticipation mocks base method
-func (m *MockUserImpl) GetParticipation(id int) ([]models.Participation, error) {
+func (m *MockUserImpl) GetParticipation(id int, withAnonymous bool) ([]models.Participation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetParticipation", id)
+	ret := m.ctrl.Call(m, "GetParticipation", id, withAnonymous)
 	ret0, _ := ret[0].([]models.Participation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetParticipation indicates an expected call of GetParticipation
-func (mr *MockUserImplMockRecorder) GetParticipation(id interface{}) *gomock.Call {
+func (mr *MockUserImplMockRecorder) GetParticipation(id, withAnonymous interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipation", reflect.TypeOf((*MockUserImpl)(nil).GetParticipation), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipation", reflect.TypeOf((*MockUserImpl)(nil).GetParticipation), id, withAnonymous)
 }
 
 // GetProjectsForRate mocks base method
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook_delivery.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockWebhookDeliveryImpl is a mock of WebhookDeliveryImpl interface
+type MockWebhookDeliveryImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryImplMockRecorder
+}
+
+// MockWebhookDeliveryImplMockRecorder is the mock recorder for MockWebhookDeliveryImpl
+type MockWebhookDeliveryImplMockRecorder struct {
+	mock *MockWebhookDeliveryImpl
+}
+
+// NewMockWebhookDeliveryImpl creates a new mock instance
+func NewMockWebhookDeliveryImpl(ctrl *gomock.Controller) *MockWebhookDeliveryImpl {
+	mock := &MockWebhookDeliveryImpl{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveryImpl) EXPECT() *MockWebhookDeliveryImplMockRecorder {
+	return m.recorder
+}
+
+// GetAllByWebhook mocks base method
+func (m *MockWebhookDeliveryImpl) GetAllByWebhook(webhookID, cursor, limit int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByWebhook", webhookID, cursor, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByWebhook indicates an expected call of GetAllByWebhook
+func (mr *MockWebhookDeliveryImplMockRecorder) GetAllByWebhook(webhookID, cursor, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByWebhook", reflect.TypeOf((*MockWebhookDeliveryImpl)(nil).GetAllByWebhook), webhookID, cursor, limit)
+}
+
+// GetPending mocks base method
+func (m *MockWebhookDeliveryImpl) GetPending(now time.Time, limit int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPending", now, limit)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPending indicates an expected call of GetPending
+func (mr *MockWebhookDeliveryImplMockRecorder) GetPending(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPending", reflect.TypeOf((*MockWebhookDeliveryImpl)(nil).GetPending), now, limit)
+}
+
+// Create mocks base method
+func (m *MockWebhookDeliveryImpl) Create(d *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookDeliveryImplMockRecorder) Create(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveryImpl)(nil).Create), d)
+}
+
+// Update mocks base method
+func (m *MockWebhookDeliveryImpl) Update(d *models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookDeliveryImplMockRecorder) Update(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveryImpl)(nil).Update), d)
+}
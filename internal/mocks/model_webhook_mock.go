@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: webhook.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "github.com/FreakyGranny/launchpad-api/internal/models"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockWebhookImpl is a mock of WebhookImpl interface
+type MockWebhookImpl struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookImplMockRecorder
+}
+
+// MockWebhookImplMockRecorder is the mock recorder for MockWebhookImpl
+type MockWebhookImplMockRecorder struct {
+	mock *MockWebhookImpl
+}
+
+// NewMockWebhookImpl creates a new mock instance
+func NewMockWebhookImpl(ctrl *gomock.Controller) *MockWebhookImpl {
+	mock := &MockWebhookImpl{ctrl: ctrl}
+	mock.recorder = &MockWebhookImplMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookImpl) EXPECT() *MockWebhookImplMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockWebhookImpl) Get(id int) (*models.Webhook, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*models.Webhook)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockWebhookImplMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookImpl)(nil).Get), id)
+}
+
+// GetAll mocks base method
+func (m *MockWebhookImpl) GetAll() ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll
+func (mr *MockWebhookImplMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWebhookImpl)(nil).GetAll))
+}
+
+// GetActiveByEvent mocks base method
+func (m *MockWebhookImpl) GetActiveByEvent(event string) ([]models.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveByEvent", event)
+	ret0, _ := ret[0].([]models.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveByEvent indicates an expected call of GetActiveByEvent
+func (mr *MockWebhookImplMockRecorder) GetActiveByEvent(event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveByEvent", reflect.TypeOf((*MockWebhookImpl)(nil).GetActiveByEvent), event)
+}
+
+// Create mocks base method
+func (m *MockWebhookImpl) Create(w *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookImplMockRecorder) Create(w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookImpl)(nil).Create), w)
+}
+
+// Update mocks base method
+func (m *MockWebhookImpl) Update(w *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookImplMockRecorder) Update(w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookImpl)(nil).Update), w)
+}
+
+// Delete mocks base method
+func (m *MockWebhookImpl) Delete(w *models.Webhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockWebhookImplMockRecorder) Delete(w interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookImpl)(nil).Delete), w)
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_activity_mock.go -package=mocks ActivityImpl
+
+const (
+	// ActivityPublished type of activity about new project
+	ActivityPublished = "project_published"
+
+	// followedActivity matches activity "ac" with follow "f".
+	// Publications are followed by category or owner, other activities by project.
+	followedActivity = `((f.target_type = 'project' AND f.target_id = ac.project_id AND ac.type != '` + ActivityPublished + `')
+		OR (f.target_type = 'category' AND f.target_id = ac.category_id AND ac.type = '` + ActivityPublished + `')
+		OR (f.target_type = 'user' AND f.target_id = ac.owner_id AND ac.type = '` + ActivityPublished + `'))`
+)
+
+// ActivityImpl ...
+type ActivityImpl interface {
+	Create(a *Activity) error
+	GetFeed(userID, cursor, limit int) ([]Activity, error)
+}
+
+// Activity public event of project shown in feeds of followers
+type Activity struct {
+	tableName  struct{}               `pg:"activities,alias:ac"` //nolint
+	ID         int                    `json:"id"`
+	Type       string                 `json:"type"`
+	ProjectID  int                    `json:"project"`
+	CategoryID int                    `json:"-"`
+	OwnerID    int                    `json:"-"`
+	Data       map[string]interface{} `json:"data"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// ActivityRepo ...
+type ActivityRepo struct {
+	db *pg.DB
+}
+
+// NewActivityModel ...
+func NewActivityModel(db *pg.DB) *ActivityRepo {
+	return &ActivityRepo{
+		db: db,
+	}
+}
+
+// Create new activity
+func (r *ActivityRepo) Create(a *Activity) error {
+	_, err := r.db.Model(a).Insert()
+
+	return err
+}
+
+// GetFeed returns activities followed by user, newest first.
+// Only activities with id less than cursor are returned if cursor is set.
+func (r *ActivityRepo) GetFeed(userID, cursor, limit int) ([]Activity, error) {
+	activities := make([]Activity, 0)
+	q := r.db.Model(&activities).
+		Where("EXISTS (SELECT 1 FROM follows f WHERE f.user_id = ? AND "+followedActivity+")", userID)
+	if cursor > 0 {
+		q = q.Where("ac.id < ?", cursor)
+	}
+	err := q.Order("ac.id DESC").Limit(limit).Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return activities, nil
+}
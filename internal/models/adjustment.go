@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_adjustment_mock.go -package=mocks AdjustmentImpl
+
+// AdjustmentImpl ...
+type AdjustmentImpl interface {
+	GetAllByUser(userID int) ([]Adjustment, error)
+}
+
+// Adjustment change of participant share after project lock
+type Adjustment struct {
+	tableName  struct{}  `pg:"adjustments,alias:a"` //nolint
+	ID         int       `json:"id"`
+	DonationID int       `json:"donation"`
+	ProjectID  int       `json:"project"`
+	UserID     int       `json:"-"`
+	OldPayment int64     `pg:",use_zero" json:"old_payment"`
+	NewPayment int64     `pg:",use_zero" json:"new_payment"`
+	Credit     int64     `pg:",use_zero" json:"credit"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// AdjustmentRepo ...
+type AdjustmentRepo struct {
+	db *pg.DB
+}
+
+// NewAdjustmentModel ...
+func NewAdjustmentModel(db *pg.DB) *AdjustmentRepo {
+	return &AdjustmentRepo{
+		db: db,
+	}
+}
+
+// GetAllByUser returns share adjustments of user, newest first
+func (r *AdjustmentRepo) GetAllByUser(userID int) ([]Adjustment, error) {
+	adjustments := make([]Adjustment, 0)
+	err := r.db.Model(&adjustments).Where("a.user_id = ?", userID).Order("a.id DESC").Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return adjustments, nil
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_audit_mock.go -package=mocks AuditImpl
+
+const (
+	// AuditEdit field of published project is edited
+	AuditEdit = "edit"
+	// AuditExtend release date of project is extended
+	AuditExtend = "extend"
+	// AuditCancel project is cancelled by owner
+	AuditCancel = "cancel"
+	// AuditReopen failed project is reopened by admin
+	AuditReopen = "reopen"
+)
+
+// AuditImpl ...
+type AuditImpl interface {
+	GetAllByProject(projectID int) ([]ProjectAudit, error)
+}
+
+// ProjectAudit change of published project
+type ProjectAudit struct {
+	tableName struct{}  `pg:"project_audit,alias:pa"` //nolint
+	ID        int       `json:"id"`
+	ProjectID int       `json:"project"`
+	UserID    int       `json:"user"`
+	Action    string    `json:"action"`
+	Field     string    `pg:",use_zero" json:"field,omitempty"`
+	OldValue  string    `pg:",use_zero" json:"old_value"`
+	NewValue  string    `pg:",use_zero" json:"new_value"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AuditRepo ...
+type AuditRepo struct {
+	db *pg.DB
+}
+
+// NewAuditModel ...
+func NewAuditModel(db *pg.DB) *AuditRepo {
+	return &AuditRepo{
+		db: db,
+	}
+}
+
+// GetAllByProject returns audit trail of project, newest first
+func (r *AuditRepo) GetAllByProject(projectID int) ([]ProjectAudit, error) {
+	entries := make([]ProjectAudit, 0)
+	err := r.db.Model(&entries).Where("pa.project_id = ?", projectID).Order("pa.id DESC").Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -18,6 +18,7 @@ type Category struct {
 	ID        int      `json:"id"`
 	Alias     string   `json:"alias"`
 	Name      string   `json:"name"`
+	Moderated bool     `pg:",use_zero" json:"moderated,omitempty"`
 }
 
 // CategoryRepo ...
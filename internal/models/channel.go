@@ -0,0 +1,52 @@
+package models
+
+import (
+	"context"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_channel_mock.go -package=mocks ChannelImpl
+
+// ChannelImpl ...
+type ChannelImpl interface {
+	Notify(channel, payload string) error
+	Listen(ctx context.Context, channel string) <-chan string
+}
+
+// ChannelRepo postgres LISTEN/NOTIFY channels
+type ChannelRepo struct {
+	db *pg.DB
+}
+
+// NewChannelModel ...
+func NewChannelModel(db *pg.DB) *ChannelRepo {
+	return &ChannelRepo{
+		db: db,
+	}
+}
+
+// Notify sends payload to all listeners of channel
+func (r *ChannelRepo) Notify(channel, payload string) error {
+	_, err := r.db.Exec("SELECT pg_notify(?, ?)", channel, payload)
+
+	return err
+}
+
+// Listen returns payloads sent to channel until context is done
+func (r *ChannelRepo) Listen(ctx context.Context, channel string) <-chan string {
+	ln := r.db.Listen(ctx, channel)
+	payloads := make(chan string)
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+	go func() {
+		defer close(payloads)
+		for n := range ln.Channel() {
+			payloads <- n.Payload
+		}
+	}()
+
+	return payloads
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_chat_account_mock.go -package=mocks ChatAccountImpl
+
+// ChatAccountImpl ...
+type ChatAccountImpl interface {
+	Get(userID int) (*ChatAccount, bool)
+	GetByChatUser(chatUserID string) (*ChatAccount, bool)
+	GetByCode(code string) (*ChatAccount, bool)
+	GetByUsers(userIDs []int) ([]ChatAccount, error)
+	Save(a *ChatAccount) error
+	Delete(userID int) error
+}
+
+// ChatAccount link between launchpad user and chat account
+type ChatAccount struct {
+	tableName     struct{} `pg:"chat_accounts,alias:ca"` //nolint
+	UserID        int      `pg:",pk"`
+	ChatUserID    string   `pg:",use_zero"`
+	ChatUsername  string   `pg:",use_zero"`
+	LinkCode      string   `pg:",use_zero"`
+	LinkExpiresAt time.Time
+}
+
+// Linked returns true if chat account is linked to user.
+func (a *ChatAccount) Linked() bool {
+	return a.ChatUserID != ""
+}
+
+// ChatAccountRepo ...
+type ChatAccountRepo struct {
+	db *pg.DB
+}
+
+// NewChatAccountModel ...
+func NewChatAccountModel(db *pg.DB) *ChatAccountRepo {
+	return &ChatAccountRepo{
+		db: db,
+	}
+}
+
+// Get returns chat account of user
+func (r *ChatAccountRepo) Get(userID int) (*ChatAccount, bool) {
+	account := &ChatAccount{}
+	err := r.db.Model(account).Where("ca.user_id = ?", userID).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return account, true
+}
+
+// GetByChatUser returns linked account by id of chat user
+func (r *ChatAccountRepo) GetByChatUser(chatUserID string) (*ChatAccount, bool) {
+	account := &ChatAccount{}
+	err := r.db.Model(account).Where("ca.chat_user_id = ?", chatUserID).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return account, true
+}
+
+// GetByCode returns account waiting for link with given code
+func (r *ChatAccountRepo) GetByCode(code string) (*ChatAccount, bool) {
+	account := &ChatAccount{}
+	err := r.db.Model(account).Where("ca.link_code = ?", code).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return account, true
+}
+
+// GetByUsers returns linked accounts of given users
+func (r *ChatAccountRepo) GetByUsers(userIDs []int) ([]ChatAccount, error) {
+	accounts := make([]ChatAccount, 0)
+	if len(userIDs) == 0 {
+		return accounts, nil
+	}
+	err := r.db.Model(&accounts).
+		Where("ca.user_id IN (?)", pg.In(userIDs)).
+		Where("ca.chat_user_id != ''").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// Save creates or replaces chat account of user
+func (r *ChatAccountRepo) Save(a *ChatAccount) error {
+	_, err := r.db.Model(a).
+		OnConflict("(user_id) DO UPDATE").
+		Set("chat_user_id = EXCLUDED.chat_user_id").
+		Set("chat_username = EXCLUDED.chat_username").
+		Set("link_code = EXCLUDED.link_code").
+		Set("link_expires_at = EXCLUDED.link_expires_at").
+		Insert()
+
+	return err
+}
+
+// Delete chat account of user
+func (r *ChatAccountRepo) Delete(userID int) error {
+	_, err := r.db.Model((*ChatAccount)(nil)).Where("ca.user_id = ?", userID).Delete()
+
+	return err
+}
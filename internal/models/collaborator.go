@@ -0,0 +1,146 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_collaborator_mock.go -package=mocks CollaboratorImpl
+
+const (
+	// RoleOwner owner of project, isn't stored as collaborator
+	RoleOwner = "owner"
+	// RoleCoOwner collaborator managing project like owner
+	RoleCoOwner = "co_owner"
+	// RoleTreasurer collaborator confirming payments
+	RoleTreasurer = "treasurer"
+	// RoleModerator collaborator moderating guestbook and comments
+	RoleModerator = "moderator"
+)
+
+// CollaboratorImpl ...
+type CollaboratorImpl interface {
+	Get(projectID, userID int) (*Collaborator, bool)
+	GetAllByProject(projectID int) ([]Collaborator, error)
+	GetInvitations(userID int) ([]Collaborator, error)
+	Create(c *Collaborator) error
+	Accept(c *Collaborator) error
+	Delete(c *Collaborator) error
+	TransferOwnership(p *Project, owner, previous *Collaborator) error
+}
+
+// Collaborator user managing project with owner.
+// Invitation is pending until it is accepted.
+type Collaborator struct {
+	tableName  struct{}  `pg:"project_collaborators,alias:pc"` //nolint
+	ProjectID  int       `pg:",pk" json:"project"`
+	UserID     int       `pg:",pk" json:"-"`
+	User       User      `json:"user"`
+	Project    Project   `json:"-"`
+	Role       string    `json:"role"`
+	InvitedBy  int       `json:"invited_by"`
+	AcceptedAt time.Time `json:"accepted_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// Accepted checks invitation is accepted.
+func (c *Collaborator) Accepted() bool {
+	return !c.AcceptedAt.IsZero()
+}
+
+// CollaboratorRepo ...
+type CollaboratorRepo struct {
+	db *pg.DB
+}
+
+// NewCollaboratorModel ...
+func NewCollaboratorModel(db *pg.DB) *CollaboratorRepo {
+	return &CollaboratorRepo{
+		db: db,
+	}
+}
+
+// Get collaborator of project
+func (r *CollaboratorRepo) Get(projectID, userID int) (*Collaborator, bool) {
+	collaborator := &Collaborator{}
+	err := r.db.Model(collaborator).
+		Where("pc.project_id = ?", projectID).
+		Where("pc.user_id = ?", userID).
+		Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return collaborator, true
+}
+
+// GetAllByProject returns collaborators and pending invitations of project
+func (r *CollaboratorRepo) GetAllByProject(projectID int) ([]Collaborator, error) {
+	collaborators := make([]Collaborator, 0)
+	err := r.db.Model(&collaborators).
+		Relation("User").
+		Where("pc.project_id = ?", projectID).
+		Order("pc.created_at ASC", "pc.user_id ASC").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return collaborators, nil
+}
+
+// GetInvitations returns pending invitations of user with projects, newest first
+func (r *CollaboratorRepo) GetInvitations(userID int) ([]Collaborator, error) {
+	invitations := make([]Collaborator, 0)
+	err := r.db.Model(&invitations).
+		Relation("Project").
+		Where("pc.user_id = ?", userID).
+		Where("pc.accepted_at IS NULL").
+		Order("pc.created_at DESC").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return invitations, nil
+}
+
+// Create invitation of collaborator
+func (r *CollaboratorRepo) Create(c *Collaborator) error {
+	_, err := r.db.Model(c).Insert()
+
+	return err
+}
+
+// Accept saves acceptance of invitation
+func (r *CollaboratorRepo) Accept(c *Collaborator) error {
+	_, err := r.db.Model(c).Column("accepted_at").WherePK().Update()
+
+	return err
+}
+
+// Delete collaborator or invitation
+func (r *CollaboratorRepo) Delete(c *Collaborator) error {
+	_, err := r.db.Model(c).WherePK().Delete()
+
+	return err
+}
+
+// TransferOwnership makes collaborator owner of project and stores previous owner as collaborator
+func (r *CollaboratorRepo) TransferOwnership(p *Project, owner, previous *Collaborator) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model(owner).WherePK().Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(previous).Insert()
+		if err != nil {
+			return err
+		}
+		p.OwnerID = owner.UserID
+		_, err = tx.Model(p).Column("owner_id").WherePK().Update()
+
+		return err
+	})
+}
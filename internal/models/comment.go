@@ -0,0 +1,139 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_comment_mock.go -package=mocks CommentImpl
+
+// CommentImpl ...
+type CommentImpl interface {
+	Get(id int) (*Comment, bool)
+	GetThreads(projectID, cursor, limit int) ([]Comment, error)
+	GetReplies(parentID, cursor, limit int) ([]Comment, error)
+	Create(c *Comment) error
+	Update(c *Comment) error
+}
+
+// Comment project discussion message
+type Comment struct {
+	tableName  struct{}   `pg:"comments,alias:c"` //nolint
+	ID         int        `json:"id"`
+	ProjectID  int        `json:"project"`
+	ParentID   int        `json:"parent,omitempty"`
+	User       User       `json:"user"`
+	UserID     int        `json:"-"`
+	Text       string     `json:"text"`
+	MentionIDs []int      `pg:",array" json:"-"`
+	Mentions   []User     `pg:"-" json:"mentions"`
+	Replies    int        `pg:"-" json:"replies"`
+	Deleted    bool       `pg:",use_zero" json:"deleted"`
+	CreatedAt  time.Time  `json:"created_at"`
+	EditedAt   *time.Time `json:"edited_at"`
+}
+
+// CommentRepo ...
+type CommentRepo struct {
+	db *pg.DB
+}
+
+// NewCommentModel ...
+func NewCommentModel(db *pg.DB) *CommentRepo {
+	return &CommentRepo{
+		db: db,
+	}
+}
+
+// Get comment
+func (r *CommentRepo) Get(id int) (*Comment, bool) {
+	comment := &Comment{}
+	err := r.db.Model(comment).Relation("User").Where("c.id = ?", id).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return comment, true
+}
+
+// GetThreads returns top level comments of project with count of replies, oldest first.
+// Only comments with id greater than cursor are returned.
+func (r *CommentRepo) GetThreads(projectID, cursor, limit int) ([]Comment, error) {
+	comments := make([]Comment, 0)
+	err := r.db.Model(&comments).
+		Relation("User").
+		Where("c.project_id = ?", projectID).
+		Where("c.parent_id IS NULL").
+		Where("c.id > ?", cursor).
+		Order("c.id ASC").
+		Limit(limit).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+	if len(comments) == 0 {
+		return comments, nil
+	}
+	ids := make([]int, 0, len(comments))
+	for _, c := range comments {
+		ids = append(ids, c.ID)
+	}
+	counts := make([]replyCount, 0)
+	err = r.db.Model((*Comment)(nil)).
+		ColumnExpr("c.parent_id").
+		ColumnExpr("count(c.id) AS cnt").
+		Where("c.parent_id IN (?)", pg.In(ids)).
+		Where("c.deleted = ?", false).
+		Group("c.parent_id").
+		Select(&counts)
+	if err != nil {
+		return nil, err
+	}
+	replies := make(map[int]int, len(counts))
+	for _, c := range counts {
+		replies[c.ParentID] = c.Cnt
+	}
+	for i := range comments {
+		comments[i].Replies = replies[comments[i].ID]
+	}
+
+	return comments, nil
+}
+
+type replyCount struct {
+	ParentID int
+	Cnt      int
+}
+
+// GetReplies returns replies to comment, oldest first.
+// Only replies with id greater than cursor are returned.
+func (r *CommentRepo) GetReplies(parentID, cursor, limit int) ([]Comment, error) {
+	comments := make([]Comment, 0)
+	err := r.db.Model(&comments).
+		Relation("User").
+		Where("c.parent_id = ?", parentID).
+		Where("c.id > ?", cursor).
+		Order("c.id ASC").
+		Limit(limit).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// Create new comment
+func (r *CommentRepo) Create(c *Comment) error {
+	_, err := r.db.Model(c).Insert()
+
+	return err
+}
+
+// Update comment
+func (r *CommentRepo) Update(c *Comment) error {
+	_, err := r.db.Model(c).Column("text", "mention_ids", "deleted", "edited_at").WherePK().Update()
+
+	return err
+}
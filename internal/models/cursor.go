@@ -0,0 +1,57 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// ErrInvalidCursor cursor can't be decoded or doesn't match ordering of list
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor position after the last entry of list page.
+// Values are sort keys of the entry, id breaks ties between equal values.
+type Cursor struct {
+	Sort   string   `json:"s,omitempty"`
+	Values []string `json:"v,omitempty"`
+	ID     int      `json:"id"`
+}
+
+// Encode returns opaque representation of cursor, nil cursor is encoded as empty string
+func (c *Cursor) Encode() string {
+	if c == nil {
+		return ""
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses opaque cursor, empty value means the first page.
+// Plain id is accepted for compatibility with numeric cursors.
+func DecodeCursor(value string) (*Cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if id, err := strconv.Atoi(value); err == nil {
+		if id < 0 {
+			return nil, ErrInvalidCursor
+		}
+		return &Cursor{ID: id}, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	c := &Cursor{}
+	err = json.Unmarshal(b, c)
+	if err != nil || c.ID <= 0 {
+		return nil, ErrInvalidCursor
+	}
+
+	return c, nil
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CursorSuite struct {
+	suite.Suite
+}
+
+func (s *CursorSuite) TestEncodeDecode() {
+	c := &Cursor{Sort: "-total", Values: []string{"100"}, ID: 5}
+	decoded, err := DecodeCursor(c.Encode())
+	s.Require().NoError(err)
+	s.Require().Equal(c, decoded)
+}
+
+func (s *CursorSuite) TestEncodeNil() {
+	var c *Cursor
+	s.Require().Equal("", c.Encode())
+}
+
+func (s *CursorSuite) TestDecodeEmpty() {
+	c, err := DecodeCursor("")
+	s.Require().NoError(err)
+	s.Require().Nil(c)
+}
+
+func (s *CursorSuite) TestDecodeNumeric() {
+	c, err := DecodeCursor("42")
+	s.Require().NoError(err)
+	s.Require().Equal(&Cursor{ID: 42}, c)
+}
+
+func (s *CursorSuite) TestDecodeInvalid() {
+	for _, value := range []string{"-1", "not a cursor", "e30"} {
+		c, err := DecodeCursor(value)
+		s.Require().Equal(ErrInvalidCursor, err, value)
+		s.Require().Nil(c)
+	}
+}
+
+func TestCursorSuite(t *testing.T) {
+	suite.Run(t, new(CursorSuite))
+}
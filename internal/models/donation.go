@@ -1,6 +1,8 @@
 package models
 
 import (
+	"time"
+
 	"github.com/go-pg/pg/v10"
 )
 
@@ -9,24 +11,63 @@ import (
 // DonationImpl ...
 type DonationImpl interface {
 	Get(id int) (*Donation, bool)
-	GetAllByUser(id int) ([]Donation, error)
+	GetAllByUser(id, cursor, limit int) ([]Donation, error)
 	GetAllByProject(id int) ([]Donation, error)
-	Create(d *Donation) error
+	GetPageByProject(id, cursor, limit int) ([]Donation, error)
+	GetMessagesByProject(id, page, pageSize int, withHidden bool) ([]Donation, int, error)
+	Create(d *Donation, joinAfterLock bool) error
 	Update(d *Donation) error
+	UpdatePayment(d *Donation) error
+	UpdatePaid(d *Donation) error
+	UpdateAnonymity(d *Donation) error
+	UpdateMessage(d *Donation) error
 	Delete(d *Donation) error
+	SetReminded(d *Donation) error
 }
 
 // Donation for project
 type Donation struct {
-	tableName struct{} `pg:"donations,alias:d"` //nolint
-	ID        int      `json:"id"`
-	Payment   int      `json:"payment"`
-	Locked    bool     `pg:",use_zero" json:"locked"`
-	Paid      bool     `pg:",use_zero" json:"paid"`
-	User      User     `json:"-"`
-	UserID    int      `json:"-"`
-	Project   Project  `json:"-"`
-	ProjectID int      `json:"project"`
+	tableName     struct{}  `pg:"donations,alias:d"` //nolint
+	ID            int       `json:"id"`
+	Payment       int64     `json:"payment"`
+	Currency      string    `json:"currency"`
+	Locked        bool      `pg:",use_zero" json:"locked"`
+	Paid          bool      `pg:",use_zero" json:"paid"`
+	PaidAmount    int64     `pg:",use_zero" json:"paid_amount"`
+	Credit        int64     `pg:",use_zero" json:"credit"`
+	User          User      `json:"-"`
+	UserID        int       `json:"-"`
+	Project       Project   `json:"-"`
+	ProjectID     int       `json:"project"`
+	TierID        int       `json:"tier,omitempty"`
+	Anonymous     bool      `pg:",use_zero" json:"anonymous"`
+	Message       string    `pg:",use_zero" json:"message"`
+	MessageHidden bool      `pg:",use_zero" json:"message_hidden"`
+	MessageAt     time.Time `json:"-"`
+	ReminderCount int       `pg:",use_zero" json:"-"`
+	RemindedAt    time.Time `json:"-"`
+	CreatedAt     time.Time `json:"-"`
+}
+
+// SetShare sets new equal share and recalculates credit for already paid amount.
+// Reminders start over if share is raised. Returns false if share is not changed.
+func (d *Donation) SetShare(share int64) bool {
+	if d.Payment == share {
+		return false
+	}
+	if share > d.Payment {
+		d.ReminderCount = 0
+	}
+	d.Payment = share
+	if d.PaidAmount > 0 {
+		d.Paid = d.PaidAmount >= share
+		d.Credit = 0
+		if d.Paid {
+			d.Credit = d.PaidAmount - share
+		}
+	}
+
+	return true
 }
 
 // DonationRepo ...
@@ -52,10 +93,14 @@ func (r *DonationRepo) Get(id int) (*Donation, bool) {
 	return donation, true
 }
 
-// GetAllByUser returns all donations associated with user
-func (r *DonationRepo) GetAllByUser(id int) ([]Donation, error) {
+// GetAllByUser returns donations of user with id greater than cursor, zero limit means no limit
+func (r *DonationRepo) GetAllByUser(id, cursor, limit int) ([]Donation, error) {
 	donations := make([]Donation, 0)
-	err := r.db.Model(&donations).Where("user_id = ?", id).Select()
+	q := r.db.Model(&donations).Where("d.user_id = ?", id).Where("d.id > ?", cursor).Order("d.id ASC")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Select()
 	if err != nil {
 		return nil, err
 	}
@@ -74,34 +119,131 @@ func (r *DonationRepo) GetAllByProject(id int) ([]Donation, error) {
 	return donations, nil
 }
 
-// Create a new donation
-func (r *DonationRepo) Create(d *Donation) error {
-	count, err := r.db.Model((*Donation)(nil)).Where("d.project_id = ? AND d.user_id = ?", d.ProjectID, d.UserID).Count()
+// GetPageByProject returns donations of project with id greater than cursor
+func (r *DonationRepo) GetPageByProject(id, cursor, limit int) ([]Donation, error) {
+	donations := make([]Donation, 0)
+	err := r.db.Model(&donations).
+		Relation("User").
+		Where("d.project_id = ?", id).
+		Where("d.id > ?", cursor).
+		Order("d.id ASC").
+		Limit(limit).
+		Select()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if count > 0 {
-		return ErrDonationAlreadyExist
+
+	return donations, nil
+}
+
+// GetMessagesByProject returns page of donations with messages and total count of them
+func (r *DonationRepo) GetMessagesByProject(id, page, pageSize int, withHidden bool) ([]Donation, int, error) {
+	donations := make([]Donation, 0)
+	q := r.db.Model(&donations).
+		Relation("User").
+		Where("d.project_id = ?", id).
+		Where("d.message != ''")
+	if !withHidden {
+		q = q.Where("d.message_hidden = ?", false)
 	}
-	count, err = r.db.Model((*User)(nil)).Where("u.id = ?", d.UserID).Count()
+	count, err := q.Order("d.message_at DESC", "d.id DESC").
+		Offset(pageSize * (page - 1)).
+		Limit(pageSize).
+		SelectAndCount()
 	if err != nil {
+		return nil, 0, err
+	}
+
+	return donations, count, nil
+}
+
+// Create a new donation, project locked meanwhile accepts it only if joinAfterLock is set
+func (r *DonationRepo) Create(d *Donation, joinAfterLock bool) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		count, err := tx.Model((*Donation)(nil)).Where("d.project_id = ? AND d.user_id = ?", d.ProjectID, d.UserID).Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return ErrDonationAlreadyExist
+		}
+		count, err = tx.Model((*User)(nil)).Where("u.id = ?", d.UserID).Count()
+		if err != nil {
+			return err
+		}
+		if count != 1 {
+			return ErrUserNotFound
+		}
+		project, err := r.lockProject(tx, d)
+		if err != nil {
+			return err
+		}
+		if project.Closed || !project.Published || (project.Locked && !joinAfterLock) {
+			return ErrDonationForbidden
+		}
+		err = r.checkOverfunding(tx, project, d)
+		if err != nil {
+			return err
+		}
+		if d.TierID != 0 {
+			err = r.takeTier(tx, d)
+			if err != nil {
+				return err
+			}
+		}
+		d.Currency = project.Currency
+		_, err = tx.Model(d).Insert()
+
 		return err
+	})
+}
+
+// lockProject selects project of donation for update, so concurrent pledges are checked one by one
+func (r *DonationRepo) lockProject(tx *pg.Tx, d *Donation) (*Project, error) {
+	project := &Project{}
+	err := tx.Model(project).Where("p.id = ?", d.ProjectID).For("UPDATE").Select()
+	if err != nil {
+		return nil, err
 	}
-	if count != 1 {
-		return ErrUserNotFound
+
+	return project, nil
+}
+
+// checkOverfunding checks payment of donation fits amount remaining to project goal
+func (r *DonationRepo) checkOverfunding(tx *pg.Tx, p *Project, d *Donation) error {
+	if !p.DenyOverfunding {
+		return nil
 	}
-	project := &Project{}
-	err = r.db.Model(project).Where("p.id = ?", d.ProjectID).Select()
+	var taken int64
+	err := tx.Model((*Donation)(nil)).
+		ColumnExpr("coalesce(sum(d.payment), 0)").
+		Where("d.project_id = ? AND d.id != ?", p.ID, d.ID).
+		Select(&taken)
 	if err != nil {
 		return err
 	}
-	if project.Closed || project.Locked || !project.Published {
-		return ErrDonationForbidden
+	remaining := p.GoalAmount - taken
+	if d.Payment > remaining {
+		return &OverfundingError{Remaining: remaining, Currency: p.Currency}
+	}
+
+	return nil
+}
+
+// takeTier locks tier of donation and checks it is not sold out
+func (r *DonationRepo) takeTier(tx *pg.Tx, d *Donation) error {
+	tier := &Tier{}
+	err := tx.Model(tier).Where("t.id = ? AND t.project_id = ?", d.TierID, d.ProjectID).For("UPDATE").Select()
+	if err != nil {
+		return err
 	}
-	_, err = r.db.Model(d).Insert()
+	tier.Taken, err = tx.Model((*Donation)(nil)).Where("d.tier_id = ?", tier.ID).Count()
 	if err != nil {
 		return err
 	}
+	if tier.SoldOut() {
+		return ErrTierSoldOut
+	}
 
 	return nil
 }
@@ -120,3 +262,48 @@ func (r *DonationRepo) Update(d *Donation) error {
 	return err
 
 }
+
+// UpdatePayment changes payment of donation if it fits project goal
+func (r *DonationRepo) UpdatePayment(d *Donation) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		project, err := r.lockProject(tx, d)
+		if err != nil {
+			return err
+		}
+		err = r.checkOverfunding(tx, project, d)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(d).Column("payment").WherePK().Update()
+
+		return err
+	})
+}
+
+// UpdatePaid saves paid state, paid amount and credit of donation
+func (r *DonationRepo) UpdatePaid(d *Donation) error {
+	_, err := r.db.Model(d).Column("paid", "paid_amount", "credit").WherePK().Update()
+
+	return err
+}
+
+// UpdateAnonymity saves anonymity of donation
+func (r *DonationRepo) UpdateAnonymity(d *Donation) error {
+	_, err := r.db.Model(d).Column("anonymous").WherePK().Update()
+
+	return err
+}
+
+// UpdateMessage saves guestbook message of donation
+func (r *DonationRepo) UpdateMessage(d *Donation) error {
+	_, err := r.db.Model(d).Column("message", "message_hidden", "message_at").WherePK().Update()
+
+	return err
+}
+
+// SetReminded saves count and time of payment reminders
+func (r *DonationRepo) SetReminded(d *Donation) error {
+	_, err := r.db.Model(d).Column("reminder_count", "reminded_at").WherePK().Update()
+
+	return err
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DonationSuite struct {
+	suite.Suite
+}
+
+func (s *DonationSuite) TestSetShareNotChanged() {
+	d := &Donation{Payment: 500}
+	s.Require().False(d.SetShare(500))
+}
+
+func (s *DonationSuite) TestSetShareNotPaid() {
+	d := &Donation{Payment: 500}
+	s.Require().True(d.SetShare(400))
+	s.Require().Equal(&Donation{Payment: 400}, d)
+}
+
+func (s *DonationSuite) TestSetShareDecreased() {
+	d := &Donation{Payment: 500, PaidAmount: 500, Paid: true}
+	s.Require().True(d.SetShare(400))
+	s.Require().Equal(&Donation{Payment: 400, PaidAmount: 500, Paid: true, Credit: 100}, d)
+}
+
+func (s *DonationSuite) TestSetShareIncreased() {
+	d := &Donation{Payment: 400, PaidAmount: 500, Paid: true, Credit: 100}
+	s.Require().True(d.SetShare(600))
+	s.Require().Equal(&Donation{Payment: 600, PaidAmount: 500, Paid: false}, d)
+}
+
+func (s *DonationSuite) TestSetShareIncreasedResetsReminders() {
+	d := &Donation{Payment: 400, ReminderCount: 2}
+	s.Require().True(d.SetShare(500))
+	s.Require().Equal(0, d.ReminderCount)
+	s.Require().True(d.SetShare(300))
+	d.ReminderCount = 1
+	s.Require().True(d.SetShare(200))
+	s.Require().Equal(1, d.ReminderCount)
+}
+
+func TestDonationSuite(t *testing.T) {
+	suite.Run(t, new(DonationSuite))
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_email_settings_mock.go -package=mocks EmailSettingsImpl
+
+// EmailSettingsImpl ...
+type EmailSettingsImpl interface {
+	Get(userID int) (*EmailSettings, bool)
+	Save(s *EmailSettings) error
+}
+
+// EmailSettings user preferences of email notifications
+type EmailSettings struct {
+	tableName struct{} `pg:"email_settings,alias:es"` //nolint
+	UserID    int      `pg:",pk" json:"-"`
+	Locale    string   `json:"locale"`
+	Digest    bool     `pg:",use_zero" json:"digest"`
+	Events    []string `pg:",array" json:"events"`
+}
+
+// Enabled returns true if user opted in emails of given event type.
+func (s *EmailSettings) Enabled(eventType string) bool {
+	for _, e := range s.Events {
+		if e == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EmailSettingsRepo ...
+type EmailSettingsRepo struct {
+	db *pg.DB
+}
+
+// NewEmailSettingsModel ...
+func NewEmailSettingsModel(db *pg.DB) *EmailSettingsRepo {
+	return &EmailSettingsRepo{
+		db: db,
+	}
+}
+
+// Get returns email settings of user
+func (r *EmailSettingsRepo) Get(userID int) (*EmailSettings, bool) {
+	settings := &EmailSettings{}
+	err := r.db.Model(settings).Where("es.user_id = ?", userID).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return settings, true
+}
+
+// Save creates or replaces email settings of user
+func (r *EmailSettingsRepo) Save(s *EmailSettings) error {
+	_, err := r.db.Model(s).
+		OnConflict("(user_id) DO UPDATE").
+		Set("locale = EXCLUDED.locale, digest = EXCLUDED.digest, events = EXCLUDED.events").
+		Insert()
+
+	return err
+}
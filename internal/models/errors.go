@@ -12,3 +12,16 @@ var ErrDonationForbidden = errors.New("donation to project is not allowed")
 
 // ErrUserNotFound user not found
 var ErrUserNotFound = errors.New("user not found")
+
+// ErrTierSoldOut all rewards of tier are taken
+var ErrTierSoldOut = errors.New("all rewards of tier are taken")
+
+// OverfundingError payment exceeds amount remaining to project goal
+type OverfundingError struct {
+	Remaining int64
+	Currency  string
+}
+
+func (e *OverfundingError) Error() string {
+	return "payment exceeds remaining amount"
+}
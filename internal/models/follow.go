@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_follow_mock.go -package=mocks FollowImpl
+
+const (
+	// FollowProject user watches progress of project
+	FollowProject = "project"
+	// FollowCategory user follows new projects of category
+	FollowCategory = "category"
+	// FollowUser user follows new projects of owner
+	FollowUser = "user"
+)
+
+// FollowImpl ...
+type FollowImpl interface {
+	GetAllByUser(userID int) ([]Follow, error)
+	GetFollowerIDs(activityID int) ([]int, error)
+	Create(f *Follow) error
+	Delete(f *Follow) error
+}
+
+// Follow subscription of user to project, category or other user
+type Follow struct {
+	tableName  struct{}  `pg:"follows,alias:f"` //nolint
+	UserID     int       `pg:",pk" json:"-"`
+	TargetType string    `pg:",pk" json:"type"`
+	TargetID   int       `pg:",pk" json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// FollowRepo ...
+type FollowRepo struct {
+	db *pg.DB
+}
+
+// NewFollowModel ...
+func NewFollowModel(db *pg.DB) *FollowRepo {
+	return &FollowRepo{
+		db: db,
+	}
+}
+
+// GetAllByUser returns follows of user, newest first
+func (r *FollowRepo) GetAllByUser(userID int) ([]Follow, error) {
+	follows := make([]Follow, 0)
+	err := r.db.Model(&follows).
+		Where("f.user_id = ?", userID).
+		Order("f.created_at DESC").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return follows, nil
+}
+
+// GetFollowerIDs returns users who follow anything activity is about
+func (r *FollowRepo) GetFollowerIDs(activityID int) ([]int, error) {
+	var ids []int
+	err := r.db.Model((*Follow)(nil)).
+		ColumnExpr("DISTINCT f.user_id").
+		Join("JOIN activities AS ac ON ac.id = ?", activityID).
+		Where(followedActivity).
+		Select(&ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Create follow, existing one is kept
+func (r *FollowRepo) Create(f *Follow) error {
+	_, err := r.db.Model(f).OnConflict("DO NOTHING").Insert()
+
+	return err
+}
+
+// Delete follow
+func (r *FollowRepo) Delete(f *Follow) error {
+	_, err := r.db.Model(f).WherePK().Delete()
+
+	return err
+}
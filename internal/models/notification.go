@@ -0,0 +1,115 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_notification_mock.go -package=mocks NotificationImpl
+
+// NotificationImpl ...
+type NotificationImpl interface {
+	GetAllByUser(userID, cursor, limit int, onlyUnread bool) ([]Notification, error)
+	CountUnread(userID int) (int, error)
+	Create(ns []Notification) error
+	MarkRead(userID int, ids []int) error
+	MarkAllRead(userID int) error
+	DeleteOlderThan(t time.Time) (int, error)
+}
+
+// Notification event delivered to user
+type Notification struct {
+	tableName struct{}               `pg:"notifications,alias:n"` //nolint
+	ID        int                    `json:"id"`
+	UserID    int                    `json:"-"`
+	Type      string                 `json:"type"`
+	ProjectID int                    `json:"project"`
+	Data      map[string]interface{} `json:"data"`
+	Read      bool                   `pg:",use_zero" json:"read"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// NotificationRepo ...
+type NotificationRepo struct {
+	db *pg.DB
+}
+
+// NewNotificationModel ...
+func NewNotificationModel(db *pg.DB) *NotificationRepo {
+	return &NotificationRepo{
+		db: db,
+	}
+}
+
+// GetAllByUser returns notifications of user, newest first.
+// Only notifications with id less than cursor are returned if cursor is set.
+func (r *NotificationRepo) GetAllByUser(userID, cursor, limit int, onlyUnread bool) ([]Notification, error) {
+	notifications := make([]Notification, 0)
+	q := r.db.Model(&notifications).Where("n.user_id = ?", userID)
+	if cursor > 0 {
+		q = q.Where("n.id < ?", cursor)
+	}
+	if onlyUnread {
+		q = q.Where("n.read = ?", false)
+	}
+	err := q.Order("n.id DESC").Limit(limit).Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// CountUnread returns count of unread notifications of user
+func (r *NotificationRepo) CountUnread(userID int) (int, error) {
+	return r.db.Model((*Notification)(nil)).
+		Where("n.user_id = ?", userID).
+		Where("n.read = ?", false).
+		Count()
+}
+
+// Create new notifications
+func (r *NotificationRepo) Create(ns []Notification) error {
+	if len(ns) == 0 {
+		return nil
+	}
+	_, err := r.db.Model(&ns).Insert()
+
+	return err
+}
+
+// MarkRead marks given notifications of user as read
+func (r *NotificationRepo) MarkRead(userID int, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.Model((*Notification)(nil)).
+		Set("read = TRUE").
+		Where("n.user_id = ?", userID).
+		Where("n.id IN (?)", pg.In(ids)).
+		Update()
+
+	return err
+}
+
+// MarkAllRead marks all notifications of user as read
+func (r *NotificationRepo) MarkAllRead(userID int) error {
+	_, err := r.db.Model((*Notification)(nil)).
+		Set("read = TRUE").
+		Where("n.user_id = ?", userID).
+		Where("n.read = ?", false).
+		Update()
+
+	return err
+}
+
+// DeleteOlderThan deletes notifications created before given time
+func (r *NotificationRepo) DeleteOlderThan(t time.Time) (int, error) {
+	res, err := r.db.Model((*Notification)(nil)).Where("n.created_at < ?", t).Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected(), nil
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_pending_email_mock.go -package=mocks PendingEmailImpl
+
+// PendingEmailImpl ...
+type PendingEmailImpl interface {
+	GetAll() ([]PendingEmail, error)
+	Create(e *PendingEmail) error
+	Delete(ids []int) error
+}
+
+// PendingEmail event waiting for daily digest
+type PendingEmail struct {
+	tableName struct{} `pg:"pending_emails,alias:pe"` //nolint
+	ID        int
+	UserID    int
+	Type      string
+	ProjectID int
+	Data      map[string]interface{}
+	CreatedAt time.Time
+}
+
+// PendingEmailRepo ...
+type PendingEmailRepo struct {
+	db *pg.DB
+}
+
+// NewPendingEmailModel ...
+func NewPendingEmailModel(db *pg.DB) *PendingEmailRepo {
+	return &PendingEmailRepo{
+		db: db,
+	}
+}
+
+// GetAll returns all pending emails grouped by user, oldest first
+func (r *PendingEmailRepo) GetAll() ([]PendingEmail, error) {
+	emails := make([]PendingEmail, 0)
+	err := r.db.Model(&emails).Order("pe.user_id", "pe.id").Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+// Create new pending email
+func (r *PendingEmailRepo) Create(e *PendingEmail) error {
+	_, err := r.db.Model(e).Insert()
+
+	return err
+}
+
+// Delete sent pending emails
+func (r *PendingEmailRepo) Delete(ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	_, err := r.db.Model((*PendingEmail)(nil)).Where("pe.id IN (?)", pg.In(ids)).Delete()
+
+	return err
+}
@@ -1,10 +1,13 @@
 package models
 
 import (
+	"strings"
 	"time"
 
 	"github.com/go-pg/pg/v10"
 	"github.com/go-pg/pg/v10/orm"
+
+	"github.com/FreakyGranny/launchpad-api/internal/money"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../mocks/model_project_mock.go -package=mocks .
@@ -20,51 +23,103 @@ const (
 	StatusHarvest string = "harvest"
 	// StatusSearch search project
 	StatusSearch string = "search"
-
-	userProjectCountLimit = 20
+	// StatusCancelled project cancelled by owner
+	StatusCancelled string = "cancelled"
+
+	// ReviewPending project is waiting for moderator approval
+	ReviewPending string = "pending"
+	// ReviewApproved project is approved by moderator
+	ReviewApproved string = "approved"
+	// ReviewRejected project is rejected by moderator
+	ReviewRejected string = "rejected"
+
+	// searchQuery full-text query matching words in both russian and english forms
+	searchQuery = "(plainto_tsquery('russian', ?0) || plainto_tsquery('english', ?0))"
+	// snippetSource text of project for snippet, HTML special characters are escaped so <mark> is the only markup
+	snippetSource = `replace(replace(replace(replace(replace(concat_ws(' ', p.title, p.sub_title, p.description),
+		'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+	// snippetOptions ts_headline options, matched words are wrapped with <mark>
+	snippetOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2"
 )
 
 // ProjectImpl ...
 type ProjectImpl interface {
 	Get(id int) (*Project, bool)
-	GetProjectsWithPagination(category, projectType, page, pageSize int, onlyOpen bool) (ProjectPaginatorImpl, error)
-	GetUserProjects(user int, contributed, owned bool) (*[]Project, error)
+	GetProjectsWithPagination(filter *ProjectFilter, page, pageSize int) (ProjectPaginatorImpl, error)
+	GetProjectsAfter(filter *ProjectFilter, cursor *Cursor, limit int) (*[]Project, *Cursor, error)
+	GetSnippets(ids []int, search string) (map[int]string, error)
+	GetUserProjects(user, viewer int, contributed, owned bool, cursor, limit int) (*[]Project, error)
 	GetActiveProjects() (*[]Project, error)
+	GetScheduledProjects(now time.Time) (*[]Project, error)
+	GetPendingReview() (*[]Project, error)
 	Create(p *Project) error
+	Clone(sourceID int, p *Project) error
 	Update(p *Project) error
 	DropEventDate(p *Project) error
+	UpdatePledgeRules(p *Project) error
+	UpdatePrivacy(p *Project) error
 	Delete(p *Project) error
 	UpdateTotalByPayment(p *Project) error
 	UpdateTotalByCount(p *Project) error
-	Lock(p *Project) error
+	Lock(p *Project, lockedAt time.Time) error
 	Close(p *Project) error
+	Fail(p *Project) error
+	Escalate(p *Project, escalatedAt time.Time) error
+	Cancel(p *Project, audit *ProjectAudit) error
+	Extend(p *Project, audit *ProjectAudit) error
+	Edit(p *Project, audit []ProjectAudit) error
+	Reopen(p *Project, audit *ProjectAudit) error
+	SchedulePublication(p *Project) error
+	UpdateReview(p *Project) error
+	Publish(p *Project) error
 	CheckForPaid(projectID int) (bool, error)
 	SetEqualDonation(p *Project) error
+	ResplitDonations(p *Project) ([]Adjustment, error)
 }
 
 // Project model
 type Project struct {
-	tableName     struct{} `pg:"projects,alias:p"` //nolint
-	ID            int
-	Title         string
-	SubTitle      string
-	ReleaseDate   time.Time
-	EventDate     time.Time
-	GoalPeople    int `pg:",notnull"`
-	GoalAmount    int `pg:",notnull"`
-	Total         int
-	Description   string
-	ImageLink     string
-	Instructions  string
-	Locked        bool `pg:",notnull"`
-	Published     bool `pg:",notnull"`
-	Closed        bool `pg:",notnull"`
-	Owner         User
-	OwnerID       int
-	Category      Category
-	CategoryID    int
-	ProjectType   ProjectType
-	ProjectTypeID int
+	tableName      struct{} `pg:"projects,alias:p"` //nolint
+	ID             int
+	Title          string
+	SubTitle       string
+	ReleaseDate    time.Time
+	EventDate      time.Time
+	GoalPeople     int   `pg:",notnull"`
+	GoalAmount     int64 `pg:",notnull"`
+	Currency       string
+	Total          int64
+	Description    string
+	ImageLink      string
+	Instructions   string
+	Locked         bool `pg:",notnull"`
+	Published      bool `pg:",notnull"`
+	Closed         bool `pg:",notnull"`
+	Owner          User
+	OwnerID        int
+	Category       Category
+	CategoryID     int
+	ProjectType    ProjectType
+	ProjectTypeID  int
+	PrivateAmounts bool `pg:",use_zero"`
+	LockedAt       time.Time
+	EscalatedAt    time.Time
+	CancelledAt    time.Time
+	CancelReason   string `pg:",use_zero"`
+	Extensions     int    `pg:",use_zero"`
+	PublishAt      time.Time
+	ReviewStatus   string `pg:",use_zero"`
+	ReviewComment  string `pg:",use_zero"`
+	ReviewedAt     time.Time
+	PledgeRules
+}
+
+// PledgeRules payment restrictions of money project
+type PledgeRules struct {
+	MinPledge       int64 `pg:",use_zero" json:"min"`
+	MaxPledge       int64 `pg:",use_zero" json:"max"`
+	PledgeStep      int64 `pg:",use_zero" json:"step"`
+	DenyOverfunding bool  `pg:",use_zero" json:"deny_overfunding"`
 }
 
 // Status of project
@@ -73,6 +128,9 @@ func (p *Project) Status() string {
 		return StatusDraft
 	}
 	if p.Closed {
+		if !p.CancelledAt.IsZero() {
+			return StatusCancelled
+		}
 		if p.Locked {
 			return StatusSuccess
 		}
@@ -85,6 +143,11 @@ func (p *Project) Status() string {
 	return StatusSearch
 }
 
+// Goal returns goal amount in project currency.
+func (p *Project) Goal() money.Money {
+	return money.New(p.GoalAmount, p.Currency)
+}
+
 // ProjectPaginatorImpl ...
 type ProjectPaginatorImpl interface {
 	NextPage() (int, bool)
@@ -93,11 +156,13 @@ type ProjectPaginatorImpl interface {
 
 // ProjectPaginator ...
 type ProjectPaginator struct {
-	EntryCount int
-	PageSize   int
-	Query      *orm.Query
-	Page       int
-	Values     *[]Project
+	EntryCount  int
+	PageSize    int
+	Query       *orm.Query
+	Page        int
+	Values      *[]Project
+	Order       string
+	OrderParams []interface{}
 }
 
 // NextPage ...
@@ -115,7 +180,11 @@ func (pp *ProjectPaginator) Retrieve() (*[]Project, error) {
 	if pp.Page > 1 {
 		ofst = pp.PageSize * (pp.Page - 1)
 	}
-	err := pp.Query.Offset(ofst).Limit(pp.PageSize).Order("id DESC").Select()
+	order := pp.Order
+	if order == "" {
+		order = "p.id DESC"
+	}
+	err := pp.Query.Offset(ofst).Limit(pp.PageSize).OrderExpr(order, pp.OrderParams...).Select()
 	if err != nil {
 		return nil, err
 	}
@@ -158,44 +227,178 @@ func (r *ProjectRepo) GetActiveProjects() (*[]Project, error) {
 	return projects, err
 }
 
-// GetProjectsWithPagination ...
-func (r *ProjectRepo) GetProjectsWithPagination(category, projectType, page, pageSize int, onlyOpen bool) (ProjectPaginatorImpl, error) {
+// GetScheduledProjects returns drafts which are due to publication and aren't held by review
+func (r *ProjectRepo) GetScheduledProjects(now time.Time) (*[]Project, error) {
+	projects := &[]Project{}
+	err := r.db.Model(projects).
+		Relation("Category").
+		Relation("ProjectType").
+		Where("p.published = ?", false).
+		Where("p.publish_at <= ?", now).
+		Where("p.review_status NOT IN (?, ?)", ReviewPending, ReviewRejected).
+		Order("p.publish_at").
+		Select()
+
+	return projects, err
+}
+
+// GetPendingReview returns drafts waiting for moderator approval, oldest first
+func (r *ProjectRepo) GetPendingReview() (*[]Project, error) {
+	projects := &[]Project{}
+	err := r.db.Model(projects).
+		Relation("Owner").
+		Relation("Category").
+		Relation("ProjectType").
+		Where("p.published = ?", false).
+		Where("p.review_status = ?", ReviewPending).
+		Order("p.id").
+		Select()
+
+	return projects, err
+}
+
+// GetProjectsWithPagination returns paginator of published projects matching filter
+func (r *ProjectRepo) GetProjectsWithPagination(filter *ProjectFilter, page, pageSize int) (ProjectPaginatorImpl, error) {
 	projects := []Project{}
 	q := r.db.Model(&projects).Relation("Category").Relation("ProjectType").Where("p.published = ?", true)
-	if category != 0 {
-		q = q.Where("category_id = ?", category)
-	}
-	if projectType != 0 {
-		q = q.Where("project_type_id = ?", projectType)
-	}
-	if onlyOpen {
-		q = q.Where("closed = ?", false)
-	}
+	q = filter.apply(q)
 	x, err := q.Count()
 	if err != nil {
 		return nil, err
 	}
+	order, params := filter.order()
+
 	return &ProjectPaginator{
-		EntryCount: x,
-		Query:      q,
-		Values:     &projects,
-		Page:       page,
-		PageSize:   pageSize,
+		EntryCount:  x,
+		Query:       q,
+		Values:      &projects,
+		Page:        page,
+		PageSize:    pageSize,
+		Order:       order,
+		OrderParams: params,
 	}, nil
 }
 
-// GetUserProjects ...
-func (r *ProjectRepo) GetUserProjects(user int, contributed, owned bool) (*[]Project, error) {
+// GetProjectsAfter returns up to limit published projects matching filter which follow cursor
+// and cursor of the next page, if there are more projects
+func (r *ProjectRepo) GetProjectsAfter(filter *ProjectFilter, cursor *Cursor, limit int) (*[]Project, *Cursor, error) {
+	projects := []Project{}
+	q := r.db.Model(&projects).Relation("Category").Relation("ProjectType").Where("p.published = ?", true)
+	q = filter.apply(q)
+	if cursor != nil {
+		var err error
+		q, err = filter.after(q, cursor)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	order, params := filter.order()
+	err := q.OrderExpr(order, params...).Limit(limit + 1).Select()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(projects) <= limit {
+		return &projects, nil, nil
+	}
+	projects = projects[:limit]
+	next, err := r.cursorAt(filter, projects[limit-1].ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &projects, next, nil
+}
+
+// cursorAt returns cursor pointing to project in list ordered by filter
+func (r *ProjectRepo) cursorAt(filter *ProjectFilter, id int) (*Cursor, error) {
+	cursor := &Cursor{Sort: filter.SortKey(), ID: id}
+	exprs, params := filter.cursorColumns()
+	if len(exprs) == 0 {
+		return cursor, nil
+	}
+	cursor.Values = make([]string, len(exprs))
+	values := make([]interface{}, len(exprs))
+	for i := range cursor.Values {
+		values[i] = &cursor.Values[i]
+	}
+	err := r.db.Model((*Project)(nil)).
+		ColumnExpr(strings.Join(exprs, ", "), params...).
+		Join(`LEFT JOIN project_types AS "project_type" ON "project_type".id = p.project_type_id`).
+		Where("p.id = ?", id).
+		Select(values...)
+	if err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
+
+// projectSnippet highlighted fragment of project text
+type projectSnippet struct {
+	ID      int
+	Snippet string
+}
+
+// GetSnippets returns fragments of title and description with highlighted search words by project id,
+// text of project is HTML-escaped, so snippet is safe to render as HTML
+func (r *ProjectRepo) GetSnippets(ids []int, search string) (map[int]string, error) {
+	result := make(map[int]string, len(ids))
+	if len(ids) == 0 || search == "" {
+		return result, nil
+	}
+	snippets := make([]projectSnippet, 0, len(ids))
+	_, err := r.db.Query(&snippets,
+		`SELECT p.id, ts_headline('russian', `+snippetSource+`, `+searchQuery+`, ?1) AS snippet
+		FROM projects p WHERE p.id IN (?2)`,
+		search, snippetOptions, pg.In(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snippets {
+		result[s.ID] = s.Snippet
+	}
+
+	return result, nil
+}
+
+// contributedQuery selects projects user donated to. Anonymous donations are seen
+// only by user, owner of project and collaborators allowed to see payments.
+func contributedQuery(q *orm.Query, user, viewer int) *orm.Query {
+	q = q.ColumnExpr("d.project_id").Where("d.user_id = ?", user)
+	if viewer == user {
+		return q
+	}
+
+	return q.WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+		q = q.WhereOr("d.anonymous = ?", false).
+			WhereOr("d.project_id IN (SELECT id FROM projects WHERE owner_id = ?)", viewer).
+			WhereOr(
+				"d.project_id IN (SELECT project_id FROM project_collaborators WHERE user_id = ? AND role IN (?) AND accepted_at IS NOT NULL)",
+				viewer, pg.In([]string{RoleCoOwner, RoleTreasurer}),
+			)
+		return q, nil
+	})
+}
+
+// GetUserProjects returns projects owned by user or, if contributed is set, projects user donated to.
+// Anonymous donations are visible only to the donor and to owners of projects.
+// Projects are ordered from newest, only projects with id less than cursor are returned.
+func (r *ProjectRepo) GetUserProjects(user, viewer int, contributed, owned bool, cursor, limit int) (*[]Project, error) {
 	projects := []Project{}
-	q := r.db.Model(&projects).Relation("Category").Relation("ProjectType").Where("owner_id = ?", user)
+	q := r.db.Model(&projects).Relation("Category").Relation("ProjectType")
 	if !owned {
 		q = q.Where("p.published = ?", true)
 	}
 	if contributed {
-		cProjects := r.db.Model((*Donation)(nil)).ColumnExpr("project_id").Where("user_id = ?", user)
-		q = q.Where("p.id IN (?)", cProjects)
+		q = q.Where("p.id IN (?)", contributedQuery(r.db.Model((*Donation)(nil)), user, viewer))
+	} else {
+		q = q.Where("p.owner_id = ?", user)
+	}
+	if cursor > 0 {
+		q = q.Where("p.id < ?", cursor)
 	}
-	err := q.Limit(userProjectCountLimit).Order("id DESC").Select()
+	err := q.Limit(limit).Order("p.id DESC").Select()
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +420,30 @@ func (r *ProjectRepo) Create(p *Project) error {
 	return err
 }
 
+// Clone creates project with tiers and tags of source project
+func (r *ProjectRepo) Clone(sourceID int, p *Project) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model(p).Insert()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(
+			`INSERT INTO tiers (project_id, amount, title, description, quantity)
+			SELECT ?, amount, title, description, quantity FROM tiers WHERE project_id = ? ORDER BY id`,
+			p.ID, sourceID,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(
+			"INSERT INTO project_tags (project_id, tag_id) SELECT ?, tag_id FROM project_tags WHERE project_id = ?",
+			p.ID, sourceID,
+		)
+
+		return err
+	})
+}
+
 // Update project
 func (r *ProjectRepo) Update(p *Project) error {
 	_, err := r.db.Model(p).WherePK().UpdateNotZero()
@@ -224,6 +451,13 @@ func (r *ProjectRepo) Update(p *Project) error {
 	return err
 }
 
+// UpdatePrivacy saves privacy settings of project
+func (r *ProjectRepo) UpdatePrivacy(p *Project) error {
+	_, err := r.db.Model(p).Column("private_amounts").WherePK().Update()
+
+	return err
+}
+
 // DropEventDate set event_date to null value
 func (r *ProjectRepo) DropEventDate(p *Project) error {
 	_, err := r.db.Model(p).Set("event_date = null").WherePK().Update()
@@ -231,16 +465,32 @@ func (r *ProjectRepo) DropEventDate(p *Project) error {
 	return err
 }
 
-// Delete project by id
-func (r *ProjectRepo) Delete(p *Project) error {
-	_, err := r.db.Model(p).WherePK().Delete()
+// UpdatePledgeRules saves pledge rules including zero values
+func (r *ProjectRepo) UpdatePledgeRules(p *Project) error {
+	_, err := r.db.Model(p).
+		Column("min_pledge", "max_pledge", "pledge_step", "deny_overfunding").
+		WherePK().
+		Update()
 
 	return err
 }
 
+// Delete project by id with its tags
+func (r *ProjectRepo) Delete(p *Project) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model((*ProjectTag)(nil)).Where("pt.project_id = ?", p.ID).Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(p).WherePK().Delete()
+
+		return err
+	})
+}
+
 // donationSum returns sum of donations payment for given project
-func (r *ProjectRepo) donationSum(id int) (int, error) {
-	sum := 0
+func (r *ProjectRepo) donationSum(id int) (int64, error) {
+	var sum int64
 	err := r.db.Model((*Donation)(nil)).
 		ColumnExpr("sum(d.payment)").
 		Where("d.project_id = ?", id).
@@ -257,7 +507,7 @@ func (r *ProjectRepo) donationCount(id int) (int, error) {
 	return r.db.Model((*Donation)(nil)).Where("d.project_id = ?", id).Count()
 }
 
-func (r *ProjectRepo) saveTotal(p *Project, value int) error {
+func (r *ProjectRepo) saveTotal(p *Project, value int64) error {
 	p.Total = value
 	_, err := r.db.Model(p).Set("total = ?", p.Total).WherePK().Update()
 
@@ -282,15 +532,17 @@ func (r *ProjectRepo) UpdateTotalByCount(p *Project) error {
 		return err
 	}
 
-	return r.saveTotal(p, count)
+	return r.saveTotal(p, int64(count))
 }
 
 // Lock project with associated donations
-func (r *ProjectRepo) Lock(p *Project) error {
-	_, err := r.db.Model(p).Set("locked = TRUE").WherePK().Update()
+func (r *ProjectRepo) Lock(p *Project, lockedAt time.Time) error {
+	_, err := r.db.Model(p).Set("locked = TRUE, locked_at = ?", lockedAt).WherePK().Update()
 	if err != nil {
 		return err
 	}
+	p.Locked = true
+	p.LockedAt = lockedAt
 	_, err = r.db.Model((*Donation)(nil)).
 		Set("locked = TRUE").
 		Where("d.project_id = ?", p.ID).
@@ -305,6 +557,7 @@ func (r *ProjectRepo) Close(p *Project) error {
 	if err != nil {
 		return err
 	}
+	p.Closed = true
 	if !p.Locked {
 		_, err = r.db.Model((*Donation)(nil)).
 			Set("locked = TRUE").
@@ -315,6 +568,135 @@ func (r *ProjectRepo) Close(p *Project) error {
 	return err
 }
 
+// Fail closes locked project as failed, donations stay locked
+func (r *ProjectRepo) Fail(p *Project) error {
+	_, err := r.db.Model(p).Set("locked = FALSE, closed = TRUE").WherePK().Update()
+	if err != nil {
+		return err
+	}
+	p.Locked = false
+	p.Closed = true
+
+	return nil
+}
+
+// Escalate marks project as escalated to administrators
+func (r *ProjectRepo) Escalate(p *Project, escalatedAt time.Time) error {
+	_, err := r.db.Model(p).Set("escalated_at = ?", escalatedAt).WherePK().Update()
+	if err != nil {
+		return err
+	}
+	p.EscalatedAt = escalatedAt
+
+	return nil
+}
+
+// Cancel closes project with reason and releases unpaid donations, paid donations are kept for refunds
+func (r *ProjectRepo) Cancel(p *Project, audit *ProjectAudit) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model(p).
+			Set("closed = TRUE, locked = FALSE, cancelled_at = ?, cancel_reason = ?", p.CancelledAt, p.CancelReason).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+		p.Closed = true
+		p.Locked = false
+		_, err = tx.Model((*Donation)(nil)).
+			Where("d.project_id = ?", p.ID).
+			Where("NOT d.paid").
+			Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(audit).Insert()
+
+		return err
+	})
+}
+
+// Extend saves new release date of project
+func (r *ProjectRepo) Extend(p *Project, audit *ProjectAudit) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model(p).Column("release_date", "extensions").WherePK().Update()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(audit).Insert()
+
+		return err
+	})
+}
+
+// Edit saves descriptive fields of published project
+func (r *ProjectRepo) Edit(p *Project, audit []ProjectAudit) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model(p).
+			Column("title", "sub_title", "description", "image_link", "instructions").
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(&audit).Insert()
+
+		return err
+	})
+}
+
+// Reopen returns failed project to search stage with new release date, donations are unlocked
+func (r *ProjectRepo) Reopen(p *Project, audit *ProjectAudit) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model(p).
+			Set("closed = FALSE, locked = FALSE, locked_at = NULL, escalated_at = NULL, release_date = ?", p.ReleaseDate).
+			WherePK().
+			Update()
+		if err != nil {
+			return err
+		}
+		p.Closed = false
+		p.Locked = false
+		p.LockedAt = time.Time{}
+		p.EscalatedAt = time.Time{}
+		_, err = tx.Model((*Donation)(nil)).
+			Set("locked = FALSE").
+			Where("d.project_id = ?", p.ID).
+			Update()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(audit).Insert()
+
+		return err
+	})
+}
+
+// SchedulePublication saves publication time of draft, empty time cancels schedule
+func (r *ProjectRepo) SchedulePublication(p *Project) error {
+	_, err := r.db.Model(p).Column("publish_at").WherePK().Update()
+
+	return err
+}
+
+// UpdateReview saves review state of project
+func (r *ProjectRepo) UpdateReview(p *Project) error {
+	_, err := r.db.Model(p).Column("review_status", "review_comment", "reviewed_at").WherePK().Update()
+
+	return err
+}
+
+// Publish makes project public
+func (r *ProjectRepo) Publish(p *Project) error {
+	_, err := r.db.Model(p).Set("published = TRUE").WherePK().Update()
+	if err != nil {
+		return err
+	}
+	p.Published = true
+
+	return nil
+}
+
 // CheckForPaid checks if all donations are paid
 func (r *ProjectRepo) CheckForPaid(projectID int) (bool, error) {
 	allPaid := false
@@ -329,12 +711,124 @@ func (r *ProjectRepo) CheckForPaid(projectID int) (bool, error) {
 	return allPaid, nil
 }
 
-// SetEqualDonation checks if all donations are paid
+// equalShares splits goal amount between n participants.
+// Remainder goes to the earliest donations one minor unit each,
+// so shares of all donations sum up to goal exactly.
+func equalShares(p *Project, n int) ([]int64, error) {
+	parts, err := p.Goal().Split(n)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]int64, n)
+	for i := range parts {
+		shares[i] = parts[i].Amount
+	}
+
+	return shares, nil
+}
+
+// shareAdjustments sets equal shares to donations ordered by id.
+// Returns adjustments for donations with changed share.
+func shareAdjustments(p *Project, donations []Donation) ([]Adjustment, error) {
+	shares, err := equalShares(p, len(donations))
+	if err != nil {
+		return nil, err
+	}
+	adjustments := make([]Adjustment, 0)
+	for i := range donations {
+		old := donations[i].Payment
+		if !donations[i].SetShare(shares[i]) {
+			continue
+		}
+		adjustments = append(adjustments, Adjustment{
+			DonationID: donations[i].ID,
+			ProjectID:  p.ID,
+			UserID:     donations[i].UserID,
+			OldPayment: old,
+			NewPayment: donations[i].Payment,
+			Credit:     donations[i].Credit,
+			CreatedAt:  time.Now(),
+		})
+	}
+
+	return adjustments, nil
+}
+
+// SetEqualDonation splits goal amount between participants and sets shares to project donations.
 func (r *ProjectRepo) SetEqualDonation(p *Project) error {
-	_, err := r.db.Model((*Donation)(nil)).
-		Set("payment = ?", p.GoalAmount/p.GoalPeople).
+	donations := make([]Donation, 0)
+	err := r.db.Model(&donations).
+		Column("d.id").
 		Where("d.project_id = ?", p.ID).
-		Update()
+		Order("d.id ASC").
+		Select()
+	if err != nil {
+		return err
+	}
+	if len(donations) == 0 {
+		return nil
+	}
+	shares, err := equalShares(p, len(donations))
+	if err != nil {
+		return err
+	}
 
-	return err
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		for i := range donations {
+			_, err := tx.Model(&donations[i]).Set("payment = ?", shares[i]).WherePK().Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ResplitDonations splits goal amount between donations of locked project.
+// Returns adjustments for donations with changed share.
+func (r *ProjectRepo) ResplitDonations(p *Project) ([]Adjustment, error) {
+	var adjustments []Adjustment
+	err := r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		donations := make([]Donation, 0)
+		err := tx.Model(&donations).
+			Where("d.project_id = ?", p.ID).
+			Order("d.id ASC").
+			For("UPDATE").
+			Select()
+		if err != nil {
+			return err
+		}
+		if len(donations) == 0 {
+			return nil
+		}
+		adjustments, err = shareAdjustments(p, donations)
+		if err != nil {
+			return err
+		}
+		byID := make(map[int]*Donation, len(donations))
+		for i := range donations {
+			byID[donations[i].ID] = &donations[i]
+		}
+		for i := range adjustments {
+			_, err = tx.Model(byID[adjustments[i].DonationID]).
+				Column("payment", "paid", "credit", "reminder_count").
+				WherePK().
+				Update()
+			if err != nil {
+				return err
+			}
+			_, err = tx.Model(&adjustments[i]).Insert()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return adjustments, nil
 }
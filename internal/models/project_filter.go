@@ -0,0 +1,284 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
+)
+
+const (
+	// SortID order by creation
+	SortID = "id"
+	// SortPercent order by completion percent
+	SortPercent = "percent"
+	// SortTotal order by collected total
+	SortTotal = "total"
+	// SortReleaseDate order by release date
+	SortReleaseDate = "release_date"
+	// SortPopularity order by number of participants
+	SortPopularity = "popularity"
+	// SortRelevance order by search rank, requires search
+	SortRelevance = "relevance"
+)
+
+// ListedStatuses statuses of published projects
+var ListedStatuses = []string{StatusSearch, StatusHarvest, StatusSuccess, StatusFail, StatusCancelled}
+
+// sortColumn expression of sort field and sql type of its value
+type sortColumn struct {
+	expr string
+	cast string
+}
+
+// timestamptzLayouts text representations of timestamptz in ISO date style
+var timestamptzLayouts = []string{"2006-01-02 15:04:05.999999999-07", "2006-01-02 15:04:05.999999999-07:00"}
+
+// valid checks cursor value could be cast to sql type of column
+func (c sortColumn) valid(value string) bool {
+	switch c.cast {
+	case "bigint":
+		_, err := strconv.ParseInt(value, 10, 64)
+		return err == nil
+	case "float8":
+		// hexadecimal form is accepted by go only
+		_, err := strconv.ParseFloat(value, 64)
+		return err == nil && !strings.ContainsAny(value, "xX")
+	case "timestamptz":
+		for _, layout := range timestamptzLayouts {
+			if _, err := time.Parse(layout, value); err == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// sortColumns order expressions by sort field, relevance expression takes search query as parameter
+var sortColumns = map[string]sortColumn{
+	SortID:          {expr: "p.id", cast: "bigint"},
+	SortTotal:       {expr: "p.total", cast: "bigint"},
+	SortReleaseDate: {expr: "p.release_date", cast: "timestamptz"},
+	SortPercent: {
+		expr: `COALESCE(CASE WHEN "project_type"."goal_by_amount"
+			THEN p.total::float8 / NULLIF(p.goal_amount, 0)
+			ELSE p.total::float8 / NULLIF(p.goal_people, 0) END, 0)`,
+		cast: "float8",
+	},
+	SortPopularity: {expr: "(SELECT count(*) FROM donations d WHERE d.project_id = p.id)", cast: "bigint"},
+	SortRelevance:  {expr: "ts_rank(p.search_vector, ?)::float8", cast: "float8"},
+}
+
+// tsQuery full-text query matching words in both russian and english forms
+func tsQuery(search string) *orm.SafeQueryAppender {
+	return pg.SafeQuery(searchQuery, search)
+}
+
+// statusConditions conditions of status derived from project flags
+var statusConditions = map[string]string{
+	StatusSearch:    "NOT p.locked AND NOT p.closed",
+	StatusHarvest:   "p.locked AND NOT p.closed",
+	StatusSuccess:   "p.locked AND p.closed",
+	StatusFail:      "NOT p.locked AND p.closed AND p.cancelled_at IS NULL",
+	StatusCancelled: "p.closed AND p.cancelled_at IS NOT NULL",
+}
+
+// IsSortField checks field could be used for ordering
+func IsSortField(field string) bool {
+	_, ok := sortColumns[field]
+
+	return ok
+}
+
+// ProjectSort ordering of project list
+type ProjectSort struct {
+	Field string
+	Desc  bool
+}
+
+// ProjectFilter conditions and ordering of project list, empty fields are ignored.
+// Tags are aliases, project matches if it has any of them. Upper bounds of date ranges are exclusive.
+type ProjectFilter struct {
+	Categories   []int
+	ProjectTypes []int
+	Statuses     []string
+	Owners       []int
+	Tags         []string
+	ReleaseFrom  time.Time
+	ReleaseTo    time.Time
+	EventFrom    time.Time
+	EventTo      time.Time
+	OnlyOpen     bool
+	Search       string
+	Sort         []ProjectSort
+}
+
+// apply adds filter conditions to query
+func (f *ProjectFilter) apply(q *orm.Query) *orm.Query {
+	if len(f.Categories) > 0 {
+		q = q.Where("p.category_id IN (?)", pg.In(f.Categories))
+	}
+	if len(f.ProjectTypes) > 0 {
+		q = q.Where("p.project_type_id IN (?)", pg.In(f.ProjectTypes))
+	}
+	if len(f.Owners) > 0 {
+		q = q.Where("p.owner_id IN (?)", pg.In(f.Owners))
+	}
+	if len(f.Tags) > 0 {
+		q = q.Where(`EXISTS (SELECT 1 FROM project_tags pt JOIN tags t ON t.id = pt.tag_id
+			WHERE pt.project_id = p.id AND t.alias IN (?))`, pg.In(f.Tags))
+	}
+	if len(f.Statuses) > 0 {
+		q = q.WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			for _, status := range f.Statuses {
+				if condition, ok := statusConditions[status]; ok {
+					q = q.WhereOr(condition)
+				}
+			}
+			return q, nil
+		})
+	}
+	if f.OnlyOpen {
+		q = q.Where("p.closed = ?", false)
+	}
+	if !f.ReleaseFrom.IsZero() {
+		q = q.Where("p.release_date >= ?", f.ReleaseFrom)
+	}
+	if !f.ReleaseTo.IsZero() {
+		q = q.Where("p.release_date < ?", f.ReleaseTo)
+	}
+	if !f.EventFrom.IsZero() {
+		q = q.Where("p.event_date >= ?", f.EventFrom)
+	}
+	if !f.EventTo.IsZero() {
+		q = q.Where("p.event_date < ?", f.EventTo)
+	}
+	if f.Search != "" {
+		q = q.Where("p.search_vector @@ ?", tsQuery(f.Search))
+	}
+
+	return q
+}
+
+// orderKey sort expression of project list
+type orderKey struct {
+	sortColumn
+	params []interface{}
+	desc   bool
+}
+
+// orderKeys returns effective ordering of list.
+// The last key is always id, so newest projects go first on equal values.
+func (f *ProjectFilter) orderKeys() []orderKey {
+	sort := f.Sort
+	if len(sort) == 0 && f.Search != "" {
+		sort = []ProjectSort{{Field: SortRelevance, Desc: true}}
+	}
+	keys := make([]orderKey, 0, len(sort)+1)
+	for _, s := range sort {
+		column, ok := sortColumns[s.Field]
+		if !ok || (s.Field == SortRelevance && f.Search == "") {
+			continue
+		}
+		key := orderKey{sortColumn: column, desc: s.Desc}
+		if s.Field == SortRelevance {
+			key.params = []interface{}{tsQuery(f.Search)}
+		}
+		keys = append(keys, key)
+		if s.Field == SortID {
+			return keys
+		}
+	}
+
+	return append(keys, orderKey{sortColumn: sortColumns[SortID], desc: true})
+}
+
+// SortKey returns canonical representation of ordering, cursors are valid only for the same ordering
+func (f *ProjectFilter) SortKey() string {
+	fields := make([]string, 0, len(f.Sort))
+	for _, s := range f.Sort {
+		if s.Desc {
+			fields = append(fields, "-"+s.Field)
+		} else {
+			fields = append(fields, s.Field)
+		}
+	}
+	if len(fields) == 0 && f.Search != "" {
+		return "-" + SortRelevance
+	}
+
+	return strings.Join(fields, ",")
+}
+
+// order returns order expression with params
+func (f *ProjectFilter) order() (string, []interface{}) {
+	keys := f.orderKeys()
+	exprs := make([]string, 0, len(keys))
+	var params []interface{}
+	for _, key := range keys {
+		if key.desc {
+			exprs = append(exprs, key.expr+" DESC")
+		} else {
+			exprs = append(exprs, key.expr+" ASC")
+		}
+		params = append(params, key.params...)
+	}
+
+	return strings.Join(exprs, ", "), params
+}
+
+// after adds keyset condition to query, so only projects following cursor are selected
+func (f *ProjectFilter) after(q *orm.Query, c *Cursor) (*orm.Query, error) {
+	keys := f.orderKeys()
+	if c.Sort != f.SortKey() || len(c.Values) != len(keys)-1 {
+		return nil, ErrInvalidCursor
+	}
+	values := make([]interface{}, 0, len(keys))
+	for i, v := range c.Values {
+		if !keys[i].valid(v) {
+			return nil, ErrInvalidCursor
+		}
+		values = append(values, v)
+	}
+	values = append(values, c.ID)
+
+	return q.WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+		for i := range keys {
+			i := i
+			q = q.WhereOrGroup(func(q *orm.Query) (*orm.Query, error) {
+				for j := 0; j < i; j++ {
+					q = q.Where(keys[j].expr+" = ?::"+keys[j].cast, keyParams(keys[j], values[j])...)
+				}
+				op := " > ?::"
+				if keys[i].desc {
+					op = " < ?::"
+				}
+				return q.Where(keys[i].expr+op+keys[i].cast, keyParams(keys[i], values[i])...), nil
+			})
+		}
+		return q, nil
+	}), nil
+}
+
+// cursorColumns returns expressions selecting sort values of project as text
+func (f *ProjectFilter) cursorColumns() ([]string, []interface{}) {
+	keys := f.orderKeys()
+	exprs := make([]string, 0, len(keys)-1)
+	var params []interface{}
+	for _, key := range keys[:len(keys)-1] {
+		exprs = append(exprs, "("+key.expr+")::text")
+		params = append(params, key.params...)
+	}
+
+	return exprs, params
+}
+
+func keyParams(key orderKey, value interface{}) []interface{} {
+	params := make([]interface{}, 0, len(key.params)+1)
+	params = append(params, key.params...)
+
+	return append(params, value)
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProjectFilterSuite struct {
+	suite.Suite
+}
+
+func (s *ProjectFilterSuite) TestOrderDefault() {
+	order, params := (&ProjectFilter{}).order()
+	s.Require().Equal("p.id DESC", order)
+	s.Require().Empty(params)
+}
+
+func (s *ProjectFilterSuite) TestOrderSearch() {
+	order, params := (&ProjectFilter{Search: "pizza"}).order()
+	s.Require().Equal("ts_rank(p.search_vector, ?)::float8 DESC, p.id DESC", order)
+	s.Require().Len(params, 1)
+}
+
+func (s *ProjectFilterSuite) TestOrderFields() {
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortTotal, Desc: true}, {Field: SortReleaseDate}}}
+	order, _ := f.order()
+	s.Require().Equal("p.total DESC, p.release_date ASC, p.id DESC", order)
+}
+
+func (s *ProjectFilterSuite) TestOrderByID() {
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortID}, {Field: SortTotal}}}
+	order, _ := f.order()
+	s.Require().Equal("p.id ASC", order)
+}
+
+func (s *ProjectFilterSuite) TestSortKey() {
+	s.Require().Equal("", (&ProjectFilter{}).SortKey())
+	s.Require().Equal("-relevance", (&ProjectFilter{Search: "pizza"}).SortKey())
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortTotal, Desc: true}, {Field: SortReleaseDate}}}
+	s.Require().Equal("-total,release_date", f.SortKey())
+}
+
+func (s *ProjectFilterSuite) TestCursorColumns() {
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortTotal, Desc: true}}}
+	columns, params := f.cursorColumns()
+	s.Require().Equal([]string{"(p.total)::text"}, columns)
+	s.Require().Empty(params)
+}
+
+func (s *ProjectFilterSuite) TestAfterWrongSort() {
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortTotal, Desc: true}}}
+	_, err := f.after(nil, &Cursor{Sort: "total", Values: []string{"100"}, ID: 5})
+	s.Require().Equal(ErrInvalidCursor, err)
+	_, err = f.after(nil, &Cursor{Sort: "-total", ID: 5})
+	s.Require().Equal(ErrInvalidCursor, err)
+}
+
+func (s *ProjectFilterSuite) TestAfterInvalidValues() {
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortTotal}, {Field: SortReleaseDate}, {Field: SortPercent}}}
+	for _, values := range [][]string{
+		{"abc", "2020-10-01 00:00:00+00", "0.5"},
+		{"100", "2020-10-01", "0.5"},
+		{"100", "2020-10-01 00:00:00+00", "0x1p-2"},
+		{"100", "2020-10-01 00:00:00+00", "'; DROP"},
+	} {
+		_, err := f.after(nil, &Cursor{Sort: "total,release_date,percent", Values: values, ID: 5})
+		s.Require().Equal(ErrInvalidCursor, err, values)
+	}
+}
+
+func (s *ProjectFilterSuite) TestAfterValidValues() {
+	f := &ProjectFilter{Sort: []ProjectSort{{Field: SortTotal}, {Field: SortReleaseDate}, {Field: SortPercent}}}
+	for _, values := range [][]string{
+		{"100", "2020-10-01 00:00:00+00", "0.5"},
+		{"-3", "2020-10-01 12:30:00.123456+05:30", "1e-05"},
+	} {
+		q, err := f.after(orm.NewQuery(nil, (*Project)(nil)), &Cursor{Sort: "total,release_date,percent", Values: values, ID: 5})
+		s.Require().NoError(err, values)
+		s.Require().NotNil(q)
+	}
+}
+
+func TestProjectFilterSuite(t *testing.T) {
+	suite.Run(t, new(ProjectFilterSuite))
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProjectSuite struct {
+	suite.Suite
+}
+
+func (s *ProjectSuite) contributedSQL(user, viewer int) string {
+	q := contributedQuery(orm.NewQuery(nil, (*Donation)(nil)), user, viewer)
+	b, err := orm.NewSelectQuery(q).AppendQuery(orm.NewFormatter(), nil)
+	s.Require().NoError(err)
+
+	return string(b)
+}
+
+func (s *ProjectSuite) TestContributedBySelf() {
+	s.Require().NotContains(s.contributedSQL(5, 5), "d.anonymous")
+}
+
+func (s *ProjectSuite) TestContributedForOtherViewer() {
+	sql := s.contributedSQL(5, 7)
+	s.Require().Contains(sql, "(d.anonymous = FALSE)")
+	s.Require().Contains(sql, "(SELECT id FROM projects WHERE owner_id = 7)")
+	s.Require().Contains(sql, "(SELECT project_id FROM project_collaborators WHERE user_id = 7 AND role IN ('co_owner','treasurer') AND accepted_at IS NOT NULL)")
+}
+
+func (s *ProjectSuite) TestEqualShares() {
+	p := &Project{GoalAmount: 1000, GoalPeople: 3, Currency: "RUB"}
+	shares, err := equalShares(p, 3)
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{334, 333, 333}, shares)
+}
+
+func (s *ProjectSuite) TestEqualSharesAboveGoalPeople() {
+	p := &Project{GoalAmount: 1000, GoalPeople: 3, Currency: "RUB"}
+	shares, err := equalShares(p, 4)
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{250, 250, 250, 250}, shares)
+}
+
+func (s *ProjectSuite) TestResplitAfterLock() {
+	p := &Project{ID: 1, GoalAmount: 1000, GoalPeople: 3, Currency: "RUB"}
+	donations := []Donation{{ID: 1}, {ID: 2}, {ID: 3}, {ID: 4}}
+	shares, err := equalShares(p, len(donations))
+	s.Require().NoError(err)
+	for i := range donations {
+		donations[i].Payment = shares[i]
+	}
+
+	adjustments, err := shareAdjustments(p, donations)
+	s.Require().NoError(err)
+	s.Require().Empty(adjustments)
+}
+
+func (s *ProjectSuite) TestResplitAfterLeave() {
+	p := &Project{ID: 1, GoalAmount: 1000, GoalPeople: 3, Currency: "RUB"}
+	donations := []Donation{
+		{ID: 1, UserID: 10, Payment: 334, PaidAmount: 334, Paid: true},
+		{ID: 3, UserID: 30, Payment: 333},
+	}
+
+	adjustments, err := shareAdjustments(p, donations)
+	s.Require().NoError(err)
+	s.Require().Len(adjustments, 2)
+	s.Require().Equal(int64(500), donations[0].Payment)
+	s.Require().False(donations[0].Paid)
+	s.Require().Equal(Adjustment{
+		DonationID: 3,
+		ProjectID:  1,
+		UserID:     30,
+		OldPayment: 333,
+		NewPayment: 500,
+		CreatedAt:  adjustments[1].CreatedAt,
+	}, adjustments[1])
+}
+
+func (s *ProjectSuite) TestEqualSharesWithoutParticipants() {
+	_, err := equalShares(&Project{GoalAmount: 1000, GoalPeople: 3, Currency: "RUB"}, 0)
+	s.Require().Error(err)
+}
+
+func TestProjectSuite(t *testing.T) {
+	suite.Run(t, new(ProjectSuite))
+}
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_project_update_mock.go -package=mocks ProjectUpdateImpl
+
+// ProjectUpdateImpl ...
+type ProjectUpdateImpl interface {
+	Get(id int) (*ProjectUpdate, bool)
+	GetAllByProject(projectID int, withPrivate bool) ([]ProjectUpdate, error)
+	Create(u *ProjectUpdate) error
+	Delete(u *ProjectUpdate) error
+}
+
+// ProjectUpdate news posted by project owner
+type ProjectUpdate struct {
+	tableName        struct{}  `pg:"project_updates,alias:pu"` //nolint
+	ID               int       `json:"id"`
+	ProjectID        int       `json:"project"`
+	Title            string    `json:"title"`
+	Text             string    `json:"text"`
+	ParticipantsOnly bool      `pg:",use_zero" json:"participants_only"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// ProjectUpdateRepo ...
+type ProjectUpdateRepo struct {
+	db *pg.DB
+}
+
+// NewProjectUpdateModel ...
+func NewProjectUpdateModel(db *pg.DB) *ProjectUpdateRepo {
+	return &ProjectUpdateRepo{
+		db: db,
+	}
+}
+
+// Get project update
+func (r *ProjectUpdateRepo) Get(id int) (*ProjectUpdate, bool) {
+	update := &ProjectUpdate{}
+	err := r.db.Model(update).Where("pu.id = ?", id).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return update, true
+}
+
+// GetAllByProject returns updates of project, newest first
+func (r *ProjectUpdateRepo) GetAllByProject(projectID int, withPrivate bool) ([]ProjectUpdate, error) {
+	updates := make([]ProjectUpdate, 0)
+	q := r.db.Model(&updates).Where("pu.project_id = ?", projectID)
+	if !withPrivate {
+		q = q.Where("pu.participants_only = ?", false)
+	}
+	err := q.Order("pu.id DESC").Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+// Create new project update
+func (r *ProjectUpdateRepo) Create(u *ProjectUpdate) error {
+	_, err := r.db.Model(u).Insert()
+
+	return err
+}
+
+// Delete project update
+func (r *ProjectUpdateRepo) Delete(u *ProjectUpdate) error {
+	_, err := r.db.Model(u).WherePK().Delete()
+
+	return err
+}
@@ -0,0 +1,188 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_ranking_mock.go -package=mocks RankingImpl
+
+// RankingImpl ...
+type RankingImpl interface {
+	GetRecentDonations(since time.Time) ([]RecentDonations, error)
+	GetDonorAffinity() ([]DonorAffinity, error)
+	GetJoinedProjects() ([]JoinedProject, error)
+	SaveTrending(rankings []TrendingProject) error
+	SaveRecommended(userID int, rankings []RecommendedProject) error
+	GetTrending(limit int) (*[]Project, error)
+	GetRecommended(userID, limit int) (*[]Project, error)
+}
+
+// RecentDonations count and sum of donations to project made since some moment
+type RecentDonations struct {
+	ProjectID int
+	Cnt       int
+	Amount    int64
+}
+
+// DonorAffinity count of user's donations to published projects of same type and category
+type DonorAffinity struct {
+	UserID        int
+	ProjectTypeID int
+	CategoryID    int
+	Cnt           int
+}
+
+// JoinedProject project on search stage user participates in
+type JoinedProject struct {
+	UserID    int
+	ProjectID int
+}
+
+// TrendingProject precomputed trending score of project
+type TrendingProject struct {
+	tableName struct{} `pg:"trending_projects,alias:tp"` //nolint
+	ProjectID int      `pg:",pk"`
+	Score     float64  `pg:",use_zero"`
+	UpdatedAt time.Time
+}
+
+// RecommendedProject precomputed score of project recommended to user
+type RecommendedProject struct {
+	tableName struct{} `pg:"recommended_projects,alias:rp"` //nolint
+	UserID    int      `pg:",pk"`
+	ProjectID int      `pg:",pk"`
+	Score     float64  `pg:",use_zero"`
+	UpdatedAt time.Time
+}
+
+// RankingRepo ...
+type RankingRepo struct {
+	db *pg.DB
+}
+
+// NewRankingModel ...
+func NewRankingModel(db *pg.DB) *RankingRepo {
+	return &RankingRepo{
+		db: db,
+	}
+}
+
+// GetRecentDonations returns donations to active projects made since given moment grouped by project
+func (r *RankingRepo) GetRecentDonations(since time.Time) ([]RecentDonations, error) {
+	recent := make([]RecentDonations, 0)
+	err := r.db.Model((*Donation)(nil)).
+		ColumnExpr("d.project_id").
+		ColumnExpr("count(d.id) AS cnt").
+		ColumnExpr("coalesce(sum(d.payment), 0) AS amount").
+		Join("JOIN projects as p ON d.project_id = p.id").
+		Where("d.created_at >= ?", since).
+		Where("p.published = ?", true).
+		Where("p.closed = ?", false).
+		Group("d.project_id").
+		Select(&recent)
+	if err != nil {
+		return nil, err
+	}
+
+	return recent, nil
+}
+
+// GetDonorAffinity returns donations to published projects grouped by user, project type and category
+func (r *RankingRepo) GetDonorAffinity() ([]DonorAffinity, error) {
+	rows := make([]DonorAffinity, 0)
+	err := r.db.Model((*Donation)(nil)).
+		ColumnExpr("d.user_id").
+		ColumnExpr("p.project_type_id").
+		ColumnExpr("p.category_id").
+		ColumnExpr("count(d.id) AS cnt").
+		Join("JOIN projects as p ON d.project_id = p.id").
+		Where("p.published = ?", true).
+		Group("d.user_id", "p.project_type_id", "p.category_id").
+		Select(&rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// GetJoinedProjects returns participants of projects on search stage
+func (r *RankingRepo) GetJoinedProjects() ([]JoinedProject, error) {
+	joined := make([]JoinedProject, 0)
+	err := r.db.Model((*Donation)(nil)).
+		ColumnExpr("d.user_id").
+		ColumnExpr("d.project_id").
+		Join("JOIN projects as p ON d.project_id = p.id").
+		Where("p.published = ?", true).
+		Where("p.locked = ?", false).
+		Where("p.closed = ?", false).
+		Select(&joined)
+	if err != nil {
+		return nil, err
+	}
+
+	return joined, nil
+}
+
+// SaveTrending replaces trending projects
+func (r *RankingRepo) SaveTrending(rankings []TrendingProject) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Exec("DELETE FROM trending_projects")
+		if err != nil || len(rankings) == 0 {
+			return err
+		}
+		_, err = tx.Model(&rankings).Insert()
+
+		return err
+	})
+}
+
+// SaveRecommended replaces projects recommended to user
+func (r *RankingRepo) SaveRecommended(userID int, rankings []RecommendedProject) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model((*RecommendedProject)(nil)).Where("rp.user_id = ?", userID).Delete()
+		if err != nil || len(rankings) == 0 {
+			return err
+		}
+		_, err = tx.Model(&rankings).Insert()
+
+		return err
+	})
+}
+
+// GetTrending returns projects on search stage with the highest trending score
+func (r *RankingRepo) GetTrending(limit int) (*[]Project, error) {
+	projects := &[]Project{}
+	err := r.db.Model(projects).
+		Relation("Category").
+		Relation("ProjectType").
+		Join("JOIN trending_projects AS tp ON tp.project_id = p.id").
+		Where("p.published = ?", true).
+		Where("p.locked = ?", false).
+		Where("p.closed = ?", false).
+		Order("tp.score DESC", "p.id DESC").
+		Limit(limit).
+		Select()
+
+	return projects, err
+}
+
+// GetRecommended returns projects on search stage recommended to user, best first
+func (r *RankingRepo) GetRecommended(userID, limit int) (*[]Project, error) {
+	projects := &[]Project{}
+	err := r.db.Model(projects).
+		Relation("Category").
+		Relation("ProjectType").
+		Join("JOIN recommended_projects AS rp ON rp.project_id = p.id").
+		Where("rp.user_id = ?", userID).
+		Where("p.published = ?", true).
+		Where("p.locked = ?", false).
+		Where("p.closed = ?", false).
+		Order("rp.score DESC", "p.id DESC").
+		Limit(limit).
+		Select()
+
+	return projects, err
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_revision_mock.go -package=mocks RevisionImpl
+
+// RevisionImpl ...
+type RevisionImpl interface {
+	GetAllByProject(projectID int) ([]ProjectRevision, error)
+	GetLast(projectID int) (*ProjectRevision, bool)
+	Create(r *ProjectRevision) error
+}
+
+// ProjectRevision saved state of project fields.
+// Flagged keeps financial fields changed after participants joined.
+type ProjectRevision struct {
+	tableName struct{}          `pg:"project_revisions,alias:pr"` //nolint
+	ID        int               `json:"-"`
+	ProjectID int               `json:"project"`
+	Number    int               `json:"number"`
+	UserID    int               `json:"user"`
+	Fields    map[string]string `json:"fields"`
+	Flagged   []string          `pg:",array" json:"flagged"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// RevisionRepo ...
+type RevisionRepo struct {
+	db *pg.DB
+}
+
+// NewRevisionModel ...
+func NewRevisionModel(db *pg.DB) *RevisionRepo {
+	return &RevisionRepo{
+		db: db,
+	}
+}
+
+// GetAllByProject returns revisions of project, oldest first
+func (r *RevisionRepo) GetAllByProject(projectID int) ([]ProjectRevision, error) {
+	revisions := make([]ProjectRevision, 0)
+	err := r.db.Model(&revisions).Where("pr.project_id = ?", projectID).Order("pr.number ASC").Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// GetLast returns latest revision of project
+func (r *RevisionRepo) GetLast(projectID int) (*ProjectRevision, bool) {
+	revision := &ProjectRevision{}
+	err := r.db.Model(revision).
+		Where("pr.project_id = ?", projectID).
+		Order("pr.number DESC").
+		Limit(1).
+		Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return revision, true
+}
+
+// Create revision of project
+func (r *RevisionRepo) Create(revision *ProjectRevision) error {
+	_, err := r.db.Model(revision).Insert()
+
+	return err
+}
@@ -0,0 +1,203 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_tag_mock.go -package=mocks TagImpl
+
+// TagImpl ...
+type TagImpl interface {
+	Get(id int) (*Tag, bool)
+	Search(prefix string, limit int) ([]Tag, error)
+	GetByProject(projectID int) ([]Tag, error)
+	SetProjectTags(projectID int, tags []Tag) ([]Tag, error)
+	CountByStatus() ([]TagStatusCount, error)
+	Save(t *Tag) error
+	Merge(source, target *Tag) error
+}
+
+// Tag free-form or curated label of project
+type Tag struct {
+	tableName struct{}  `pg:"tags,alias:t"` //nolint
+	ID        int       `json:"id"`
+	Alias     string    `json:"alias"`
+	Name      string    `json:"name"`
+	Curated   bool      `pg:",use_zero" json:"curated"`
+	CreatedAt time.Time `json:"-"`
+}
+
+// ProjectTag link between project and tag
+type ProjectTag struct {
+	tableName struct{} `pg:"project_tags,alias:pt"` //nolint
+	ProjectID int      `pg:",pk"`
+	TagID     int      `pg:",pk"`
+}
+
+// TagStatusCount count of published projects with tag in status
+type TagStatusCount struct {
+	TagID   int
+	Alias   string
+	Name    string
+	Curated bool
+	Status  string
+	Count   int
+}
+
+// TagRepo ...
+type TagRepo struct {
+	db *pg.DB
+}
+
+// NewTagModel ...
+func NewTagModel(db *pg.DB) *TagRepo {
+	return &TagRepo{
+		db: db,
+	}
+}
+
+// likeEscaper escapes wildcards of LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Get returns tag
+func (r *TagRepo) Get(id int) (*Tag, bool) {
+	tag := &Tag{}
+	err := r.db.Model(tag).Where("t.id = ?", id).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return tag, true
+}
+
+// Search returns tags with alias starting with prefix.
+// Curated tags go first, then the most used ones.
+func (r *TagRepo) Search(prefix string, limit int) ([]Tag, error) {
+	tags := make([]Tag, 0)
+	err := r.db.Model(&tags).
+		Where("t.alias LIKE ?", likeEscaper.Replace(prefix)+"%").
+		OrderExpr("t.curated DESC").
+		OrderExpr("(SELECT count(*) FROM project_tags pt WHERE pt.tag_id = t.id) DESC").
+		Order("t.alias").
+		Limit(limit).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// GetByProject returns tags of project
+func (r *TagRepo) GetByProject(projectID int) ([]Tag, error) {
+	tags := make([]Tag, 0)
+	err := r.db.Model(&tags).
+		Join("JOIN project_tags AS pt ON pt.tag_id = t.id").
+		Where("pt.project_id = ?", projectID).
+		Order("t.alias").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// SetProjectTags replaces tags of project, unknown tags are created as free-form ones.
+// Returns saved tags ordered by alias.
+func (r *TagRepo) SetProjectTags(projectID int, tags []Tag) ([]Tag, error) {
+	err := r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model((*ProjectTag)(nil)).Where("pt.project_id = ?", projectID).Delete()
+		if err != nil {
+			return err
+		}
+		for i := range tags {
+			_, err = tx.Model(&tags[i]).
+				OnConflict("(alias) DO UPDATE").
+				Set("alias = EXCLUDED.alias").
+				Returning("*").
+				Insert()
+			if err != nil {
+				return err
+			}
+			_, err = tx.Model(&ProjectTag{ProjectID: projectID, TagID: tags[i].ID}).
+				OnConflict("DO NOTHING").
+				Insert()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetByProject(projectID)
+}
+
+// CountByStatus returns count of published projects by tag and status, ordered by tag alias
+func (r *TagRepo) CountByStatus() ([]TagStatusCount, error) {
+	status := "CASE"
+	for _, s := range ListedStatuses {
+		status += " WHEN " + statusConditions[s] + " THEN '" + s + "'"
+	}
+	status += " END"
+
+	counts := make([]TagStatusCount, 0)
+	_, err := r.db.Query(&counts,
+		`SELECT t.id AS tag_id, t.alias, t.name, t.curated, `+status+` AS status, count(*) AS count
+		FROM project_tags pt
+		JOIN tags t ON t.id = pt.tag_id
+		JOIN projects p ON p.id = pt.project_id
+		WHERE p.published
+		GROUP BY t.id, status
+		ORDER BY t.alias, status`,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// Save creates tag or updates existing one with the same alias
+func (r *TagRepo) Save(t *Tag) error {
+	_, err := r.db.Model(t).
+		OnConflict("(alias) DO UPDATE").
+		Set("name = EXCLUDED.name, curated = EXCLUDED.curated").
+		Returning("*").
+		Insert()
+
+	return err
+}
+
+// Merge moves projects of source tag to target one and deletes source tag
+func (r *TagRepo) Merge(source, target *Tag) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Exec(
+			`INSERT INTO project_tags (project_id, tag_id)
+			SELECT project_id, ? FROM project_tags WHERE tag_id = ?
+			ON CONFLICT DO NOTHING`,
+			target.ID, source.ID,
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model((*ProjectTag)(nil)).Where("pt.tag_id = ?", source.ID).Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(source).WherePK().Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(target).WherePK().Column("curated").Update()
+
+		return err
+	})
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_template_mock.go -package=mocks TemplateImpl
+
+// TemplateImpl ...
+type TemplateImpl interface {
+	Get(id int) (*ProjectTemplate, bool)
+	GetAvailable(userID int) ([]ProjectTemplate, error)
+	Create(t *ProjectTemplate) error
+	Delete(t *ProjectTemplate) error
+}
+
+// ProjectTemplate reusable content of new projects.
+// Shared templates are available to everyone, others only to owner.
+type ProjectTemplate struct {
+	tableName     struct{}  `pg:"project_templates,alias:tpl"` //nolint
+	ID            int       `json:"id"`
+	OwnerID       int       `json:"owner"`
+	Name          string    `json:"name"`
+	Shared        bool      `pg:",use_zero" json:"shared"`
+	Title         string    `pg:",use_zero" json:"title"`
+	Description   string    `pg:",use_zero" json:"description"`
+	Instructions  string    `pg:",use_zero" json:"instructions"`
+	ProjectTypeID int       `json:"project_type,omitempty"`
+	GoalPeople    int       `pg:",use_zero" json:"goal_people"`
+	GoalAmount    int64     `pg:",use_zero" json:"goal_amount"`
+	CreatedAt     time.Time `json:"-"`
+}
+
+// TemplateRepo ...
+type TemplateRepo struct {
+	db *pg.DB
+}
+
+// NewTemplateModel ...
+func NewTemplateModel(db *pg.DB) *TemplateRepo {
+	return &TemplateRepo{
+		db: db,
+	}
+}
+
+// Get template
+func (r *TemplateRepo) Get(id int) (*ProjectTemplate, bool) {
+	template := &ProjectTemplate{}
+	err := r.db.Model(template).Where("tpl.id = ?", id).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return template, true
+}
+
+// GetAvailable returns shared templates and templates of user, shared first
+func (r *TemplateRepo) GetAvailable(userID int) ([]ProjectTemplate, error) {
+	templates := make([]ProjectTemplate, 0)
+	err := r.db.Model(&templates).
+		WhereOr("tpl.shared = ?", true).
+		WhereOr("tpl.owner_id = ?", userID).
+		Order("tpl.shared DESC", "tpl.name ASC", "tpl.id ASC").
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// Create new template
+func (r *TemplateRepo) Create(t *ProjectTemplate) error {
+	_, err := r.db.Model(t).Insert()
+
+	return err
+}
+
+// Delete template
+func (r *TemplateRepo) Delete(t *ProjectTemplate) error {
+	_, err := r.db.Model(t).WherePK().Delete()
+
+	return err
+}
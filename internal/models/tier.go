@@ -0,0 +1,104 @@
+package models
+
+import (
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_tier_mock.go -package=mocks TierImpl
+
+// TierImpl ...
+type TierImpl interface {
+	Get(id int) (*Tier, bool)
+	GetAllByProject(projectID int) ([]Tier, error)
+	Create(t *Tier) error
+	Delete(t *Tier) error
+}
+
+// Tier reward option of money project
+type Tier struct {
+	tableName   struct{} `pg:"tiers,alias:t"` //nolint
+	ID          int      `json:"id"`
+	ProjectID   int      `json:"project"`
+	Amount      int64    `json:"amount"`
+	Title       string   `json:"title"`
+	Description string   `pg:",use_zero" json:"description"`
+	Quantity    int      `pg:",use_zero" json:"quantity"`
+	Taken       int      `pg:"-" json:"taken"`
+}
+
+// SoldOut checks all limited rewards are taken.
+// Zero quantity means unlimited rewards.
+func (t *Tier) SoldOut() bool {
+	return t.Quantity > 0 && t.Taken >= t.Quantity
+}
+
+// TierRepo ...
+type TierRepo struct {
+	db *pg.DB
+}
+
+// NewTierModel ...
+func NewTierModel(db *pg.DB) *TierRepo {
+	return &TierRepo{
+		db: db,
+	}
+}
+
+// Get tier
+func (r *TierRepo) Get(id int) (*Tier, bool) {
+	tier := &Tier{}
+	err := r.db.Model(tier).Where("t.id = ?", id).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return tier, true
+}
+
+type tierCount struct {
+	TierID int
+	Cnt    int
+}
+
+// GetAllByProject returns project tiers with count of taken rewards
+func (r *TierRepo) GetAllByProject(projectID int) ([]Tier, error) {
+	tiers := make([]Tier, 0)
+	err := r.db.Model(&tiers).Where("t.project_id = ?", projectID).Order("t.amount ASC").Select()
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]tierCount, 0)
+	err = r.db.Model((*Donation)(nil)).
+		ColumnExpr("d.tier_id").
+		ColumnExpr("count(d.id) AS cnt").
+		Where("d.project_id = ?", projectID).
+		Where("d.tier_id IS NOT NULL").
+		Group("d.tier_id").
+		Select(&counts)
+	if err != nil {
+		return nil, err
+	}
+	taken := make(map[int]int, len(counts))
+	for _, c := range counts {
+		taken[c.TierID] = c.Cnt
+	}
+	for i := range tiers {
+		tiers[i].Taken = taken[tiers[i].ID]
+	}
+
+	return tiers, nil
+}
+
+// Create new tier
+func (r *TierRepo) Create(t *Tier) error {
+	_, err := r.db.Model(t).Insert()
+
+	return err
+}
+
+// Delete tier
+func (r *TierRepo) Delete(t *Tier) error {
+	_, err := r.db.Model(t).WherePK().Delete()
+
+	return err
+}
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/stretchr/testify/suite"
+)
+
+type TierSuite struct {
+	suite.Suite
+}
+
+func (s *TierSuite) TestInsertWithoutDescription() {
+	q := orm.NewQuery(nil, &Tier{ProjectID: 1, Amount: 500, Title: "basic"})
+	b, err := orm.NewInsertQuery(q).AppendQuery(orm.NewFormatter(), nil)
+	s.Require().NoError(err)
+	s.Require().Contains(string(b), `VALUES (DEFAULT, 1, 500, 'basic', '', 0)`)
+}
+
+func TestTierSuite(t *testing.T) {
+	suite.Run(t, new(TierSuite))
+}
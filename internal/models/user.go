@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/go-pg/pg/v10"
+	"github.com/go-pg/pg/v10/orm"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=../mocks/model_user_mock.go -package=mocks UserImpl
@@ -9,9 +10,12 @@ import (
 // UserImpl ...
 type UserImpl interface {
 	Get(id int) (*User, bool)
+	GetByIDs(ids []int) ([]User, error)
+	GetByUsernames(usernames []string) ([]User, error)
+	GetAdmins() ([]User, error)
 	Create(*User) (*User, error)
 	Update(*User) (*User, error)
-	GetParticipation(id int) ([]Participation, error)
+	GetParticipation(id int, withAnonymous bool) ([]Participation, error)
 	GetProjectsForRate(userID int) ([]ProjectGroup, error)
 }
 
@@ -52,6 +56,42 @@ func (r *UserRepo) Get(id int) (*User, bool) {
 	return user, true
 }
 
+// GetByIDs returns users with given ids
+func (r *UserRepo) GetByIDs(ids []int) ([]User, error) {
+	users := make([]User, 0)
+	if len(ids) == 0 {
+		return users, nil
+	}
+	err := r.db.Model(&users).Where("u.id IN (?)", pg.In(ids)).Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetByUsernames returns users with given usernames
+func (r *UserRepo) GetByUsernames(usernames []string) ([]User, error) {
+	users := make([]User, 0)
+	if len(usernames) == 0 {
+		return users, nil
+	}
+	err := r.db.Model(&users).Where("u.username IN (?)", pg.In(usernames)).Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// GetAdmins returns administrators
+func (r *UserRepo) GetAdmins() ([]User, error) {
+	users := make([]User, 0)
+	err := r.db.Model(&users).Where("is_admin = ?", true).Select()
+
+	return users, err
+}
+
 // Create ...
 func (r *UserRepo) Create(u *User) (*User, error) {
 	_, err := r.db.Model(u).Insert()
@@ -85,17 +125,25 @@ type ProjectGroup struct {
 	Locked bool
 }
 
-// GetParticipation ...
-func (r *UserRepo) GetParticipation(id int) ([]Participation, error) {
-	pts := make([]Participation, 0)
-	err := r.db.Model((*Donation)(nil)).
-		ColumnExpr("count(d.id) AS cnt").
+// participationQuery counts user's donations by project type, anonymous ones are counted only on demand
+func participationQuery(q *orm.Query, id int, withAnonymous bool) *orm.Query {
+	q = q.ColumnExpr("count(d.id) AS cnt").
 		ColumnExpr("p.project_type_id").
 		Join("JOIN projects as p ON d.project_id = p.id").
 		Group("p.project_type_id").
 		Where("d.user_id = ?", id).
-		Where("p.published = ?", true).
-		Select(&pts)
+		Where("p.published = ?", true)
+	if !withAnonymous {
+		q = q.Where("d.anonymous = ?", false)
+	}
+
+	return q
+}
+
+// GetParticipation ...
+func (r *UserRepo) GetParticipation(id int, withAnonymous bool) ([]Participation, error) {
+	pts := make([]Participation, 0)
+	err := participationQuery(r.db.Model((*Donation)(nil)), id, withAnonymous).Select(&pts)
 	if err != nil {
 		return nil, err
 	}
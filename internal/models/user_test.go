@@ -0,0 +1,32 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/go-pg/pg/v10/orm"
+	"github.com/stretchr/testify/suite"
+)
+
+type UserSuite struct {
+	suite.Suite
+}
+
+func (s *UserSuite) participationSQL(withAnonymous bool) string {
+	q := participationQuery(orm.NewQuery(nil, (*Donation)(nil)), 5, withAnonymous)
+	b, err := orm.NewSelectQuery(q).AppendQuery(orm.NewFormatter(), nil)
+	s.Require().NoError(err)
+
+	return string(b)
+}
+
+func (s *UserSuite) TestParticipationOfOwner() {
+	s.Require().NotContains(s.participationSQL(true), "d.anonymous")
+}
+
+func (s *UserSuite) TestParticipationForOtherViewer() {
+	s.Require().Contains(s.participationSQL(false), "(d.anonymous = FALSE)")
+}
+
+func TestUserSuite(t *testing.T) {
+	suite.Run(t, new(UserSuite))
+}
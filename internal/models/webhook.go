@@ -0,0 +1,106 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_webhook_mock.go -package=mocks WebhookImpl
+
+// WebhookImpl ...
+type WebhookImpl interface {
+	Get(id int) (*Webhook, bool)
+	GetAll() ([]Webhook, error)
+	GetActiveByEvent(event string) ([]Webhook, error)
+	Create(w *Webhook) error
+	Update(w *Webhook) error
+	Delete(w *Webhook) error
+}
+
+// Webhook outgoing subscription to events
+// Secret is never serialized, it is shown only once when webhook is created.
+type Webhook struct {
+	tableName struct{}  `pg:"webhooks,alias:w"` //nolint
+	ID        int       `json:"id"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	Events    []string  `pg:",array" json:"events"`
+	Active    bool      `pg:",use_zero" json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WebhookRepo ...
+type WebhookRepo struct {
+	db *pg.DB
+}
+
+// NewWebhookModel ...
+func NewWebhookModel(db *pg.DB) *WebhookRepo {
+	return &WebhookRepo{
+		db: db,
+	}
+}
+
+// Get returns webhook
+func (r *WebhookRepo) Get(id int) (*Webhook, bool) {
+	webhook := &Webhook{}
+	err := r.db.Model(webhook).Where("w.id = ?", id).Select()
+	if err != nil {
+		return nil, false
+	}
+
+	return webhook, true
+}
+
+// GetAll returns all webhooks
+func (r *WebhookRepo) GetAll() ([]Webhook, error) {
+	webhooks := make([]Webhook, 0)
+	err := r.db.Model(&webhooks).Order("w.id").Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// GetActiveByEvent returns active webhooks subscribed to event
+func (r *WebhookRepo) GetActiveByEvent(event string) ([]Webhook, error) {
+	webhooks := make([]Webhook, 0)
+	err := r.db.Model(&webhooks).
+		Where("w.active = ?", true).
+		Where("? = ANY(w.events)", event).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// Create new webhook
+func (r *WebhookRepo) Create(w *Webhook) error {
+	_, err := r.db.Model(w).Insert()
+
+	return err
+}
+
+// Update webhook
+func (r *WebhookRepo) Update(w *Webhook) error {
+	_, err := r.db.Model(w).WherePK().Update()
+
+	return err
+}
+
+// Delete webhook with its delivery log
+func (r *WebhookRepo) Delete(w *Webhook) error {
+	return r.db.RunInTransaction(r.db.Context(), func(tx *pg.Tx) error {
+		_, err := tx.Model((*WebhookDelivery)(nil)).Where("wd.webhook_id = ?", w.ID).Delete()
+		if err != nil {
+			return err
+		}
+		_, err = tx.Model(w).WherePK().Delete()
+
+		return err
+	})
+}
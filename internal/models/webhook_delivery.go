@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-pg/pg/v10"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=../mocks/model_webhook_delivery_mock.go -package=mocks WebhookDeliveryImpl
+
+// WebhookDeliveryImpl ...
+type WebhookDeliveryImpl interface {
+	GetAllByWebhook(webhookID, cursor, limit int) ([]WebhookDelivery, error)
+	GetPending(now time.Time, limit int) ([]WebhookDelivery, error)
+	Create(d *WebhookDelivery) error
+	Update(d *WebhookDelivery) error
+}
+
+// WebhookDelivery log record of event sent to webhook
+// NextAttemptAt is set while failed delivery is waiting for retry.
+type WebhookDelivery struct {
+	tableName     struct{}   `pg:"webhook_deliveries,alias:wd"` //nolint
+	ID            int        `json:"id"`
+	Webhook       Webhook    `json:"-"`
+	WebhookID     int        `json:"webhook"`
+	Event         string     `json:"event"`
+	Payload       string     `json:"payload"`
+	Attempts      int        `pg:",use_zero" json:"attempts"`
+	StatusCode    int        `pg:",use_zero" json:"status_code"`
+	Error         string     `pg:",use_zero" json:"error"`
+	Delivered     bool       `pg:",use_zero" json:"delivered"`
+	NextAttemptAt *time.Time `json:"next_attempt_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// WebhookDeliveryRepo ...
+type WebhookDeliveryRepo struct {
+	db *pg.DB
+}
+
+// NewWebhookDeliveryModel ...
+func NewWebhookDeliveryModel(db *pg.DB) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{
+		db: db,
+	}
+}
+
+// GetAllByWebhook returns deliveries of webhook, newest first.
+// Only deliveries with id less than cursor are returned if cursor is set.
+func (r *WebhookDeliveryRepo) GetAllByWebhook(webhookID, cursor, limit int) ([]WebhookDelivery, error) {
+	deliveries := make([]WebhookDelivery, 0)
+	q := r.db.Model(&deliveries).Where("wd.webhook_id = ?", webhookID)
+	if cursor > 0 {
+		q = q.Where("wd.id < ?", cursor)
+	}
+	err := q.Order("wd.id DESC").Limit(limit).Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// GetPending returns deliveries to active webhooks due for retry at now, earliest first
+func (r *WebhookDeliveryRepo) GetPending(now time.Time, limit int) ([]WebhookDelivery, error) {
+	deliveries := make([]WebhookDelivery, 0)
+	err := r.db.Model(&deliveries).
+		Relation("Webhook").
+		Where("wd.delivered = ?", false).
+		Where("wd.next_attempt_at <= ?", now).
+		Where("webhook.active = ?", true).
+		Order("wd.next_attempt_at").
+		Limit(limit).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// Create new delivery
+func (r *WebhookDeliveryRepo) Create(d *WebhookDelivery) error {
+	_, err := r.db.Model(d).Insert()
+
+	return err
+}
+
+// Update delivery
+func (r *WebhookDeliveryRepo) Update(d *WebhookDelivery) error {
+	_, err := r.db.Model(d).WherePK().Update()
+
+	return err
+}
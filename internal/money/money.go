@@ -0,0 +1,155 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency currency used when project has no explicit one.
+const DefaultCurrency = "RUB"
+
+var (
+	// ErrUnknownCurrency currency code is not supported.
+	ErrUnknownCurrency = errors.New("unknown currency")
+	// ErrCurrencyMismatch operation on amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrWrongParts amount can't be split into given number of parts.
+	ErrWrongParts = errors.New("wrong number of parts")
+	// ErrWrongAmount amount can't be parsed.
+	ErrWrongAmount = errors.New("wrong amount")
+)
+
+// minorUnits number of decimal digits in minor unit for supported currencies.
+var minorUnits = map[string]int{
+	"RUB": 2,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+}
+
+// IsSupported checks currency code is known.
+func IsSupported(currency string) bool {
+	_, ok := minorUnits[currency]
+
+	return ok
+}
+
+// NormalizeCurrency returns upper-cased currency code or default one for empty code.
+func NormalizeCurrency(currency string) (string, error) {
+	if currency == "" {
+		return DefaultCurrency, nil
+	}
+	currency = strings.ToUpper(currency)
+	if !IsSupported(currency) {
+		return "", ErrUnknownCurrency
+	}
+
+	return currency, nil
+}
+
+// Parse returns money from amount in major units, e.g. "10.05" or "10,05".
+func Parse(value, currency string) (Money, error) {
+	digits, ok := minorUnits[currency]
+	if !ok {
+		return Money{}, ErrUnknownCurrency
+	}
+	value = strings.Replace(strings.TrimSpace(value), ",", ".", 1)
+	parts := strings.SplitN(value, ".", 2)
+	major, err := strconv.ParseUint(parts[0], 10, 63)
+	if err != nil {
+		return Money{}, ErrWrongAmount
+	}
+	amount := int64(major)
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = parts[1]
+		if fraction == "" || len(fraction) > digits {
+			return Money{}, ErrWrongAmount
+		}
+	}
+	fraction += strings.Repeat("0", digits-len(fraction))
+	for i := 0; i < digits; i++ {
+		amount *= 10
+	}
+	if fraction != "" {
+		minor, err := strconv.ParseUint(fraction, 10, 63)
+		if err != nil {
+			return Money{}, ErrWrongAmount
+		}
+		amount += int64(minor)
+	}
+
+	return New(amount, currency), nil
+}
+
+// Money amount in minor units of currency.
+type Money struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// New returns money with given amount of minor units.
+func New(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// IsZero checks amount is zero.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns sum of amounts in the same currency.
+func (m Money) Add(o Money) (Money, error) {
+	if m.Currency != o.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	return New(m.Amount+o.Amount, m.Currency), nil
+}
+
+// Split splits amount into n parts without losing minor units.
+// Remainder is distributed by one minor unit starting from the first part,
+// so the result is always the same for the same input.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, ErrWrongParts
+	}
+	base := m.Amount / int64(n)
+	rem := m.Amount % int64(n)
+	step := int64(1)
+	if rem < 0 {
+		step, rem = -1, -rem
+	}
+
+	parts := make([]Money, n)
+	for i := range parts {
+		parts[i] = New(base, m.Currency)
+		if int64(i) < rem {
+			parts[i].Amount += step
+		}
+	}
+
+	return parts, nil
+}
+
+// String returns amount in major units with currency code, e.g. "10.05 RUB".
+func (m Money) String() string {
+	digits := minorUnits[m.Currency]
+	if digits == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	div := int64(1)
+	for i := 0; i < digits; i++ {
+		div *= 10
+	}
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/div, digits, amount%div, m.Currency)
+}
@@ -0,0 +1,87 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type MoneySuite struct {
+	suite.Suite
+}
+
+func (s *MoneySuite) TestSplitEqual() {
+	parts, err := New(900, "RUB").Split(3)
+	s.Require().NoError(err)
+	s.Require().Equal([]Money{New(300, "RUB"), New(300, "RUB"), New(300, "RUB")}, parts)
+}
+
+func (s *MoneySuite) TestSplitRemainder() {
+	parts, err := New(1000, "RUB").Split(3)
+	s.Require().NoError(err)
+	s.Require().Equal([]Money{New(334, "RUB"), New(333, "RUB"), New(333, "RUB")}, parts)
+}
+
+func (s *MoneySuite) TestSplitNegative() {
+	parts, err := New(-1000, "RUB").Split(3)
+	s.Require().NoError(err)
+	s.Require().Equal([]Money{New(-334, "RUB"), New(-333, "RUB"), New(-333, "RUB")}, parts)
+}
+
+func (s *MoneySuite) TestSplitWrongParts() {
+	_, err := New(1000, "RUB").Split(0)
+	s.Require().Equal(ErrWrongParts, err)
+}
+
+func (s *MoneySuite) TestAdd() {
+	sum, err := New(150, "USD").Add(New(50, "USD"))
+	s.Require().NoError(err)
+	s.Require().Equal(New(200, "USD"), sum)
+
+	_, err = New(150, "USD").Add(New(50, "EUR"))
+	s.Require().Equal(ErrCurrencyMismatch, err)
+}
+
+func (s *MoneySuite) TestNormalizeCurrency() {
+	c, err := NormalizeCurrency("")
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultCurrency, c)
+
+	c, err = NormalizeCurrency("usd")
+	s.Require().NoError(err)
+	s.Require().Equal("USD", c)
+
+	_, err = NormalizeCurrency("XXX")
+	s.Require().Equal(ErrUnknownCurrency, err)
+}
+
+func (s *MoneySuite) TestString() {
+	s.Require().Equal("10.05 RUB", New(1005, "RUB").String())
+	s.Require().Equal("-0.50 USD", New(-50, "USD").String())
+	s.Require().Equal("1500 JPY", New(1500, "JPY").String())
+}
+
+func (s *MoneySuite) TestParse() {
+	m, err := Parse("10.05", "RUB")
+	s.Require().NoError(err)
+	s.Require().Equal(New(1005, "RUB"), m)
+
+	m, err = Parse("150,5", "USD")
+	s.Require().NoError(err)
+	s.Require().Equal(New(15050, "USD"), m)
+
+	m, err = Parse("1500", "JPY")
+	s.Require().NoError(err)
+	s.Require().Equal(New(1500, "JPY"), m)
+
+	for _, value := range []string{"", "-5", "1.005", "1.", "1.x", "abc", "15.5"} {
+		_, err = Parse(value, "JPY")
+		s.Require().Equal(ErrWrongAmount, err, value)
+	}
+	_, err = Parse("10", "XXX")
+	s.Require().Equal(ErrUnknownCurrency, err)
+}
+
+func TestMoneySuite(t *testing.T) {
+	suite.Run(t, new(MoneySuite))
+}
@@ -35,6 +35,10 @@ func New(a app.Application, jwtSecret []byte) *echo.Echo {
 	u := e.Group("/user")
 	u.Use(JWTmiddleware)
 	u.GET("", hu.GetCurrentUser)
+	u.GET("/email_settings", hu.GetEmailSettings)
+	u.PUT("/email_settings", hu.UpdateEmailSettings)
+	u.POST("/chat_link", hu.CreateChatLink)
+	u.DELETE("/chat_link", hu.DeleteChatLink)
 	u.GET("/:id", hu.GetUser)
 
 	hpt := handlers.NewProjectTypeHandler(a)
@@ -47,19 +51,143 @@ func New(a app.Application, jwtSecret []byte) *echo.Echo {
 	p.Use(JWTmiddleware)
 	p.GET("", hp.GetProjects)
 	p.GET("/user/:id", hp.GetUserProjects)
+	p.GET("/trending", hp.GetTrendingProjects)
+	p.GET("/recommended", hp.GetRecommendedProjects)
 	p.GET("/:id", hp.GetSingleProject)
 	p.POST("", hp.CreateProject)
 	p.PATCH("/:id", hp.UpdateProject)
 	p.DELETE("/:id", hp.DeleteProject)
+	p.PUT("/:id/privacy", hp.SetProjectPrivacy)
+	p.PUT("/:id/tags", hp.SetProjectTags)
+	p.POST("/:id/clone", hp.CloneProject)
+	p.POST("/:id/cancel", hp.CancelProject)
+	p.POST("/:id/extend", hp.ExtendProject)
+	p.PATCH("/:id/details", hp.EditProject)
+	p.POST("/:id/reopen", hp.ReopenProject)
+	p.GET("/:id/audit", hp.GetProjectAudit)
+	p.GET("/:id/revisions", hp.GetProjectRevisions)
+	p.GET("/:id/revisions/diff", hp.GetRevisionDiff)
+	p.PUT("/:id/schedule", hp.ScheduleProject)
+
+	hcl := handlers.NewCollaboratorHandler(a)
+	p.GET("/:id/collaborator", hcl.GetCollaborators)
+	p.POST("/:id/collaborator", hcl.InviteCollaborator)
+	p.POST("/:id/collaborator/accept", hcl.AcceptInvitation)
+	p.DELETE("/:id/collaborator/:user", hcl.RemoveCollaborator)
+	p.POST("/:id/transfer", hcl.TransferOwnership)
+	e.GET("/invitation", hcl.GetInvitations, JWTmiddleware)
+
+	hm := handlers.NewModerationHandler(a)
+	mg := e.Group("/moderation")
+	mg.Use(JWTmiddleware)
+	mg.GET("", hm.GetReviewQueue)
+	mg.POST("/:id/approve", hm.ApproveProject)
+	mg.POST("/:id/reject", hm.RejectProject)
+
+	htpl := handlers.NewTemplateHandler(a)
+	tpl := e.Group("/template")
+	tpl.Use(JWTmiddleware)
+	tpl.GET("", htpl.GetTemplates)
+	tpl.POST("", htpl.CreateTemplate)
+	tpl.DELETE("/:id", htpl.DeleteTemplate)
+
+	htg := handlers.NewTagHandler(a)
+	tag := e.Group("/tag")
+	tag.Use(JWTmiddleware)
+	tag.GET("", htg.SearchTags)
+	tag.GET("/stats", htg.GetTagStats)
+	tag.POST("", htg.CreateTag)
+	tag.POST("/:id/merge", htg.MergeTags)
 
 	hd := handlers.NewDonationHandler(a)
 	dg := e.Group("/donation")
 	dg.Use(JWTmiddleware)
 	dg.GET("", hd.GetUserDonations)
+	dg.GET("/adjustment", hd.GetUserAdjustments)
+	dg.POST("/:id/settle", hd.SettleCredit)
+	dg.PUT("/:id/anonymity", hd.SetDonationAnonymity)
+	dg.PUT("/:id/message", hd.SetDonationMessage)
+	dg.DELETE("/:id/message", hd.DeleteDonationMessage)
+	dg.PUT("/:id/message/hidden", hd.HideDonationMessage)
+	dg.GET("/project/:id/guestbook", hd.GetGuestbook)
 	// dg.GET("/project/:id", hd.GetProjectDonations)
 	// dg.POST("", hd.CreateDonation)
 	// dg.DELETE("/:id", hd.DeleteDonation)
 	// dg.PATCH("/:id", hd.UpdateDonation)
 
+	ht := handlers.NewTierHandler(a)
+	tg := e.Group("/tier")
+	tg.Use(JWTmiddleware)
+	tg.GET("/project/:id", ht.GetProjectTiers)
+	tg.POST("", ht.CreateTier)
+	tg.DELETE("/:id", ht.DeleteTier)
+
+	hcm := handlers.NewCommentHandler(a)
+	cmg := e.Group("/comment")
+	cmg.Use(JWTmiddleware)
+	cmg.GET("/project/:id", hcm.GetProjectComments)
+	cmg.GET("/:id/replies", hcm.GetCommentReplies)
+	cmg.POST("", hcm.CreateComment)
+	cmg.PATCH("/:id", hcm.UpdateComment)
+	cmg.DELETE("/:id", hcm.DeleteComment)
+
+	hpu := handlers.NewProjectUpdateHandler(a)
+	pug := e.Group("/update")
+	pug.Use(JWTmiddleware)
+	pug.GET("/project/:id", hpu.GetProjectUpdates)
+	pug.POST("", hpu.CreateProjectUpdate)
+	pug.DELETE("/:id", hpu.DeleteProjectUpdate)
+
+	hn := handlers.NewNotificationHandler(a)
+	ng := e.Group("/notifications")
+	ng.Use(JWTmiddleware)
+	ng.GET("", hn.GetNotifications)
+	ng.POST("/read", hn.MarkRead)
+	ng.POST("/read_all", hn.MarkAllRead)
+
+	hw := handlers.NewWebhookHandler(a)
+	wg := e.Group("/webhook")
+	wg.Use(JWTmiddleware)
+	wg.GET("", hw.GetWebhooks)
+	wg.POST("", hw.CreateWebhook)
+	wg.PUT("/:id", hw.UpdateWebhook)
+	wg.DELETE("/:id", hw.DeleteWebhook)
+	wg.GET("/:id/deliveries", hw.GetWebhookDeliveries)
+	wg.POST("/:id/test", hw.SendTestWebhook)
+
+	hf := handlers.NewFollowHandler(a)
+	fg := e.Group("/follow")
+	fg.Use(JWTmiddleware)
+	fg.GET("", hf.GetFollows)
+	fg.PUT("/:type/:id", hf.Follow)
+	fg.DELETE("/:type/:id", hf.Unfollow)
+	e.GET("/feed", hf.GetFeed, JWTmiddleware)
+
+	hl := handlers.NewLiveHandler(a)
+	lg := e.Group("/live")
+	lg.Use(tokenFromQuery, JWTmiddleware)
+	lg.GET("/project/:id", hl.StreamProject)
+
 	return e
 }
+
+// tokenFromQuery passes JWT from "token" query param to authorization header,
+// browsers can't set headers for EventSource requests.
+// Token is removed from request URI, so it doesn't get to access log.
+func tokenFromQuery(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		query := req.URL.Query()
+		token := query.Get("token")
+		if token != "" {
+			if req.Header.Get(echo.HeaderAuthorization) == "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+			}
+			query.Del("token")
+			req.URL.RawQuery = query.Encode()
+			req.RequestURI = req.URL.RequestURI()
+		}
+
+		return next(c)
+	}
+}
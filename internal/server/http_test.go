@@ -0,0 +1,50 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServerSuite struct {
+	suite.Suite
+}
+
+func (s *ServerSuite) TestTokenFromQuery() {
+	req := httptest.NewRequest(echo.GET, "/live/project/10?token=abc&since=5", nil)
+	e := echo.New()
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	var auth string
+	h := tokenFromQuery(func(c echo.Context) error {
+		auth = c.Request().Header.Get(echo.HeaderAuthorization)
+		return c.NoContent(http.StatusOK)
+	})
+	s.Require().NoError(h(c))
+
+	s.Require().Equal("Bearer abc", auth)
+	s.Require().Equal("/live/project/10?since=5", req.RequestURI)
+	s.Require().Equal("since=5", req.URL.RawQuery)
+}
+
+func (s *ServerSuite) TestTokenFromQueryKeepsHeader() {
+	req := httptest.NewRequest(echo.GET, "/live/project/10?token=abc", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer xyz")
+	e := echo.New()
+	c := e.NewContext(req, httptest.NewRecorder())
+
+	h := tokenFromQuery(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	s.Require().NoError(h(c))
+
+	s.Require().Equal("Bearer xyz", req.Header.Get(echo.HeaderAuthorization))
+	s.Require().Equal("/live/project/10", req.RequestURI)
+}
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(ServerSuite))
+}
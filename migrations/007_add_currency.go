@@ -0,0 +1,52 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(addCurrency, rollbackCurrency)
+}
+
+func addCurrency(db migrations.DB) error {
+	log.Info("moving amounts of [projects] and [donations] to minor units...")
+	_, err := db.Exec(
+		`ALTER TABLE projects
+			ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'RUB',
+			ALTER COLUMN goal_amount TYPE bigint,
+			ALTER COLUMN total TYPE bigint;
+		UPDATE projects SET goal_amount = goal_amount * 100;
+		UPDATE projects AS p SET total = p.total * 100
+			FROM project_types AS pt
+			WHERE p.project_type_id = pt.id AND pt.goal_by_amount AND NOT pt.goal_by_people;
+		ALTER TABLE donations
+			ADD COLUMN currency varchar(3) NOT NULL DEFAULT 'RUB',
+			ALTER COLUMN payment TYPE bigint;
+		UPDATE donations AS d SET payment = d.payment * 100, currency = p.currency
+			FROM projects AS p
+			WHERE d.project_id = p.id;
+	`)
+
+	return err
+}
+
+func rollbackCurrency(db migrations.DB) error {
+	log.Warn("moving amounts of [projects] and [donations] to major units...")
+	_, err := db.Exec(
+		`UPDATE donations SET payment = payment / 100;
+		ALTER TABLE donations
+			DROP COLUMN currency,
+			ALTER COLUMN payment TYPE int;
+		UPDATE projects AS p SET total = p.total / 100
+			FROM project_types AS pt
+			WHERE p.project_type_id = pt.id AND pt.goal_by_amount AND NOT pt.goal_by_people;
+		UPDATE projects SET goal_amount = goal_amount / 100;
+		ALTER TABLE projects
+			DROP COLUMN currency,
+			ALTER COLUMN goal_amount TYPE int,
+			ALTER COLUMN total TYPE int;
+	`)
+
+	return err
+}
@@ -0,0 +1,45 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createAdjustments, rollbackAdjustments)
+}
+
+func createAdjustments(db migrations.DB) error {
+	log.Info("creating table [adjustments]...")
+	_, err := db.Exec(
+		`ALTER TABLE donations
+			ADD COLUMN paid_amount bigint NOT NULL DEFAULT 0,
+			ADD COLUMN credit bigint NOT NULL DEFAULT 0;
+		UPDATE donations SET paid_amount = payment WHERE paid;
+		CREATE TABLE adjustments (
+			id bigserial NOT NULL primary key,
+			donation_id int NOT NULL,
+			project_id int NOT NULL,
+			user_id int NOT NULL,
+			old_payment bigint NOT NULL DEFAULT 0,
+			new_payment bigint NOT NULL DEFAULT 0,
+			credit bigint NOT NULL DEFAULT 0,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX adjustments_user_id_idx ON adjustments (user_id);
+	`)
+
+	return err
+}
+
+func rollbackAdjustments(db migrations.DB) error {
+	log.Warn("dropping table [adjustments]...")
+	_, err := db.Exec(
+		`DROP TABLE adjustments;
+		ALTER TABLE donations
+			DROP COLUMN paid_amount,
+			DROP COLUMN credit;
+	`)
+
+	return err
+}
@@ -0,0 +1,38 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createTiers, rollbackTiers)
+}
+
+func createTiers(db migrations.DB) error {
+	log.Info("creating table [tiers]...")
+	_, err := db.Exec(
+		`CREATE TABLE tiers (
+			id bigserial NOT NULL primary key,
+			project_id int NOT NULL,
+			amount bigint NOT NULL,
+			title varchar NOT NULL,
+			description varchar NOT NULL,
+			quantity int NOT NULL DEFAULT 0
+		);
+		CREATE INDEX tiers_project_id_idx ON tiers (project_id);
+		ALTER TABLE donations ADD COLUMN tier_id int;
+	`)
+
+	return err
+}
+
+func rollbackTiers(db migrations.DB) error {
+	log.Warn("dropping table [tiers]...")
+	_, err := db.Exec(
+		`ALTER TABLE donations DROP COLUMN tier_id;
+		DROP TABLE tiers;
+	`)
+
+	return err
+}
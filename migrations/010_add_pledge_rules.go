@@ -0,0 +1,36 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegister(addPledgeRules, rollbackPledgeRules)
+}
+
+func addPledgeRules(db migrations.DB) error {
+	log.Info("adding pledge rules to [projects]...")
+	_, err := db.Exec(
+		`ALTER TABLE projects
+			ADD COLUMN min_pledge bigint NOT NULL DEFAULT 0,
+			ADD COLUMN max_pledge bigint NOT NULL DEFAULT 0,
+			ADD COLUMN pledge_step bigint NOT NULL DEFAULT 0,
+			ADD COLUMN deny_overfunding boolean NOT NULL DEFAULT FALSE;
+	`)
+
+	return err
+}
+
+func rollbackPledgeRules(db migrations.DB) error {
+	log.Warn("dropping pledge rules from [projects]...")
+	_, err := db.Exec(
+		`ALTER TABLE projects
+			DROP COLUMN min_pledge,
+			DROP COLUMN max_pledge,
+			DROP COLUMN pledge_step,
+			DROP COLUMN deny_overfunding;
+	`)
+
+	return err
+}
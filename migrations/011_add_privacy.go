@@ -0,0 +1,30 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegister(addPrivacy, rollbackPrivacy)
+}
+
+func addPrivacy(db migrations.DB) error {
+	log.Info("adding privacy settings...")
+	_, err := db.Exec(
+		`ALTER TABLE donations ADD COLUMN anonymous boolean NOT NULL DEFAULT false;
+		ALTER TABLE projects ADD COLUMN private_amounts boolean NOT NULL DEFAULT true;
+	`)
+
+	return err
+}
+
+func rollbackPrivacy(db migrations.DB) error {
+	log.Warn("dropping privacy settings...")
+	_, err := db.Exec(
+		`ALTER TABLE donations DROP COLUMN anonymous;
+		ALTER TABLE projects DROP COLUMN private_amounts;
+	`)
+
+	return err
+}
@@ -0,0 +1,36 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegister(addDonationMessages, rollbackDonationMessages)
+}
+
+func addDonationMessages(db migrations.DB) error {
+	log.Info("adding messages to [donations]...")
+	_, err := db.Exec(
+		`ALTER TABLE donations
+			ADD COLUMN message varchar NOT NULL DEFAULT '',
+			ADD COLUMN message_hidden boolean NOT NULL DEFAULT false,
+			ADD COLUMN message_at timestamptz;
+		CREATE INDEX donations_project_id_message_at_idx ON donations (project_id, message_at DESC) WHERE message != '';
+	`)
+
+	return err
+}
+
+func rollbackDonationMessages(db migrations.DB) error {
+	log.Warn("dropping messages from [donations]...")
+	_, err := db.Exec(
+		`DROP INDEX donations_project_id_message_at_idx;
+		ALTER TABLE donations
+			DROP COLUMN message,
+			DROP COLUMN message_hidden,
+			DROP COLUMN message_at;
+	`)
+
+	return err
+}
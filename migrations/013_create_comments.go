@@ -0,0 +1,38 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createComments, rollbackComments)
+}
+
+func createComments(db migrations.DB) error {
+	log.Info("creating table [comments]...")
+	_, err := db.Exec(
+		`CREATE TABLE comments (
+			id bigserial NOT NULL primary key,
+			project_id int NOT NULL,
+			parent_id int REFERENCES comments (id),
+			user_id int NOT NULL,
+			text varchar NOT NULL,
+			mention_ids int[],
+			deleted boolean NOT NULL DEFAULT false,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			edited_at timestamptz
+		);
+		CREATE INDEX comments_project_id_idx ON comments (project_id, id) WHERE parent_id IS NULL;
+		CREATE INDEX comments_parent_id_idx ON comments (parent_id, id);
+	`)
+
+	return err
+}
+
+func rollbackComments(db migrations.DB) error {
+	log.Warn("dropping table [comments]...")
+	_, err := db.Exec(`DROP TABLE comments;`)
+
+	return err
+}
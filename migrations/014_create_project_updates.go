@@ -0,0 +1,34 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createProjectUpdates, rollbackProjectUpdates)
+}
+
+func createProjectUpdates(db migrations.DB) error {
+	log.Info("creating table [project_updates]...")
+	_, err := db.Exec(
+		`CREATE TABLE project_updates (
+			id bigserial NOT NULL primary key,
+			project_id int NOT NULL,
+			title varchar NOT NULL,
+			text varchar NOT NULL,
+			participants_only boolean NOT NULL DEFAULT false,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX project_updates_project_id_idx ON project_updates (project_id);
+	`)
+
+	return err
+}
+
+func rollbackProjectUpdates(db migrations.DB) error {
+	log.Warn("dropping table [project_updates]...")
+	_, err := db.Exec(`DROP TABLE project_updates;`)
+
+	return err
+}
@@ -0,0 +1,36 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createNotifications, rollbackNotifications)
+}
+
+func createNotifications(db migrations.DB) error {
+	log.Info("creating table [notifications]...")
+	_, err := db.Exec(
+		`CREATE TABLE notifications (
+			id bigserial NOT NULL primary key,
+			user_id int NOT NULL,
+			type varchar NOT NULL,
+			project_id int NOT NULL,
+			data jsonb,
+			read boolean NOT NULL DEFAULT false,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX notifications_user_id_idx ON notifications (user_id, id);
+		CREATE INDEX notifications_created_at_idx ON notifications (created_at);
+	`)
+
+	return err
+}
+
+func rollbackNotifications(db migrations.DB) error {
+	log.Warn("dropping table [notifications]...")
+	_, err := db.Exec(`DROP TABLE notifications;`)
+
+	return err
+}
@@ -0,0 +1,43 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createEmailSettings, rollbackEmailSettings)
+}
+
+func createEmailSettings(db migrations.DB) error {
+	log.Info("creating tables [email_settings, pending_emails]...")
+	_, err := db.Exec(
+		`CREATE TABLE email_settings (
+			user_id int NOT NULL primary key,
+			locale varchar NOT NULL DEFAULT 'ru',
+			digest boolean NOT NULL DEFAULT false,
+			events varchar[] NOT NULL DEFAULT '{}'
+		);
+		CREATE TABLE pending_emails (
+			id bigserial NOT NULL primary key,
+			user_id int NOT NULL,
+			type varchar NOT NULL,
+			project_id int NOT NULL,
+			data jsonb,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX pending_emails_user_id_idx ON pending_emails (user_id);
+	`)
+
+	return err
+}
+
+func rollbackEmailSettings(db migrations.DB) error {
+	log.Warn("dropping tables [email_settings, pending_emails]...")
+	_, err := db.Exec(
+		`DROP TABLE pending_emails;
+		DROP TABLE email_settings;
+	`)
+
+	return err
+}
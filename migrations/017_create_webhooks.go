@@ -0,0 +1,49 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createWebhooks, rollbackWebhooks)
+}
+
+func createWebhooks(db migrations.DB) error {
+	log.Info("creating tables [webhooks, webhook_deliveries]...")
+	_, err := db.Exec(
+		`CREATE TABLE webhooks (
+			id bigserial NOT NULL primary key,
+			url varchar NOT NULL,
+			secret varchar NOT NULL,
+			events varchar[] NOT NULL DEFAULT '{}',
+			active boolean NOT NULL DEFAULT true,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE TABLE webhook_deliveries (
+			id bigserial NOT NULL primary key,
+			webhook_id int NOT NULL,
+			event varchar NOT NULL,
+			payload text NOT NULL,
+			attempts int NOT NULL DEFAULT 0,
+			status_code int NOT NULL DEFAULT 0,
+			error varchar NOT NULL DEFAULT '',
+			delivered boolean NOT NULL DEFAULT false,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			updated_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, id);
+	`)
+
+	return err
+}
+
+func rollbackWebhooks(db migrations.DB) error {
+	log.Warn("dropping tables [webhooks, webhook_deliveries]...")
+	_, err := db.Exec(
+		`DROP TABLE webhook_deliveries;
+		DROP TABLE webhooks;
+	`)
+
+	return err
+}
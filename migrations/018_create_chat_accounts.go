@@ -0,0 +1,34 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createChatAccounts, rollbackChatAccounts)
+}
+
+func createChatAccounts(db migrations.DB) error {
+	log.Info("creating table [chat_accounts]...")
+	_, err := db.Exec(
+		`CREATE TABLE chat_accounts (
+			user_id int NOT NULL primary key,
+			chat_user_id varchar NOT NULL DEFAULT '',
+			chat_username varchar NOT NULL DEFAULT '',
+			link_code varchar NOT NULL DEFAULT '',
+			link_expires_at timestamptz
+		);
+		CREATE UNIQUE INDEX chat_accounts_chat_user_id_idx ON chat_accounts (chat_user_id) WHERE chat_user_id != '';
+		CREATE UNIQUE INDEX chat_accounts_link_code_idx ON chat_accounts (link_code) WHERE link_code != '';
+	`)
+
+	return err
+}
+
+func rollbackChatAccounts(db migrations.DB) error {
+	log.Warn("dropping table [chat_accounts]...")
+	_, err := db.Exec(`DROP TABLE chat_accounts;`)
+
+	return err
+}
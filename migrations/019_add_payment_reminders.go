@@ -0,0 +1,35 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegister(addPaymentReminders, rollbackPaymentReminders)
+}
+
+func addPaymentReminders(db migrations.DB) error {
+	log.Info("adding payment reminders...")
+	_, err := db.Exec(
+		`ALTER TABLE projects ADD COLUMN locked_at timestamptz;
+		ALTER TABLE projects ADD COLUMN escalated_at timestamptz;
+		UPDATE projects SET locked_at = now() WHERE locked AND NOT closed;
+		ALTER TABLE donations ADD COLUMN reminder_count int NOT NULL DEFAULT 0;
+		ALTER TABLE donations ADD COLUMN reminded_at timestamptz;
+	`)
+
+	return err
+}
+
+func rollbackPaymentReminders(db migrations.DB) error {
+	log.Warn("dropping payment reminders...")
+	_, err := db.Exec(
+		`ALTER TABLE projects DROP COLUMN locked_at;
+		ALTER TABLE projects DROP COLUMN escalated_at;
+		ALTER TABLE donations DROP COLUMN reminder_count;
+		ALTER TABLE donations DROP COLUMN reminded_at;
+	`)
+
+	return err
+}
@@ -0,0 +1,75 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(addProjectSearch, rollbackProjectSearch)
+}
+
+func addProjectSearch(db migrations.DB) error {
+	log.Info("adding project search...")
+	_, err := db.Exec(
+		`ALTER TABLE projects ADD COLUMN search_vector tsvector;
+		CREATE FUNCTION project_search_vector(title text, sub_title text, description text, owner_name text) RETURNS tsvector AS $$
+			SELECT
+				setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+				setweight(to_tsvector('russian', coalesce(sub_title, '')), 'B') ||
+				setweight(to_tsvector('english', coalesce(sub_title, '')), 'B') ||
+				setweight(to_tsvector('simple', coalesce(owner_name, '')), 'B') ||
+				setweight(to_tsvector('russian', coalesce(description, '')), 'C') ||
+				setweight(to_tsvector('english', coalesce(description, '')), 'C')
+		$$ LANGUAGE SQL IMMUTABLE;
+		CREATE FUNCTION projects_search_update() RETURNS trigger AS $$
+		BEGIN
+			NEW.search_vector := project_search_vector(
+				NEW.title,
+				NEW.sub_title,
+				NEW.description,
+				(SELECT concat_ws(' ', u.first_name, u.last_name, u.username) FROM users u WHERE u.id = NEW.owner_id)
+			);
+			RETURN NEW;
+		END
+		$$ LANGUAGE plpgsql;
+		CREATE TRIGGER projects_search_update BEFORE INSERT OR UPDATE OF title, sub_title, description, owner_id ON projects
+			FOR EACH ROW EXECUTE PROCEDURE projects_search_update();
+		CREATE FUNCTION users_search_update() RETURNS trigger AS $$
+		BEGIN
+			UPDATE projects SET search_vector = project_search_vector(
+				title, sub_title, description, concat_ws(' ', NEW.first_name, NEW.last_name, NEW.username)
+			) WHERE owner_id = NEW.id;
+			RETURN NEW;
+		END
+		$$ LANGUAGE plpgsql;
+		CREATE TRIGGER users_search_update AFTER UPDATE OF first_name, last_name, username ON users
+			FOR EACH ROW
+			WHEN (OLD.first_name IS DISTINCT FROM NEW.first_name
+				OR OLD.last_name IS DISTINCT FROM NEW.last_name
+				OR OLD.username IS DISTINCT FROM NEW.username)
+			EXECUTE PROCEDURE users_search_update();
+		UPDATE projects p SET search_vector = project_search_vector(
+			p.title, p.sub_title, p.description,
+			(SELECT concat_ws(' ', u.first_name, u.last_name, u.username) FROM users u WHERE u.id = p.owner_id)
+		);
+		CREATE INDEX projects_search_vector_idx ON projects USING GIN (search_vector);
+	`)
+
+	return err
+}
+
+func rollbackProjectSearch(db migrations.DB) error {
+	log.Warn("dropping project search...")
+	_, err := db.Exec(
+		`DROP TRIGGER users_search_update ON users;
+		DROP FUNCTION users_search_update();
+		DROP TRIGGER projects_search_update ON projects;
+		DROP FUNCTION projects_search_update();
+		DROP FUNCTION project_search_vector(text, text, text, text);
+		ALTER TABLE projects DROP COLUMN search_vector;
+	`)
+
+	return err
+}
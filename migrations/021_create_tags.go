@@ -0,0 +1,47 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createTags, rollbackTags)
+}
+
+func createTags(db migrations.DB) error {
+	log.Info("creating tables [tags, project_tags]...")
+	_, err := db.Exec(
+		`CREATE TABLE tags (
+			id bigserial NOT NULL primary key,
+			alias varchar NOT NULL,
+			name varchar NOT NULL,
+			curated boolean NOT NULL DEFAULT false,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE UNIQUE INDEX tags_alias_idx ON tags (alias varchar_pattern_ops);
+		CREATE TABLE project_tags (
+			project_id int NOT NULL,
+			tag_id int NOT NULL,
+			primary key (project_id, tag_id)
+		);
+		CREATE INDEX project_tags_tag_id_idx ON project_tags (tag_id);
+		INSERT INTO tags (alias, name, curated) VALUES
+			('for-kids', 'For kids', true),
+			('weekend', 'Weekend', true),
+			('outdoor', 'Outdoor', true),
+			('online', 'Online', true);
+	`)
+
+	return err
+}
+
+func rollbackTags(db migrations.DB) error {
+	log.Warn("dropping tables [tags, project_tags]...")
+	_, err := db.Exec(
+		`DROP TABLE project_tags;
+		DROP TABLE tags;
+	`)
+
+	return err
+}
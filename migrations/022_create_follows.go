@@ -0,0 +1,48 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createFollows, rollbackFollows)
+}
+
+func createFollows(db migrations.DB) error {
+	log.Info("creating tables [follows, activities]...")
+	_, err := db.Exec(
+		`CREATE TABLE follows (
+			user_id int NOT NULL,
+			target_type varchar NOT NULL,
+			target_id int NOT NULL,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			primary key (user_id, target_type, target_id)
+		);
+		CREATE INDEX follows_target_idx ON follows (target_type, target_id);
+		CREATE TABLE activities (
+			id bigserial NOT NULL primary key,
+			type varchar NOT NULL,
+			project_id int NOT NULL,
+			category_id int NOT NULL,
+			owner_id int NOT NULL,
+			data jsonb NOT NULL DEFAULT '{}',
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX activities_project_id_idx ON activities (project_id, id);
+		CREATE INDEX activities_category_id_idx ON activities (category_id, id);
+		CREATE INDEX activities_owner_id_idx ON activities (owner_id, id);
+	`)
+
+	return err
+}
+
+func rollbackFollows(db migrations.DB) error {
+	log.Warn("dropping tables [follows, activities]...")
+	_, err := db.Exec(
+		`DROP TABLE activities;
+		DROP TABLE follows;
+	`)
+
+	return err
+}
@@ -0,0 +1,45 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createRankings, rollbackRankings)
+}
+
+func createRankings(db migrations.DB) error {
+	log.Info("creating tables [trending_projects, recommended_projects]...")
+	// existing donations keep empty creation time and don't count as recent
+	_, err := db.Exec(
+		`ALTER TABLE donations ADD COLUMN created_at timestamptz;
+		ALTER TABLE donations ALTER COLUMN created_at SET DEFAULT now();
+		CREATE INDEX donations_created_at_idx ON donations (created_at);
+		CREATE TABLE trending_projects (
+			project_id int NOT NULL primary key,
+			score double precision NOT NULL,
+			updated_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE TABLE recommended_projects (
+			user_id int NOT NULL,
+			project_id int NOT NULL,
+			score double precision NOT NULL,
+			updated_at timestamptz NOT NULL DEFAULT now(),
+			primary key (user_id, project_id)
+		);
+	`)
+
+	return err
+}
+
+func rollbackRankings(db migrations.DB) error {
+	log.Warn("dropping tables [trending_projects, recommended_projects]...")
+	_, err := db.Exec(
+		`DROP TABLE recommended_projects;
+		DROP TABLE trending_projects;
+		ALTER TABLE donations DROP COLUMN created_at;
+	`)
+
+	return err
+}
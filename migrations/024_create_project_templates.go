@@ -0,0 +1,39 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createProjectTemplates, rollbackProjectTemplates)
+}
+
+func createProjectTemplates(db migrations.DB) error {
+	log.Info("creating table [project_templates]...")
+	_, err := db.Exec(
+		`CREATE TABLE project_templates (
+			id bigserial NOT NULL primary key,
+			owner_id int NOT NULL,
+			name varchar NOT NULL,
+			shared boolean NOT NULL DEFAULT false,
+			title varchar NOT NULL DEFAULT '',
+			description text NOT NULL DEFAULT '',
+			instructions text NOT NULL DEFAULT '',
+			project_type_id int,
+			goal_people int NOT NULL DEFAULT 0,
+			goal_amount bigint NOT NULL DEFAULT 0,
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX project_templates_owner_id_idx ON project_templates (owner_id);
+	`)
+
+	return err
+}
+
+func rollbackProjectTemplates(db migrations.DB) error {
+	log.Warn("dropping table [project_templates]...")
+	_, err := db.Exec(`DROP TABLE project_templates`)
+
+	return err
+}
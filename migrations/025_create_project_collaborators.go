@@ -0,0 +1,35 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createProjectCollaborators, rollbackProjectCollaborators)
+}
+
+func createProjectCollaborators(db migrations.DB) error {
+	log.Info("creating table [project_collaborators]...")
+	_, err := db.Exec(
+		`CREATE TABLE project_collaborators (
+			project_id int NOT NULL,
+			user_id int NOT NULL,
+			role varchar NOT NULL,
+			invited_by int NOT NULL,
+			accepted_at timestamptz,
+			created_at timestamptz NOT NULL DEFAULT now(),
+			primary key (project_id, user_id)
+		);
+		CREATE INDEX project_collaborators_user_id_idx ON project_collaborators (user_id);
+	`)
+
+	return err
+}
+
+func rollbackProjectCollaborators(db migrations.DB) error {
+	log.Warn("dropping table [project_collaborators]...")
+	_, err := db.Exec(`DROP TABLE project_collaborators`)
+
+	return err
+}
@@ -0,0 +1,44 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(addProjectLifecycle, rollbackProjectLifecycle)
+}
+
+func addProjectLifecycle(db migrations.DB) error {
+	log.Info("creating table [project_audit]...")
+	_, err := db.Exec(
+		`ALTER TABLE projects ADD COLUMN cancelled_at timestamptz;
+		ALTER TABLE projects ADD COLUMN cancel_reason varchar NOT NULL DEFAULT '';
+		ALTER TABLE projects ADD COLUMN extensions int NOT NULL DEFAULT 0;
+		CREATE TABLE project_audit (
+			id bigserial NOT NULL primary key,
+			project_id int NOT NULL,
+			user_id int NOT NULL,
+			action varchar NOT NULL,
+			field varchar NOT NULL DEFAULT '',
+			old_value text NOT NULL DEFAULT '',
+			new_value text NOT NULL DEFAULT '',
+			created_at timestamptz NOT NULL DEFAULT now()
+		);
+		CREATE INDEX project_audit_project_id_idx ON project_audit (project_id);
+	`)
+
+	return err
+}
+
+func rollbackProjectLifecycle(db migrations.DB) error {
+	log.Warn("dropping table [project_audit]...")
+	_, err := db.Exec(
+		`DROP TABLE project_audit;
+		ALTER TABLE projects DROP COLUMN cancelled_at;
+		ALTER TABLE projects DROP COLUMN cancel_reason;
+		ALTER TABLE projects DROP COLUMN extensions;
+	`)
+
+	return err
+}
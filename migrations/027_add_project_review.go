@@ -0,0 +1,38 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(addProjectReview, rollbackProjectReview)
+}
+
+func addProjectReview(db migrations.DB) error {
+	log.Info("adding scheduled publishing and project review...")
+	_, err := db.Exec(
+		`ALTER TABLE categories ADD COLUMN moderated boolean NOT NULL DEFAULT false;
+		ALTER TABLE projects ADD COLUMN publish_at timestamptz;
+		ALTER TABLE projects ADD COLUMN review_status varchar NOT NULL DEFAULT '';
+		ALTER TABLE projects ADD COLUMN review_comment text NOT NULL DEFAULT '';
+		ALTER TABLE projects ADD COLUMN reviewed_at timestamptz;
+		CREATE INDEX projects_publish_at_idx ON projects (publish_at) WHERE NOT published;
+	`)
+
+	return err
+}
+
+func rollbackProjectReview(db migrations.DB) error {
+	log.Warn("dropping scheduled publishing and project review...")
+	_, err := db.Exec(
+		`DROP INDEX projects_publish_at_idx;
+		ALTER TABLE projects DROP COLUMN publish_at;
+		ALTER TABLE projects DROP COLUMN review_status;
+		ALTER TABLE projects DROP COLUMN review_comment;
+		ALTER TABLE projects DROP COLUMN reviewed_at;
+		ALTER TABLE categories DROP COLUMN moderated;
+	`)
+
+	return err
+}
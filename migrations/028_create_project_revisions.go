@@ -0,0 +1,60 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(createProjectRevisions, rollbackProjectRevisions)
+}
+
+func createProjectRevisions(db migrations.DB) error {
+	log.Info("creating table [project_revisions]...")
+	_, err := db.Exec(
+		`CREATE TABLE project_revisions (
+			id bigserial NOT NULL primary key,
+			project_id int NOT NULL,
+			number int NOT NULL,
+			user_id int NOT NULL,
+			fields jsonb NOT NULL DEFAULT '{}',
+			flagged varchar[] NOT NULL DEFAULT '{}',
+			created_at timestamptz NOT NULL DEFAULT now(),
+			UNIQUE (project_id, number)
+		);
+	`)
+	if err != nil {
+		return err
+	}
+	log.Info("saving current state of projects as first revision...")
+	_, err = db.Exec(
+		`INSERT INTO project_revisions (project_id, number, user_id, fields)
+		SELECT id, 1, owner_id, jsonb_build_object(
+			'title', title,
+			'subtitle', sub_title,
+			'description', description,
+			'image_link', image_link,
+			'instructions', instructions,
+			'category', category_id::text,
+			'project_type', project_type_id::text,
+			'goal_people', goal_people::text,
+			'goal_amount', goal_amount::text,
+			'currency', currency,
+			'release_date', to_char(release_date AT TIME ZONE 'UTC', 'YYYY-MM-DD'),
+			'event_date', coalesce(to_char(event_date AT TIME ZONE 'UTC', 'YYYY-MM-DD HH24:MI:SS'), ''),
+			'min_pledge', min_pledge::text,
+			'max_pledge', max_pledge::text,
+			'pledge_step', pledge_step::text,
+			'deny_overfunding', deny_overfunding::text
+		) FROM projects;
+	`)
+
+	return err
+}
+
+func rollbackProjectRevisions(db migrations.DB) error {
+	log.Warn("dropping table [project_revisions]...")
+	_, err := db.Exec(`DROP TABLE project_revisions`)
+
+	return err
+}
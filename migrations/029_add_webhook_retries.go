@@ -0,0 +1,30 @@
+package migrate
+
+import (
+	"github.com/go-pg/migrations/v8"
+	"github.com/labstack/gommon/log"
+)
+
+func init() {
+	migrations.MustRegisterTx(addWebhookRetries, rollbackWebhookRetries)
+}
+
+func addWebhookRetries(db migrations.DB) error {
+	log.Info("adding next attempt to webhook deliveries...")
+	_, err := db.Exec(
+		`ALTER TABLE webhook_deliveries ADD COLUMN next_attempt_at timestamptz;
+		CREATE INDEX webhook_deliveries_next_attempt_at_idx ON webhook_deliveries (next_attempt_at) WHERE next_attempt_at IS NOT NULL;
+	`)
+
+	return err
+}
+
+func rollbackWebhookRetries(db migrations.DB) error {
+	log.Warn("removing next attempt from webhook deliveries...")
+	_, err := db.Exec(
+		`DROP INDEX webhook_deliveries_next_attempt_at_idx;
+		ALTER TABLE webhook_deliveries DROP COLUMN next_attempt_at;
+	`)
+
+	return err
+}